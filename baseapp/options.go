@@ -240,3 +240,10 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
 }
+
+// AddABCIListener registers a listener into the BaseApp hooks, which is passed
+// the BeginBlock, DeliverTx, and EndBlock requests and responses like a
+// StreamingService, without listening to the store writes.
+func (app *BaseApp) AddABCIListener(l ABCIListener) {
+	app.abciListeners = append(app.abciListeners, l)
+}
@@ -0,0 +1,93 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// stateChangeRecorder is a WriteListener recording the writes to the KV
+// stores. As the branches of a multistore inherit its listeners, a key may be
+// reported once per branch written, the last write being the net one.
+type stateChangeRecorder struct {
+	keys    []storetypes.StoreKey
+	changes []*tx.StateChange
+	indexes map[string]int
+}
+
+var _ storetypes.WriteListener = (*stateChangeRecorder)(nil)
+
+// OnWrite implements the WriteListener interface. The writes to the transient
+// and memory stores aren't recorded, as they aren't persisted.
+func (r *stateChangeRecorder) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	if _, ok := storeKey.(*storetypes.KVStoreKey); !ok {
+		return nil
+	}
+
+	change := &tx.StateChange{
+		StoreKey: storeKey.Name(),
+		Key:      append([]byte(nil), key...),
+		Delete:   delete,
+		Value:    append([]byte(nil), value...),
+	}
+
+	id := change.StoreKey + "/" + string(key)
+	if i, ok := r.indexes[id]; ok {
+		r.changes[i] = change
+		return nil
+	}
+
+	r.indexes[id] = len(r.changes)
+	r.keys = append(r.keys, storeKey)
+	r.changes = append(r.changes, change)
+
+	return nil
+}
+
+// SimulateWithStateChanges executes a tx in simulate mode like Simulate, and
+// also returns the changes of the tx to the KV stores, sorted by store key and
+// key. The changes are the net writes and deletes of the tx, along with the
+// values of the keys before the tx.
+func (app *BaseApp) SimulateWithStateChanges(txBytes []byte) (sdk.GasInfo, *sdk.Result, []*tx.StateChange, error) {
+	sdkTx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return sdk.GasInfo{}, nil, nil, err
+	}
+
+	// the simulation context is branched from the check state, and is
+	// branched again with the recorder, which is notified of the net writes
+	// of the tx once the second branch is written into the first one
+	ctx := sdk.UnwrapSDKContext(app.getContextForTx(runTxModeSimulate, txBytes))
+	ms, ok := ctx.MultiStore().(cachemulti.Store)
+	if !ok {
+		return sdk.GasInfo{}, nil, nil, fmt.Errorf("cannot record the state changes of a %T multistore", ctx.MultiStore())
+	}
+
+	recorder := &stateChangeRecorder{indexes: make(map[string]int)}
+	simMs := ms.CacheMultiStoreWithListener(recorder)
+	res, err := app.txHandler.SimulateTx(sdk.WrapSDKContext(ctx.WithMultiStore(simMs)), sdkTx, tx.RequestSimulateTx{TxBytes: txBytes})
+	if err != nil {
+		return res.GasInfo, nil, nil, err
+	}
+	simMs.Write()
+
+	parent := app.getState(runTxModeSimulate).ms
+	for i, change := range recorder.changes {
+		change.PreviousValue = parent.GetKVStore(recorder.keys[i]).Get(change.Key)
+	}
+
+	sort.SliceStable(recorder.changes, func(i, j int) bool {
+		a, b := recorder.changes[i], recorder.changes[j]
+		if a.StoreKey != b.StoreKey {
+			return a.StoreKey < b.StoreKey
+		}
+		return bytes.Compare(a.Key, b.Key) < 0
+	})
+
+	return res.GasInfo, res.Result, recorder.changes, nil
+}
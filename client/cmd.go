@@ -120,7 +120,29 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	if flagSet.Changed(flags.FlagKeyringRemote) {
+		remoteAddr, _ := flagSet.GetString(flags.FlagKeyringRemote)
+		opts := append([]keyring.Option{}, clientCtx.KeyringOptions...)
+		opts = append(opts, keyring.WithRemoteSigner(remoteAddr))
+
+		if insecure, _ := flagSet.GetBool(flags.FlagKeyringRemoteInsecure); insecure {
+			opts = append(opts, keyring.WithRemoteSignerInsecure())
+		} else {
+			caFile, _ := flagSet.GetString(flags.FlagKeyringRemoteCA)
+			certFile, _ := flagSet.GetString(flags.FlagKeyringRemoteCert)
+			keyFile, _ := flagSet.GetString(flags.FlagKeyringRemoteKey)
+
+			tlsConfig, err := keyring.RemoteSignerClientTLSConfig(caFile, certFile, keyFile)
+			if err != nil {
+				return clientCtx, err
+			}
+			opts = append(opts, keyring.WithRemoteSignerTLS(tlsConfig))
+		}
+
+		clientCtx = clientCtx.WithKeyringOptions(opts...)
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) || flagSet.Changed(flags.FlagKeyringRemote) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
@@ -35,6 +35,8 @@ const (
 	SignModeDirect = "direct"
 	// SignModeLegacyAminoJSON is the value of the --sign-mode flag for SIGN_MODE_LEGACY_AMINO_JSON
 	SignModeLegacyAminoJSON = "amino-json"
+	// SignModeTextual is the value of the --sign-mode flag for SIGN_MODE_TEXTUAL
+	SignModeTextual = "textual"
 )
 
 // List of CLI flags
@@ -62,6 +64,7 @@ const (
 	FlagSkipConfirmation = "yes"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
+	FlagKeyringRemote    = "keyring-remote-addr"
 	FlagPage             = "page"
 	FlagLimit            = "limit"
 	FlagSignMode         = "sign-mode"
@@ -76,6 +79,12 @@ const (
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
 	FlagLogFormat = "log_format"
+
+	// TLS flags of the remote keyring backend
+	FlagKeyringRemoteCA       = "keyring-remote-ca"
+	FlagKeyringRemoteCert     = "keyring-remote-cert"
+	FlagKeyringRemoteKey      = "keyring-remote-key"
+	FlagKeyringRemoteInsecure = "keyring-remote-insecure"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -100,7 +109,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
 	cmd.Flags().String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
 	cmd.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
-	cmd.Flags().String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
+	cmd.Flags().String(FlagGasPrices, "", fmt.Sprintf("Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom); set to %q to use the median gas price paid in the last blocks", GasFlagAuto))
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 	cmd.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	cmd.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -109,8 +118,13 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
-	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
+	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	cmd.Flags().String(FlagKeyringRemote, "", "<host>:<port> of the signer of the remote keyring backend")
+	cmd.Flags().String(FlagKeyringRemoteCA, "", "CA certificates verifying the signer of the remote keyring backend; if omitted, the system ones are used")
+	cmd.Flags().String(FlagKeyringRemoteCert, "", "Client certificate authenticating with the signer of the remote keyring backend")
+	cmd.Flags().String(FlagKeyringRemoteKey, "", "Private key of the client certificate of the remote keyring backend")
+	cmd.Flags().Bool(FlagKeyringRemoteInsecure, false, "Connect to the signer of the remote keyring backend without TLS (unsafe)")
+	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json|textual), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
 
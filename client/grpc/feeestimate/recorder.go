@@ -0,0 +1,156 @@
+package feeestimate
+
+import (
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxBlocks is the default number of last blocks whose gas prices are
+// recorded.
+const DefaultMaxBlocks = 100
+
+// blockGasPrices are the gas prices paid by the transactions of a block, by
+// fee denom.
+type blockGasPrices struct {
+	height int64
+	prices map[string][]sdk.Dec
+}
+
+// FeeRecorder is an ABCI listener recording the gas prices paid by the
+// successful transactions of the last committed blocks, which are used to
+// estimate the gas prices. It is registered with BaseApp.AddABCIListener.
+type FeeRecorder struct {
+	txDecoder sdk.TxDecoder
+	maxBlocks int
+
+	mtx     sync.RWMutex
+	current *blockGasPrices
+	// blocks are the last recorded blocks, from the oldest to the newest.
+	blocks []*blockGasPrices
+}
+
+// NewFeeRecorder returns a FeeRecorder keeping the gas prices of the last
+// maxBlocks blocks.
+func NewFeeRecorder(txDecoder sdk.TxDecoder, maxBlocks int) *FeeRecorder {
+	return &FeeRecorder{
+		txDecoder: txDecoder,
+		maxBlocks: maxBlocks,
+	}
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (r *FeeRecorder) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.current = &blockGasPrices{height: req.Header.Height, prices: make(map[string][]sdk.Dec)}
+
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (r *FeeRecorder) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+
+	tx, err := r.txDecoder(req.Tx)
+	if err != nil {
+		return err
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return nil
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.current == nil {
+		return nil
+	}
+
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
+	for _, fee := range feeTx.GetFee() {
+		r.current.prices[fee.Denom] = append(r.current.prices[fee.Denom], fee.Amount.ToDec().Quo(gas))
+	}
+
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (r *FeeRecorder) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.current == nil {
+		return nil
+	}
+
+	r.blocks = append(r.blocks, r.current)
+	if len(r.blocks) > r.maxBlocks {
+		r.blocks = r.blocks[len(r.blocks)-r.maxBlocks:]
+	}
+	r.current = nil
+
+	return nil
+}
+
+// FeeEstimate returns the gas price estimates of each fee denom over the last
+// blocks, or over all the recorded blocks if blocks is zero, along with the
+// number of these blocks and the height of the last one.
+func (r *FeeRecorder) FeeEstimate(blocks int) ([]GasPriceEstimate, int, int64) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	recorded := r.blocks
+	if blocks > 0 && blocks < len(recorded) {
+		recorded = recorded[len(recorded)-blocks:]
+	}
+	if len(recorded) == 0 {
+		return nil, 0, 0
+	}
+
+	prices := make(map[string][]sdk.Dec)
+	for _, b := range recorded {
+		for denom, p := range b.prices {
+			prices[denom] = append(prices[denom], p...)
+		}
+	}
+
+	estimates := make([]GasPriceEstimate, 0, len(prices))
+	for denom, p := range prices {
+		sort.Slice(p, func(i, j int) bool { return p[i].LT(p[j]) })
+		estimates = append(estimates, GasPriceEstimate{
+			Denom:  denom,
+			Low:    percentile(p, 10),
+			Median: percentile(p, 50),
+			High:   percentile(p, 90),
+			Txs:    uint64(len(p)),
+		})
+	}
+
+	sort.Slice(estimates, func(i, j int) bool {
+		if estimates[i].Txs != estimates[j].Txs {
+			return estimates[i].Txs > estimates[j].Txs
+		}
+		return estimates[i].Denom < estimates[j].Denom
+	})
+
+	return estimates, len(recorded), recorded[len(recorded)-1].height
+}
+
+// percentile returns the nearest-rank percentile of the sorted gas prices.
+func percentile(sorted []sdk.Dec, p int) sdk.Dec {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
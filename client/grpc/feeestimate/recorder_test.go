@@ -0,0 +1,94 @@
+package feeestimate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/feeestimate"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeRecorder(t *testing.T) {
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	recorder := feeestimate.NewFeeRecorder(txConfig.TxDecoder(), 3)
+	ctx := sdk.Context{}
+
+	encodeTx := func(fees sdk.Coins, gas uint64) []byte {
+		txBuilder := txConfig.NewTxBuilder()
+		txBuilder.SetFeeAmount(fees)
+		txBuilder.SetGasLimit(gas)
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// commitBlock records a block whose transactions pay the given stake fees
+	// with 1000 gas.
+	commitBlock := func(height int64, fees ...int64) {
+		require.NoError(t, recorder.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+		for _, fee := range fees {
+			req := abci.RequestDeliverTx{Tx: encodeTx(sdk.NewCoins(sdk.NewInt64Coin("stake", fee)), 1000)}
+			require.NoError(t, recorder.ListenDeliverTx(ctx, req, abci.ResponseDeliverTx{}))
+		}
+		require.NoError(t, recorder.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	}
+
+	estimates, blocks, height := recorder.FeeEstimate(0)
+	require.Empty(t, estimates)
+	require.Zero(t, blocks)
+	require.Zero(t, height)
+
+	commitBlock(1, 1000)
+	commitBlock(2)
+
+	require.NoError(t, recorder.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}}, abci.ResponseBeginBlock{}))
+	for i := int64(1); i <= 10; i++ {
+		req := abci.RequestDeliverTx{Tx: encodeTx(sdk.NewCoins(sdk.NewInt64Coin("stake", i*100)), 1000)}
+		require.NoError(t, recorder.ListenDeliverTx(ctx, req, abci.ResponseDeliverTx{}))
+	}
+	// the failed transactions and the transactions without fees are ignored
+	req := abci.RequestDeliverTx{Tx: encodeTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)), 1000)}
+	require.NoError(t, recorder.ListenDeliverTx(ctx, req, abci.ResponseDeliverTx{Code: 1}))
+	req = abci.RequestDeliverTx{Tx: encodeTx(nil, 1000)}
+	require.NoError(t, recorder.ListenDeliverTx(ctx, req, abci.ResponseDeliverTx{}))
+	req = abci.RequestDeliverTx{Tx: encodeTx(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), 1000)}
+	require.NoError(t, recorder.ListenDeliverTx(ctx, req, abci.ResponseDeliverTx{}))
+	require.NoError(t, recorder.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 3}, abci.ResponseEndBlock{}))
+
+	estimates, blocks, height = recorder.FeeEstimate(0)
+	require.Equal(t, 3, blocks)
+	require.Equal(t, int64(3), height)
+	require.Equal(t, []feeestimate.GasPriceEstimate{
+		{
+			Denom:  "stake",
+			Low:    sdk.NewDecWithPrec(2, 1),
+			Median: sdk.NewDecWithPrec(6, 1),
+			High:   sdk.NewDecWithPrec(10, 1),
+			Txs:    11,
+		},
+		{
+			Denom:  "atom",
+			Low:    sdk.NewDecWithPrec(1, 2),
+			Median: sdk.NewDecWithPrec(1, 2),
+			High:   sdk.NewDecWithPrec(1, 2),
+			Txs:    1,
+		},
+	}, estimates)
+
+	// the estimates are computed over the last blocks
+	estimates, blocks, _ = recorder.FeeEstimate(2)
+	require.Equal(t, 2, blocks)
+	require.Equal(t, uint64(10), estimates[0].Txs)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), estimates[0].Median)
+
+	// only the last 3 blocks are kept
+	commitBlock(4)
+	estimates, blocks, height = recorder.FeeEstimate(10)
+	require.Equal(t, 3, blocks)
+	require.Equal(t, int64(4), height)
+	require.Equal(t, uint64(10), estimates[0].Txs)
+}
@@ -0,0 +1,52 @@
+package feeestimate
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// This is the struct that we will implement all the handlers on.
+type queryServer struct {
+	recorder *FeeRecorder
+}
+
+var _ ServiceServer = queryServer{}
+
+// NewQueryServer creates a new fee estimate query server, estimating the gas
+// prices from the blocks recorded by the given recorder.
+func NewQueryServer(recorder *FeeRecorder) ServiceServer {
+	return queryServer{recorder: recorder}
+}
+
+// FeeEstimate implements ServiceServer.FeeEstimate
+func (s queryServer) FeeEstimate(_ context.Context, req *FeeEstimateRequest) (*FeeEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	estimates, blocks, height := s.recorder.FeeEstimate(int(req.Blocks))
+
+	return &FeeEstimateResponse{
+		Estimates: estimates,
+		Blocks:    uint32(blocks),
+		Height:    height,
+	}, nil
+}
+
+// RegisterFeeEstimateService registers the fee estimate queries on the gRPC
+// router.
+func RegisterFeeEstimateService(qrt gogogrpc.Server, recorder *FeeRecorder) {
+	RegisterServiceServer(qrt, NewQueryServer(recorder))
+}
+
+// RegisterGRPCGatewayRoutes mounts the fee estimate service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	if err := RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn)); err != nil {
+		panic(err)
+	}
+}
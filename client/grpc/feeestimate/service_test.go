@@ -0,0 +1,133 @@
+package feeestimate_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/feeestimate"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/testutil/rest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtestutil "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+
+	queryClient feeestimate.ServiceClient
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	s.queryClient = feeestimate.NewServiceClient(s.network.Validators[0].ClientCtx)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s IntegrationTestSuite) TestFeeEstimate() {
+	val := s.network.Validators[0]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))
+
+	// without transactions, the gas prices can't be estimated
+	_, err := banktestutil.MsgSendExec(val.ClientCtx, val.Address, val.Address, amount,
+		fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().Error(err)
+
+	// a transaction paying a gas price of 0.001stake
+	_, err = banktestutil.MsgSendExec(val.ClientCtx, val.Address, val.Address, amount,
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100))),
+		fmt.Sprintf("--%s=100000", flags.FlagGas),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	res, err := s.queryClient.FeeEstimate(context.Background(), &feeestimate.FeeEstimateRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Estimates, 1)
+	s.Require().Equal(s.cfg.BondDenom, res.Estimates[0].Denom)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 3), res.Estimates[0].Median)
+	s.Require().Equal(uint64(1), res.Estimates[0].Txs)
+	s.Require().NotZero(res.Blocks)
+	s.Require().NotZero(res.Height)
+
+	restRes, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/base/feeestimate/v1beta1/fee_estimate?blocks=1", val.APIAddress))
+	s.Require().NoError(err)
+	var restFeeEstimate feeestimate.FeeEstimateResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &restFeeEstimate))
+	s.Require().Equal(uint32(1), restFeeEstimate.Blocks)
+
+	// the fees of the transactions are computed from the median gas price
+	out, err := banktestutil.MsgSendExec(val.ClientCtx, val.Address, val.Address, amount,
+		fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto),
+		fmt.Sprintf("--%s=200000", flags.FlagGas),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 200)), tx.(sdk.FeeTx).GetFee())
+
+	// the transactions composed from a file of messages, whose gas is
+	// simulated, are built with the estimated gas prices too
+	msgSend := fmt.Sprintf(`- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: %s
+  to_address: %s
+  amount: [{denom: %s, amount: "10"}]
+`, val.Address, val.Address, s.cfg.BondDenom)
+	msgsFile := testutil.WriteToNewTempFile(s.T(), "messages:\n"+strings.Repeat(msgSend, 2))
+
+	out, err = authtestutil.TxComposeExec(val.ClientCtx, val.Address, msgsFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	tx, err = val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	feeTx := tx.(sdk.FeeTx)
+	s.Require().Len(tx.GetMsgs(), 2)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, int64(feeTx.GetGas()+999)/1000)), feeTx.GetFee())
+
+	out, err = authtestutil.TxComposeExec(val.ClientCtx, val.Address, msgsFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	)
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
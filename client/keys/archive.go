@@ -0,0 +1,247 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagConflict = "conflict"
+
+	// conflict resolutions of import-all
+	conflictFail      = "fail"
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+)
+
+// ExportAllKeysCommand exports all the keys of the key store in an encrypted
+// archive.
+func ExportAllKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-all",
+		Short: "Export all the keys in an encrypted archive",
+		Long: `Export all the keys of the keyring in a single ASCII-armored archive encrypted
+with a passphrase. The archive holds the private keys of the local keys, the
+derivation paths of the ledger keys and the public keys of the offline and
+multisig keys, and can be imported in another keyring with the import-all command.
+
+The archive is written to the file given by --output, or printed otherwise.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keyring:", buf)
+			if err != nil {
+				return err
+			}
+
+			armored, err := keyring.ExportArchive(clientCtx.Keyring, clientCtx.Codec, encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(cli.OutputFlag)
+			if output == "" {
+				cmd.Println(armored)
+				return nil
+			}
+
+			return os.WriteFile(output, []byte(armored), 0600)
+		},
+	}
+
+	cmd.Flags().String(cli.OutputFlag, "", "Write the archive to the given file instead of the standard output")
+
+	return cmd
+}
+
+// ImportAllKeysCommand imports all the keys of an encrypted archive.
+func ImportAllKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-all <archive>",
+		Short: "Import all the keys of an encrypted archive",
+		Long: `Import all the keys of an archive written by the export-all command into the
+keyring.
+
+A key conflicts with the keyring when a key with the same name or address is
+already stored. The --conflict flag selects how the conflicts are resolved:
+
+    fail        Import nothing if any key conflicts (default)
+    skip        Import the keys which don't conflict
+    overwrite   Delete the conflicting keys of the keyring before importing
+
+If a key fails to be imported, the keys already imported are deleted and the
+overwritten keys are restored.
+
+With --dry-run, the keys of the archive are listed along with the action which
+would be taken, and the keyring is left untouched.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+			conflict, _ := cmd.Flags().GetString(flagConflict)
+			switch conflict {
+			case conflictFail, conflictSkip, conflictOverwrite:
+			default:
+				return fmt.Errorf("invalid conflict resolution %s, expected %s|%s|%s", conflict, conflictFail, conflictSkip, conflictOverwrite)
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the archive:", buf)
+			if err != nil {
+				return err
+			}
+
+			records, err := keyring.ReadArchive(clientCtx.Codec, string(bz), passphrase)
+			if err != nil {
+				return err
+			}
+
+			// all the conflicts are resolved before anything is imported
+			actions := make([]string, len(records))
+			for i, k := range records {
+				conflicts, err := conflictsWithKeyring(clientCtx.Keyring, k)
+				if err != nil {
+					return err
+				}
+
+				switch {
+				case !conflicts:
+					actions[i] = "import"
+				case conflict == conflictFail:
+					if !dryRun {
+						return fmt.Errorf("key %s conflicts with a key of the keyring, use --%s to resolve the conflicts", k.Name, flagConflict)
+					}
+					actions[i] = "conflict"
+				case conflict == conflictSkip:
+					actions[i] = "skip"
+				default:
+					actions[i] = "overwrite"
+				}
+			}
+
+			if dryRun {
+				for i, k := range records {
+					addr, err := k.GetAddress()
+					if err != nil {
+						return err
+					}
+					cmd.Printf("%s\t%s\t%s\t%s\n", actions[i], k.Name, k.GetType(), addr)
+				}
+				return nil
+			}
+
+			// the keyring is restored if any key fails to be imported
+			var imported, deleted []*keyring.Record
+			for i, k := range records {
+				switch actions[i] {
+				case "skip":
+					continue
+				case "overwrite":
+					overwritten, err := deleteConflicting(clientCtx.Keyring, k)
+					deleted = append(deleted, overwritten...)
+					if err != nil {
+						return restoreKeyring(clientCtx.Keyring, imported, deleted, err)
+					}
+				}
+
+				if err := clientCtx.Keyring.ImportRecord(k); err != nil {
+					return restoreKeyring(clientCtx.Keyring, imported, deleted, fmt.Errorf("failed to import key %s: %w", k.Name, err))
+				}
+				imported = append(imported, k)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagDryRun, false, "List the keys of the archive without importing them")
+	cmd.Flags().String(flagConflict, conflictFail, "How to resolve the conflicts with the keys of the keyring (fail|skip|overwrite)")
+
+	return cmd
+}
+
+// conflictsWithKeyring returns whether a key with the name or the address of
+// the record is stored in the keyring.
+func conflictsWithKeyring(kr keyring.Keyring, k *keyring.Record) (bool, error) {
+	if _, err := kr.Key(k.Name); err == nil {
+		return true, nil
+	}
+
+	addr, err := k.GetAddress()
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := kr.KeyByAddress(addr); err == nil {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// deleteConflicting deletes the keys of the keyring with the name or the
+// address of the record, and returns the deleted keys.
+func deleteConflicting(kr keyring.Keyring, k *keyring.Record) ([]*keyring.Record, error) {
+	var deleted []*keyring.Record
+	if existing, err := kr.Key(k.Name); err == nil {
+		if err := kr.Delete(k.Name); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, existing)
+	}
+
+	addr, err := k.GetAddress()
+	if err != nil {
+		return deleted, err
+	}
+
+	if existing, err := kr.KeyByAddress(addr); err == nil {
+		if err := kr.DeleteByAddress(addr); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, existing)
+	}
+
+	return deleted, nil
+}
+
+// restoreKeyring undoes a failed import by deleting the imported keys and
+// importing back the deleted ones, and returns the error of the import.
+func restoreKeyring(kr keyring.Keyring, imported, deleted []*keyring.Record, importErr error) error {
+	for _, k := range imported {
+		if err := kr.Delete(k.Name); err != nil {
+			return fmt.Errorf("%w; failed to restore the keyring: %s", importErr, err)
+		}
+	}
+
+	for _, k := range deleted {
+		if err := kr.ImportRecord(k); err != nil {
+			return fmt.Errorf("%w; failed to restore key %s: %s", importErr, k.Name, err)
+		}
+	}
+
+	return importErr
+}
@@ -0,0 +1,131 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// failingImportKeyring is a keyring which fails the first import of the key
+// with the given name.
+type failingImportKeyring struct {
+	keyring.Keyring
+	failingName string
+	failed      bool
+}
+
+func (kr *failingImportKeyring) ImportRecord(k *keyring.Record) error {
+	if k.Name == kr.failingName && !kr.failed {
+		kr.failed = true
+		return fmt.Errorf("mock err")
+	}
+
+	return kr.Keyring.ImportRecord(k)
+}
+
+func Test_runExportImportAllCmd(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	path := sdk.GetConfig().GetFullBIP44Path()
+
+	runCmd := func(cmd *cobra.Command, kb keyring.Keyring, userInput string, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(userInput)
+
+		clientCtx := client.Context{}.
+			WithKeyring(kb).
+			WithInput(mockIn).
+			WithCodec(cdc)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	exported, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	a, err := exported.NewAccount("a", testutil.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	b, _, err := exported.NewMnemonic("b", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	archive := filepath.Join(t.TempDir(), "archive")
+	_, err = runCmd(ExportAllKeysCommand(), keyring.NewInMemory(cdc), "12345678\n", fmt.Sprintf("--%s=%s", cli.OutputFlag, archive))
+	require.Error(t, err)
+	_, err = runCmd(ExportAllKeysCommand(), exported, "short\n", fmt.Sprintf("--%s=%s", cli.OutputFlag, archive))
+	require.Error(t, err)
+	_, err = runCmd(ExportAllKeysCommand(), exported, "12345678\n", fmt.Sprintf("--%s=%s", cli.OutputFlag, archive))
+	require.NoError(t, err)
+
+	// the keyring of the import holds another key named a
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("a", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	_, err = runCmd(ImportAllKeysCommand(), kb, "87654321\n", archive)
+	require.Error(t, err)
+	_, err = runCmd(ImportAllKeysCommand(), kb, "12345678\n", archive, fmt.Sprintf("--%s=unknown", flagConflict))
+	require.Error(t, err)
+
+	addrA, err := a.GetAddress()
+	require.NoError(t, err)
+	addrB, err := b.GetAddress()
+	require.NoError(t, err)
+
+	out, err := runCmd(ImportAllKeysCommand(), kb, "12345678\n", archive, fmt.Sprintf("--%s", flags.FlagDryRun))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("conflict\ta\tlocal\t%s", addrA),
+		fmt.Sprintf("import\tb\tlocal\t%s", addrB),
+	}, strings.Split(strings.TrimSpace(out), "\n"))
+
+	// nothing is imported on conflicts by default
+	_, err = runCmd(ImportAllKeysCommand(), kb, "12345678\n", archive)
+	require.Error(t, err)
+	_, err = kb.Key("b")
+	require.Error(t, err)
+
+	_, err = runCmd(ImportAllKeysCommand(), kb, "12345678\n", archive, fmt.Sprintf("--%s=%s", flagConflict, conflictSkip))
+	require.NoError(t, err)
+	k, err := kb.Key("b")
+	require.NoError(t, err)
+	require.Equal(t, b.PubKey, k.PubKey)
+	k, err = kb.Key("a")
+	require.NoError(t, err)
+	require.NotEqual(t, a.PubKey, k.PubKey)
+
+	// the overwritten keys are restored if a key fails to be imported
+	_, err = runCmd(ImportAllKeysCommand(), &failingImportKeyring{Keyring: kb, failingName: "b"}, "12345678\n", archive, fmt.Sprintf("--%s=%s", flagConflict, conflictOverwrite))
+	require.Error(t, err)
+	k, err = kb.Key("a")
+	require.NoError(t, err)
+	require.NotEqual(t, a.PubKey, k.PubKey)
+	k, err = kb.Key("b")
+	require.NoError(t, err)
+	require.Equal(t, b.PubKey, k.PubKey)
+
+	_, err = runCmd(ImportAllKeysCommand(), kb, "12345678\n", archive, fmt.Sprintf("--%s=%s", flagConflict, conflictOverwrite))
+	require.NoError(t, err)
+	records, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	k, err = kb.Key("a")
+	require.NoError(t, err)
+	require.Equal(t, a.PubKey, k.PubKey)
+}
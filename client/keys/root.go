@@ -42,6 +42,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		AddKeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
+		ExportAllKeysCommand(),
+		ImportAllKeysCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
 		DeleteKeyCommand(),
@@ -52,7 +54,12 @@ The pass backend requires GnuPG: https://gnupg.org/
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
+	cmd.PersistentFlags().String(flags.FlagKeyringRemote, "", "<host>:<port> of the signer of the remote keyring backend")
+	cmd.PersistentFlags().String(flags.FlagKeyringRemoteCA, "", "CA certificates verifying the signer of the remote keyring backend; if omitted, the system ones are used")
+	cmd.PersistentFlags().String(flags.FlagKeyringRemoteCert, "", "Client certificate authenticating with the signer of the remote keyring backend")
+	cmd.PersistentFlags().String(flags.FlagKeyringRemoteKey, "", "Private key of the client certificate of the remote keyring backend")
+	cmd.PersistentFlags().Bool(flags.FlagKeyringRemoteInsecure, false, "Connect to the signer of the remote keyring backend without TLS (unsafe)")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
@@ -0,0 +1,334 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// sequenceMismatchRegexp matches the error returned for a transaction signed
+// with a wrong account sequence, and captures the expected sequence.
+var sequenceMismatchRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// ErrBroadcasterClosed is returned when a transaction is queued to a closed
+// Broadcaster.
+var ErrBroadcasterClosed = errors.New("broadcaster is closed")
+
+// BroadcastResult is the result of a transaction queued to a Broadcaster.
+type BroadcastResult struct {
+	// ID is the identifier returned when the transaction was queued.
+	ID uint64
+
+	// From is the name of the key which signed the transaction.
+	From string
+
+	// Sequence is the account sequence of the last attempt to broadcast the
+	// transaction.
+	Sequence uint64
+
+	// Response is the response of the node to the last broadcast attempt. It
+	// is set even if the transaction failed the checks of the node, in which
+	// case its code isn't zero.
+	Response *sdk.TxResponse
+
+	// Err is the error which prevented the transaction to be built, signed or
+	// broadcast.
+	Err error
+}
+
+// broadcastRequest is a transaction queued to a Broadcaster.
+type broadcastRequest struct {
+	id   uint64
+	msgs []sdk.Msg
+}
+
+// broadcastWorker signs and broadcasts the transactions of a key in order, and
+// tracks the account sequence locally.
+type broadcastWorker struct {
+	name    string
+	address sdk.AccAddress
+	queue   chan broadcastRequest
+
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// Broadcaster signs and broadcasts transactions of multiple keys concurrently.
+// The transactions of a key are broadcast in order, without waiting for the
+// previous ones to be included in a block, with an account sequence tracked
+// locally. When the node reports an account sequence mismatch, e.g. because
+// the account sent transactions through another client, the sequence is
+// queried again with the AccountRetriever of the Factory and the transaction
+// is retried. The results are reported on the Results channel, which must be
+// consumed for the broadcasts to progress.
+type Broadcaster struct {
+	clientCtx  client.Context
+	txf        Factory
+	queueSize  int
+	maxRetries int
+
+	// mu guards the workers and the IDs, it isn't held while a transaction is
+	// queued so that a full queue only blocks the callers of its key.
+	mu      sync.Mutex
+	closed  bool
+	nextID  uint64
+	workers map[string]*broadcastWorker
+	wg      sync.WaitGroup
+
+	// closing is closed by Close to unblock the callers waiting on a full
+	// queue, and sending tracks the callers queueing a transaction, which
+	// must be done before the queues are closed.
+	closing chan struct{}
+	sending sync.WaitGroup
+
+	// signMu serializes the accesses to the keyring, which isn't safe for
+	// concurrent use. It may be taken while holding mu, never the other way
+	// around.
+	signMu sync.Mutex
+
+	// gasPricesOnce estimates the "auto" gas prices of the Factory once, for
+	// all the transactions, into preparedTxf.
+	gasPricesOnce sync.Once
+	preparedTxf   Factory
+	gasPricesErr  error
+
+	results chan BroadcastResult
+}
+
+// NewBroadcaster returns a Broadcaster building transactions with the given
+// Factory and broadcasting them in sync mode. queueSize is the number of
+// transactions which can be queued per key and the number of results which
+// can be buffered, maxRetries is the number of times a transaction is retried
+// after an account sequence mismatch.
+func NewBroadcaster(clientCtx client.Context, txf Factory, queueSize, maxRetries int) *Broadcaster {
+	return &Broadcaster{
+		clientCtx:  clientCtx.WithBroadcastMode(flags.BroadcastSync),
+		txf:        txf,
+		queueSize:  queueSize,
+		maxRetries: maxRetries,
+		workers:    make(map[string]*broadcastWorker),
+		closing:    make(chan struct{}),
+		results:    make(chan BroadcastResult, queueSize),
+	}
+}
+
+// Results returns the channel of the broadcast results, which is closed once
+// the Broadcaster is closed and all the queued transactions are processed.
+func (b *Broadcaster) Results() <-chan BroadcastResult {
+	return b.results
+}
+
+// Broadcast queues a transaction with the given messages, signed by the key
+// with the given name, and returns the ID of its result. It blocks while the
+// queue of the key is full, until the Broadcaster is closed.
+func (b *Broadcaster) Broadcast(name string, msgs ...sdk.Msg) (uint64, error) {
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, err
+		}
+	}
+
+	w, id, err := b.queueWorker(name)
+	if err != nil {
+		return 0, err
+	}
+	defer b.sending.Done()
+
+	select {
+	case w.queue <- broadcastRequest{id: id, msgs: msgs}:
+		return id, nil
+	case <-b.closing:
+		return 0, ErrBroadcasterClosed
+	}
+}
+
+// queueWorker returns the worker of the key with the given name, starting it
+// if needed, along with the ID of a new transaction. On success, the caller
+// is registered in sending and must queue the transaction before calling
+// sending.Done.
+func (b *Broadcaster) queueWorker(name string) (*broadcastWorker, uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, 0, ErrBroadcasterClosed
+	}
+
+	w, ok := b.workers[name]
+	if !ok {
+		b.signMu.Lock()
+		record, err := b.txf.Keybase().Key(name)
+		b.signMu.Unlock()
+		if err != nil {
+			return nil, 0, err
+		}
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		w = &broadcastWorker{
+			name:    name,
+			address: addr,
+			queue:   make(chan broadcastRequest, b.queueSize),
+		}
+		b.workers[name] = w
+
+		b.wg.Add(1)
+		go b.run(w)
+	}
+
+	b.nextID++
+	b.sending.Add(1)
+
+	return w, b.nextID, nil
+}
+
+// Close stops accepting transactions and waits for the queued ones to be
+// processed, after which the Results channel is closed. The callers blocked
+// on a full queue return ErrBroadcasterClosed.
+func (b *Broadcaster) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	close(b.closing)
+	b.mu.Unlock()
+
+	// no transaction is queued once the pending callers return, and the
+	// workers are no longer modified
+	b.sending.Wait()
+	for _, w := range b.workers {
+		close(w.queue)
+	}
+
+	b.wg.Wait()
+	close(b.results)
+}
+
+// run processes the queue of a worker.
+func (b *Broadcaster) run(w *broadcastWorker) {
+	defer b.wg.Done()
+
+	for req := range w.queue {
+		b.results <- b.broadcast(w, req)
+	}
+}
+
+// broadcast broadcasts a transaction, retrying it after account sequence
+// mismatches.
+func (b *Broadcaster) broadcast(w *broadcastWorker, req broadcastRequest) BroadcastResult {
+	res := BroadcastResult{ID: req.id, From: w.name}
+
+	for attempt := 0; ; attempt++ {
+		if !w.synced {
+			if err := b.sync(w); err != nil {
+				res.Err = err
+				return res
+			}
+		}
+
+		res.Sequence = w.sequence
+		txRes, err := b.signAndBroadcast(w, req.msgs)
+		res.Response, res.Err = txRes, err
+
+		var mismatchLog string
+		switch {
+		case err != nil:
+			mismatchLog = err.Error()
+		case txRes.Code == sdkerrors.ErrWrongSequence.ABCICode() && txRes.Codespace == sdkerrors.ErrWrongSequence.Codespace():
+			mismatchLog = txRes.RawLog
+		}
+
+		if matches := sequenceMismatchRegexp.FindStringSubmatch(mismatchLog); matches != nil {
+			if attempt >= b.maxRetries {
+				w.synced = false
+				return res
+			}
+
+			if err := b.sync(w); err != nil {
+				res.Err = err
+				return res
+			}
+			// the queried sequence doesn't account for the pending
+			// transactions of the node, unlike the expected one
+			if expected, err := strconv.ParseUint(matches[1], 10, 64); err == nil && expected > w.sequence {
+				w.sequence = expected
+			}
+			continue
+		}
+
+		switch {
+		case err != nil:
+			// the transaction may or may not have reached the node
+			w.synced = false
+		case txRes.Code == 0:
+			w.sequence++
+		}
+
+		return res
+	}
+}
+
+// sync queries the account number and sequence of a worker.
+func (b *Broadcaster) sync(w *broadcastWorker) error {
+	accountNumber, sequence, err := b.txf.AccountRetriever().GetAccountNumberSequence(b.clientCtx, w.address)
+	if err != nil {
+		return fmt.Errorf("failed to query the account of %s: %w", w.name, err)
+	}
+
+	w.accountNumber, w.sequence, w.synced = accountNumber, sequence, true
+
+	return nil
+}
+
+// signAndBroadcast builds, signs and broadcasts a transaction with the
+// current sequence of a worker.
+func (b *Broadcaster) signAndBroadcast(w *broadcastWorker, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	b.gasPricesOnce.Do(func() {
+		b.preparedTxf, b.gasPricesErr = b.txf.PrepareGasPrices(b.clientCtx)
+	})
+	if b.gasPricesErr != nil {
+		return nil, b.gasPricesErr
+	}
+
+	txf := b.preparedTxf.WithAccountNumber(w.accountNumber).WithSequence(w.sequence)
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(b.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	tx, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	tx.SetFeeGranter(b.clientCtx.GetFeeGranterAddress())
+
+	b.signMu.Lock()
+	err = Sign(txf, w.name, tx, true)
+	b.signMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return b.clientCtx.BroadcastTx(txBytes)
+}
@@ -0,0 +1,205 @@
+package tx_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBroadcaster(t *testing.T) {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	n, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer n.Cleanup()
+
+	_, err = n.WaitForHeight(1)
+	require.NoError(t, err)
+
+	val := n.Validators[0]
+	clientCtx := val.ClientCtx
+	valRecord, err := clientCtx.Keyring.KeyByAddress(val.Address)
+	require.NoError(t, err)
+	newRecord, _, err := clientCtx.Keyring.NewMnemonic("broadcaster", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	newAddr, err := newRecord.GetAddress()
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithTxConfig(clientCtx.TxConfig).
+		WithKeybase(clientCtx.Keyring).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithGas(200000).
+		WithFees(sdk.NewInt64Coin(cfg.BondDenom, 10).String())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 1000))
+
+	// collect returns the next n results, which must be successful
+	collect := func(b *tx.Broadcaster, n int) []tx.BroadcastResult {
+		results := make([]tx.BroadcastResult, n)
+		for i := range results {
+			results[i] = <-b.Results()
+			require.NoError(t, results[i].Err)
+			require.Equal(t, uint32(0), results[i].Response.Code, results[i].Response.RawLog)
+		}
+		return results
+	}
+
+	b := tx.NewBroadcaster(clientCtx, txf, 10, 3)
+
+	// the transactions of a key are pipelined with sequential sequences
+	for i := 0; i < 5; i++ {
+		_, err := b.Broadcast(valRecord.Name, banktypes.NewMsgSend(val.Address, newAddr, coins))
+		require.NoError(t, err)
+	}
+	results := collect(b, 5)
+	for i := 1; i < len(results); i++ {
+		require.Equal(t, results[0].Sequence+uint64(i), results[i].Sequence)
+	}
+	require.NoError(t, n.WaitForNextBlock())
+
+	// a transaction sent by another broadcaster makes the local sequence
+	// stale, which is recovered from
+	other := tx.NewBroadcaster(clientCtx, txf, 10, 3)
+	_, err = other.Broadcast(valRecord.Name, banktypes.NewMsgSend(val.Address, newAddr, coins))
+	require.NoError(t, err)
+	otherResults := collect(other, 1)
+	other.Close()
+
+	_, err = b.Broadcast(valRecord.Name, banktypes.NewMsgSend(val.Address, newAddr, coins.Add(coins...)))
+	require.NoError(t, err)
+	results = collect(b, 1)
+	require.Equal(t, otherResults[0].Sequence+1, results[0].Sequence)
+
+	// the transactions of multiple keys are broadcast concurrently
+	for i := 0; i < 3; i++ {
+		_, err := b.Broadcast(valRecord.Name, banktypes.NewMsgSend(val.Address, newAddr, coins))
+		require.NoError(t, err)
+		_, err = b.Broadcast(newRecord.Name, banktypes.NewMsgSend(newAddr, val.Address, coins))
+		require.NoError(t, err)
+	}
+	results = collect(b, 6)
+
+	seen := make(map[uint64]bool)
+	for _, res := range results {
+		require.False(t, seen[res.ID])
+		seen[res.ID] = true
+	}
+
+	b.Close()
+	_, ok := <-b.Results()
+	require.False(t, ok)
+
+	_, err = b.Broadcast(valRecord.Name, banktypes.NewMsgSend(val.Address, newAddr, coins))
+	require.ErrorIs(t, err, tx.ErrBroadcasterClosed)
+}
+
+// blockingAccountRetriever fails to query the accounts, after waiting for
+// release to be closed for the blocked address.
+type blockingAccountRetriever struct {
+	client.TestAccountRetriever
+
+	blocked sdk.AccAddress
+	release chan struct{}
+}
+
+func (r blockingAccountRetriever) GetAccountNumberSequence(_ client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	if addr.Equals(r.blocked) {
+		<-r.release
+	}
+
+	return 0, 0, fmt.Errorf("account %s not found", addr)
+}
+
+func TestBroadcasterSaturatedQueue(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	kr := keyring.NewInMemory(encCfg.Codec)
+
+	newAddr := func(name string) sdk.AccAddress {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		return addr
+	}
+	slowAddr, fastAddr := newAddr("slow"), newAddr("fast")
+
+	retriever := blockingAccountRetriever{blocked: slowAddr, release: make(chan struct{})}
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kr).
+		WithAccountRetriever(retriever)
+	clientCtx := client.Context{}.WithTxConfig(encCfg.TxConfig)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	slowMsg := banktypes.NewMsgSend(slowAddr, fastAddr, coins)
+	fastMsg := banktypes.NewMsgSend(fastAddr, slowAddr, coins)
+
+	b := tx.NewBroadcaster(clientCtx, txf, 1, 0)
+
+	// the worker of the slow key is stuck on its first transaction and its
+	// queue holds the second one, so the third one blocks
+	for i := 0; i < 2; i++ {
+		_, err := b.Broadcast("slow", slowMsg)
+		require.NoError(t, err)
+	}
+	blockedErr := make(chan error, 1)
+	go func() {
+		_, err := b.Broadcast("slow", slowMsg)
+		blockedErr <- err
+	}()
+	require.Never(t, func() bool { return len(blockedErr) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	// the other key isn't blocked by the saturated queue
+	fastID := make(chan uint64, 1)
+	go func() {
+		id, err := b.Broadcast("fast", fastMsg)
+		require.NoError(t, err)
+		fastID <- id
+	}()
+	select {
+	case id := <-fastID:
+		res := <-b.Results()
+		require.Equal(t, id, res.ID)
+		require.Equal(t, "fast", res.From)
+		require.Error(t, res.Err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("broadcast of another key blocked by a full queue")
+	}
+
+	// closing doesn't wait for the caller blocked on the full queue
+	closed := make(chan struct{})
+	go func() {
+		b.Close()
+		close(closed)
+	}()
+	select {
+	case err := <-blockedErr:
+		require.ErrorIs(t, err, tx.ErrBroadcasterClosed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("broadcast blocked on a full queue after close")
+	}
+
+	// the queued transactions are processed before the results are closed
+	close(retriever.release)
+	var slowResults int
+	for res := range b.Results() {
+		require.Equal(t, "slow", res.From)
+		slowResults++
+	}
+	require.Equal(t, 2, slowResults)
+	<-closed
+}
@@ -0,0 +1,203 @@
+package tx
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BatchLimits are the limits of the transactions built from a list of
+// messages by BatchMsgs. A zero limit is not enforced.
+type BatchLimits struct {
+	// MaxGas is the maximum gas limit of a transaction.
+	MaxGas uint64
+
+	// MaxBytes is the maximum size of an encoded transaction. As it is checked
+	// before the transactions are signed, the signed transactions are slightly
+	// larger.
+	MaxBytes uint64
+}
+
+// MsgBatch is a batch of messages sent in a single transaction.
+type MsgBatch struct {
+	Msgs []sdk.Msg
+
+	// Gas is the gas limit of the transaction, estimated by simulating it.
+	Gas uint64
+}
+
+// BatchMsgs splits the messages, in order, into batches whose transactions
+// don't exceed the limits. The gas of each message is estimated by simulating
+// it alone, which overestimates the gas of the batches, and the gas limit of
+// each batch is then set by simulating its transaction.
+func BatchMsgs(clientCtx client.Context, txf Factory, limits BatchLimits, msgs []sdk.Msg) ([]MsgBatch, error) {
+	if clientCtx.Offline {
+		return nil, fmt.Errorf("cannot estimate gas in offline mode")
+	}
+
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		batches  []MsgBatch
+		batch    []sdk.Msg
+		batchGas uint64
+	)
+
+	for i, msg := range msgs {
+		_, gas, err := CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate message #%d: %w", i, err)
+		}
+		if limits.MaxGas > 0 && gas > limits.MaxGas {
+			return nil, sdkerrors.ErrOutOfGas.Wrapf("message #%d needs %d gas, more than the limit of %d", i, gas, limits.MaxGas)
+		}
+
+		fits, err := batchFits(txf, limits, append(batch, msg), batchGas+gas)
+		if err != nil {
+			return nil, err
+		}
+		if !fits && len(batch) > 0 {
+			batches = append(batches, MsgBatch{Msgs: batch})
+			batch, batchGas = nil, 0
+		}
+
+		batch = append(batch, msg)
+		batchGas += gas
+
+		if fits, err := batchFits(txf, limits, batch, batchGas); err != nil {
+			return nil, err
+		} else if !fits {
+			return nil, sdkerrors.ErrTxTooLarge.Wrapf("message #%d exceeds the limit of %d bytes", i, limits.MaxBytes)
+		}
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, MsgBatch{Msgs: batch})
+	}
+
+	for i := range batches {
+		_, gas, err := CalculateGas(clientCtx, txf, batches[i].Msgs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate transaction #%d: %w", i, err)
+		}
+		batches[i].Gas = gas
+	}
+
+	return batches, nil
+}
+
+// batchFits returns true if the transaction of a batch of messages with the
+// given estimated gas doesn't exceed the limits.
+func batchFits(txf Factory, limits BatchLimits, msgs []sdk.Msg, gas uint64) (bool, error) {
+	if limits.MaxGas > 0 && gas > limits.MaxGas {
+		return false, nil
+	}
+
+	if limits.MaxBytes > 0 {
+		txBytes, err := txf.WithGas(gas).BuildSimTx(msgs...)
+		if err != nil {
+			return false, err
+		}
+		if uint64(len(txBytes)) > limits.MaxBytes {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// GenerateOrBroadcastMsgBatches either prints the unsigned transactions of the
+// batches, one per line, or signs and broadcasts them with sequential account
+// sequences. The broadcast stops at the first transaction which fails.
+func GenerateOrBroadcastMsgBatches(clientCtx client.Context, txf Factory, batches []MsgBatch) error {
+	for _, batch := range batches {
+		for _, msg := range batch.Msgs {
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the gas prices are estimated once for all the transactions
+	txf, err := txf.PrepareGasPrices(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		for _, batch := range batches {
+			if err := txf.WithGas(batch.Gas).WithSimulateAndExecute(false).PrintUnsignedTx(clientCtx, batch.Msgs...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	txs := make([]client.TxBuilder, len(batches))
+	for i, batch := range batches {
+		txs[i], err = txf.WithGas(batch.Gas).BuildUnsignedTx(batch.Msgs...)
+		if err != nil {
+			return err
+		}
+		txs[i].SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	}
+
+	if !clientCtx.SkipConfirm {
+		for _, tx := range txs {
+			out, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", out)
+		}
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation(
+			fmt.Sprintf("confirm %d transactions before signing and broadcasting", len(txs)), buf, os.Stderr,
+		)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transactions")
+			return err
+		}
+	}
+
+	sequence := txf.Sequence()
+	for i, tx := range txs {
+		if err := Sign(txf.WithSequence(sequence+uint64(i)), clientCtx.GetFromName(), tx, true); err != nil {
+			return err
+		}
+
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+		if err != nil {
+			return err
+		}
+
+		res, err := clientCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+
+		if err := clientCtx.PrintProto(res); err != nil {
+			return err
+		}
+
+		if res.Code != 0 {
+			return fmt.Errorf("transaction #%d failed with code %d, the remaining %d transactions were not broadcast", i, res.Code, len(txs)-i-1)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,6 +31,7 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	gasPricesAuto      bool
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -44,6 +46,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -91,6 +95,10 @@ func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 
+// GasPricesAuto returns whether the gas prices are estimated from the gas prices
+// paid in the last blocks, with EstimateGasPrices.
+func (f Factory) GasPricesAuto() bool { return f.gasPricesAuto }
+
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
@@ -130,14 +138,22 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
-// WithGasPrices returns a copy of the Factory with updated gas prices.
+// WithGasPrices returns a copy of the Factory with updated gas prices. If the
+// gas prices are "auto", they are estimated before the transaction is built.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
+	if gasPrices == flags.GasFlagAuto {
+		f.gasPrices = nil
+		f.gasPricesAuto = true
+		return f
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
 	}
 
 	f.gasPrices = parsedGasPrices
+	f.gasPricesAuto = false
 	return f
 }
 
@@ -202,6 +218,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if f.gasPricesAuto {
+		return nil, errors.New("gas prices must be estimated with PrepareGasPrices before building the transaction")
+	}
+
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -240,6 +260,11 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	f, err := f.PrepareGasPrices(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -294,8 +319,9 @@ func (f Factory) BuildSimTx(msgs ...sdk.Msg) ([]byte, error) {
 
 // Prepare ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
+// they will be queried for and set on the provided Factory. The "auto" gas
+// prices are estimated with PrepareGasPrices. A new Factory with the updated
+// fields will be returned.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	fc := f
 
@@ -321,5 +347,25 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 		}
 	}
 
-	return fc, nil
+	return fc.PrepareGasPrices(clientCtx)
+}
+
+// PrepareGasPrices returns a copy of the Factory whose "auto" gas prices are
+// replaced by the gas prices estimated with EstimateGasPrices. The Factory is
+// returned as is if its gas prices are set.
+func (f Factory) PrepareGasPrices(clientConn gogogrpc.ClientConn) (Factory, error) {
+	if !f.gasPricesAuto {
+		return f, nil
+	}
+
+	if clientCtx, ok := clientConn.(client.Context); ok && clientCtx.Offline {
+		return f, errors.New("cannot estimate gas prices in offline mode")
+	}
+
+	gasPrices, err := EstimateGasPrices(clientConn)
+	if err != nil {
+		return f, err
+	}
+
+	return f.WithGasPrices(gasPrices.String()), nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/feeestimate"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -117,6 +118,11 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 func CalculateGas(
 	clientCtx gogogrpc.ClientConn, txf Factory, msgs ...sdk.Msg,
 ) (*tx.SimulateResponse, uint64, error) {
+	txf, err := txf.PrepareGasPrices(clientCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	txBytes, err := txf.BuildSimTx(msgs...)
 	if err != nil {
 		return nil, 0, err
@@ -133,6 +139,25 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// EstimateGasPrices queries the fee estimate of the gas prices paid in the last
+// blocks, and returns the median gas price of the fee denom used by the most
+// transactions.
+func EstimateGasPrices(clientCtx gogogrpc.ClientConn) (sdk.DecCoins, error) {
+	res, err := feeestimate.NewServiceClient(clientCtx).FeeEstimate(context.Background(), &feeestimate.FeeEstimateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Estimates) == 0 {
+		return nil, errors.New("no gas prices were paid in the last blocks, the gas prices must be provided")
+	}
+	if !res.Estimates[0].Median.IsPositive() {
+		return nil, fmt.Errorf("the estimated gas price of %s is zero, the gas prices must be provided", res.Estimates[0].Denom)
+	}
+
+	return sdk.DecCoins{sdk.NewDecCoinFromDec(res.Estimates[0].Denom, res.Estimates[0].Median)}, nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -143,7 +168,7 @@ func SignWithPrivKey(
 	var sigV2 signing.SignatureV2
 
 	// Generate the bytes to be signed.
-	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	signBytes, err := authsigning.GetSignBytesWithContext(context.Background(), txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return sigV2, err
 	}
@@ -257,7 +282,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Generate the bytes to be signed.
-	bytesToSign, err := txf.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	bytesToSign, err := authsigning.GetSignBytesWithContext(context.Background(), txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/feeestimate"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -49,6 +50,52 @@ func (mockContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grp
 	panic("not implemented")
 }
 
+// mockFeeEstimateContext is a mock client.Context to return arbitrary gas price
+// estimates, used to unit test EstimateGasPrices.
+type mockFeeEstimateContext struct {
+	estimates []feeestimate.GasPriceEstimate
+}
+
+func (m mockFeeEstimateContext) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
+	*(reply.(*feeestimate.FeeEstimateResponse)) = feeestimate.FeeEstimateResponse{Estimates: m.estimates}
+
+	return nil
+}
+
+func (mockFeeEstimateContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestEstimateGasPrices(t *testing.T) {
+	testCases := []struct {
+		name      string
+		estimates []feeestimate.GasPriceEstimate
+		expPrices sdk.DecCoins
+		expErr    bool
+	}{
+		{"no estimate", nil, nil, true},
+		{"zero median", []feeestimate.GasPriceEstimate{{Denom: "stake", Median: sdk.ZeroDec()}}, nil, true},
+		{
+			"most used denom",
+			[]feeestimate.GasPriceEstimate{{Denom: "stake", Median: sdk.NewDecWithPrec(25, 3)}, {Denom: "atom", Median: sdk.OneDec()}},
+			sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3))},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prices, err := tx.EstimateGasPrices(mockFeeEstimateContext{estimates: tc.estimates})
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expPrices, prices)
+			}
+		})
+	}
+}
+
 func TestCalculateGas(t *testing.T) {
 	type args struct {
 		mockGasUsed uint64
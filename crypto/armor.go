@@ -3,11 +3,13 @@ package crypto
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/tendermint/crypto/bcrypt"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/armor"
 	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	"golang.org/x/crypto/argon2"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -19,6 +21,8 @@ const (
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
 
+	blockTypeKeyringArchive = "TENDERMINT KEYRING ARCHIVE"
+
 	defaultAlgo = "secp256k1"
 
 	headerVersion = "version"
@@ -40,6 +44,15 @@ const (
 // For further notes on security parameter choice, see README.md
 var BcryptSecurityParameter = 12
 
+// Argon2 parameters of the key derived from the passphrase of a keyring
+// archive, as recommended by the argon2 package for Argon2id.
+const (
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	argon2KeyLen  = 32
+)
+
 //-----------------------------------------------------------------
 // add armor
 
@@ -207,3 +220,57 @@ func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privK
 
 	return legacy.PrivKeyFromBytes(privKeyBytes)
 }
+
+//-----------------------------------------------------------------
+// encrypt/decrypt keyring archives with armor
+
+// EncryptArmorKeyringArchive encrypts the serialized records of a keyring
+// archive with the xsalsa20 cipher and a key derived from the passphrase with
+// Argon2id, and armors them.
+func EncryptArmorKeyringArchive(bz []byte, passphrase string) string {
+	saltBytes := crypto.CRandBytes(16)
+	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	header := map[string]string{
+		"kdf":         "argon2id",
+		"salt":        fmt.Sprintf("%X", saltBytes),
+		headerVersion: "0.0.1",
+	}
+
+	return armor.EncodeArmor(blockTypeKeyringArchive, header, xsalsa20symmetric.EncryptSymmetric(bz, key))
+}
+
+// UnarmorDecryptKeyringArchive returns the serialized records of an armored
+// keyring archive encrypted with the passphrase.
+func UnarmorDecryptKeyringArchive(armorStr string, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeKeyringArchive)
+	if err != nil {
+		return nil, err
+	}
+
+	if header[headerVersion] != "0.0.1" {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+
+	if header["kdf"] != "argon2id" {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+
+	if header["salt"] == "" {
+		return nil, fmt.Errorf("missing salt bytes")
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding salt: %v", err.Error())
+	}
+
+	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	if err != nil && strings.EqualFold(err.Error(), "ciphertext decryption failed") {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	return bz, err
+}
@@ -21,6 +21,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestArmorUnarmorPrivKey(t *testing.T) {
@@ -69,6 +70,28 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorKeyringArchive(t *testing.T) {
+	bz := []byte("records")
+	armored := crypto.EncryptArmorKeyringArchive(bz, "passphrase")
+
+	_, err := crypto.UnarmorDecryptKeyringArchive(armored, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	decrypted, err := crypto.UnarmorDecryptKeyringArchive(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, bz, decrypted)
+
+	// wrong armor type
+	_, err = crypto.UnarmorDecryptKeyringArchive(crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", ""), "passphrase")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognized armor type")
+
+	// wrong kdf header
+	armored = armor.EncodeArmor("TENDERMINT KEYRING ARCHIVE", map[string]string{"kdf": "bcrypt", "salt": "00", "version": "0.0.1"}, bz)
+	_, err = crypto.UnarmorDecryptKeyringArchive(armored, "passphrase")
+	require.EqualError(t, err, "unrecognized KDF type: bcrypt")
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	encCfg := simapp.MakeTestEncodingConfig()
@@ -1,9 +1,13 @@
 package hd
 
 import (
+	"crypto/ed25519"
+
 	"github.com/cosmos/go-bip39"
 
+	cosmosed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -15,8 +19,10 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is currently not supported for ledgers.
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
@@ -25,6 +31,11 @@ const (
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters, and derives the keys with
+	// SLIP-10.
+	Secp256r1 = secp256r1Algo{}
+	// Ed25519 derives the ed25519 keys with SLIP-10, through hardened levels only.
+	Ed25519 = ed25519Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +80,65 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and HD path.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return slip10Nist256p1.derivePrivateKeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		priv, err := secp256r1.NewPrivKeyFromSecret(bz)
+		if err != nil {
+			// the derived keys are always valid
+			panic(err)
+		}
+
+		return priv
+	}
+}
+
+type ed25519Algo struct {
+}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the ed25519 seed of the private key for the given
+// seed and HD path.
+func (s ed25519Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return slip10Ed25519.derivePrivateKeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates an ed25519 private key from the given seed.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		var seed = make([]byte, ed25519.SeedSize)
+		copy(seed, bz)
+
+		return &cosmosed25519.PrivKey{Key: ed25519.NewKeyFromSeed(seed)}
+	}
+}
@@ -166,22 +166,44 @@ func ComputeMastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	indexes, hardened, err := pathIndexes(path)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	data := privKeyBytes
+	for i, idx := range indexes {
+		data, chainCode = derivePrivateKey(data, chainCode, idx, hardened[i])
+	}
+
+	derivedKey := make([]byte, 32)
+	n := copy(derivedKey, data[:])
+
+	if n != 32 || len(data) != 32 {
+		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+	}
+
+	return derivedKey, nil
+}
+
+// pathIndexes returns the indexes of the levels of a BIP 32 path, and whether
+// each level is hardened.
+func pathIndexes(path string) (indexes []uint32, hardened []bool, err error) {
 	// First step is to trim the right end path separator lest we panic.
 	// See issue https://github.com/cosmos/cosmos-sdk/issues/8557
 	path = strings.TrimRightFunc(path, func(r rune) bool { return r == filepath.Separator })
-	data := privKeyBytes
 	parts := strings.Split(path, "/")
 
 	switch {
 	case parts[0] == path:
-		return nil, fmt.Errorf("path '%s' doesn't contain '/' separators", path)
+		return nil, nil, fmt.Errorf("path '%s' doesn't contain '/' separators", path)
 	case strings.TrimSpace(parts[0]) == "m":
 		parts = parts[1:]
 	}
 
 	for i, part := range parts {
 		if part == "" {
-			return nil, fmt.Errorf("path %q with split element #%d is an empty string", part, i)
+			return nil, nil, fmt.Errorf("path %q with split element #%d is an empty string", part, i)
 		}
 		// do we have an apostrophe?
 		harden := part[len(part)-1:] == "'"
@@ -195,20 +217,14 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		// index values are in the range [0, 1<<31-1] aka [0, max(int32)]
 		idx, err := strconv.ParseUint(part, 10, 31)
 		if err != nil {
-			return []byte{}, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
+			return nil, nil, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
 		}
 
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
-	}
-
-	derivedKey := make([]byte, 32)
-	n := copy(derivedKey, data[:])
-
-	if n != 32 || len(data) != 32 {
-		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+		indexes = append(indexes, uint32(idx))
+		hardened = append(hardened, harden)
 	}
 
-	return derivedKey, nil
+	return indexes, hardened, nil
 }
 
 // derivePrivateKey derives the private key with index and chainCode.
@@ -0,0 +1,99 @@
+package hd
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+// slip10Curve is a curve of the SLIP-10 derivation scheme, which generalizes
+// BIP 32 to other curves than secp256k1. For more information on SLIP-10 see
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+type slip10Curve struct {
+	// seedKey is the HMAC key of the master key derivation.
+	seedKey []byte
+	// curve is nil for ed25519, whose keys can only be derived through hardened
+	// levels and don't need to be reduced modulo the curve order.
+	curve elliptic.Curve
+}
+
+var (
+	slip10Nist256p1 = slip10Curve{seedKey: []byte("Nist256p1 seed"), curve: elliptic.P256()}
+	slip10Ed25519   = slip10Curve{seedKey: []byte("ed25519 seed")}
+)
+
+// computeMastersFromSeed returns the master secret key, and chain code.
+func (c slip10Curve) computeMastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
+	secret, chainCode = i64(c.seedKey, seed)
+	for c.curve != nil && !c.isValidScalar(secret[:]) {
+		I := append(secret[:], chainCode[:]...)
+		secret, chainCode = i64(c.seedKey, I)
+	}
+
+	return
+}
+
+// derivePrivateKeyForPath derives the private key by following the path from
+// the master key of the seed. As ed25519 only supports hardened derivation, all
+// the levels of the path are hardened for ed25519.
+func (c slip10Curve) derivePrivateKeyForPath(seed []byte, path string) ([]byte, error) {
+	data, chainCode := c.computeMastersFromSeed(seed)
+	if len(path) == 0 {
+		return data[:], nil
+	}
+
+	indexes, hardened, err := pathIndexes(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, idx := range indexes {
+		data, chainCode = c.derivePrivateKey(data, chainCode, idx, hardened[i] || c.curve == nil)
+	}
+
+	return data[:], nil
+}
+
+// derivePrivateKey derives the private key with index and chainCode.
+// If harden is true, the derivation is 'hardened'.
+// It returns the new private key and new chain code.
+func (c slip10Curve) derivePrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte) {
+	var data []byte
+
+	if harden {
+		index |= 0x80000000
+
+		data = append([]byte{byte(0)}, privKeyBytes[:]...)
+	} else {
+		x, y := c.curve.ScalarBaseMult(privKeyBytes[:])
+		data = elliptic.MarshalCompressed(c.curve, x, y)
+	}
+
+	data = append(data, uint32ToBytes(index)...)
+	il, ir := i64(chainCode[:], data)
+	if c.curve == nil {
+		return il, ir
+	}
+
+	for {
+		if c.isValidScalar(il[:]) {
+			x := new(big.Int).Add(new(big.Int).SetBytes(il[:]), new(big.Int).SetBytes(privKeyBytes[:]))
+			x.Mod(x, c.curve.Params().N)
+			if x.Sign() != 0 {
+				var key [32]byte
+				x.FillBytes(key[:])
+
+				return key, ir
+			}
+		}
+
+		data = append(append([]byte{byte(1)}, ir[:]...), uint32ToBytes(index)...)
+		il, ir = i64(chainCode[:], data)
+	}
+}
+
+// isValidScalar returns whether bz is a valid private key of the curve, i.e.
+// it isn't 0 and is lower than the curve order.
+func (c slip10Curve) isValidScalar(bz []byte) bool {
+	k := new(big.Int).SetBytes(bz)
+	return k.Sign() != 0 && k.Cmp(c.curve.Params().N) < 0
+}
@@ -0,0 +1,39 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test vector 1 of https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func TestSLIP10TestVectors(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		curve    slip10Curve
+		path     string
+		expected string
+	}{
+		{slip10Nist256p1, "", "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"},
+		{slip10Nist256p1, "m/0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{slip10Nist256p1, "m/0'/1", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+		{slip10Ed25519, "", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{slip10Ed25519, "m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{slip10Ed25519, "m/0'/1'", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{slip10Ed25519, "m/0'/1'/2'", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+		// the levels are hardened for ed25519
+		{slip10Ed25519, "m/0/1/2", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+	}
+
+	for _, tc := range testCases {
+		key, err := tc.curve.derivePrivateKeyForPath(seed, tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.expected, hex.EncodeToString(key), tc.path)
+	}
+
+	_, err = slip10Nist256p1.derivePrivateKeyForPath(seed, "m/0'/x")
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package keyring
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *RecordArchive) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, k := range a.Records {
+		if err := k.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportArchive returns the records of all the keys of a keyring, including
+// the private keys of the local keys, the paths of the ledger keys and the
+// public keys of the offline and multisig keys, in an armored archive
+// encrypted with the passphrase.
+func ExportArchive(kr Keyring, cdc codec.Codec, passphrase string) (string, error) {
+	records, err := kr.List()
+	if err != nil {
+		return "", err
+	}
+	if len(records) == 0 {
+		return "", errors.New("the keyring holds no keys")
+	}
+
+	bz, err := cdc.Marshal(&RecordArchive{Records: records})
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorKeyringArchive(bz, passphrase), nil
+}
+
+// ReadArchive returns the records of an armored archive encrypted with the
+// passphrase, which can be imported with Keyring.ImportRecord.
+func ReadArchive(cdc codec.Codec, armor, passphrase string) ([]*Record, error) {
+	bz, err := crypto.UnarmorDecryptKeyringArchive(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var archive RecordArchive
+	if err := cdc.Unmarshal(bz, &archive); err != nil {
+		return nil, err
+	}
+
+	return archive.Records, nil
+}
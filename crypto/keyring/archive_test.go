@@ -0,0 +1,69 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestExportImportArchive(t *testing.T) {
+	cdc := getCodec()
+	kr := NewInMemory(cdc)
+
+	local, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	ledger, err := NewLedgerRecord("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(1, sdk.CoinType, 2))
+	require.NoError(t, err)
+	require.NoError(t, kr.ImportRecord(ledger))
+	_, err = kr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = kr.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(
+		1, []types.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()},
+	))
+	require.NoError(t, err)
+
+	// the records can't be imported twice
+	require.Error(t, kr.ImportRecord(ledger))
+
+	armor, err := ExportArchive(kr, cdc, "passphrase")
+	require.NoError(t, err)
+
+	_, err = ReadArchive(cdc, armor, "wrong")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	records, err := ReadArchive(cdc, armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	imported := NewInMemory(cdc)
+	for _, k := range records {
+		require.NoError(t, imported.ImportRecord(k))
+	}
+
+	expected, err := kr.List()
+	require.NoError(t, err)
+	actual, err := imported.List()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	// the local keys can sign after the import
+	msg := []byte("message")
+	sig, pk, err := imported.Sign("local", msg)
+	require.NoError(t, err)
+	localPk, err := local.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pk.Equals(localPk))
+	require.True(t, localPk.VerifySignature(msg, sig))
+
+	// the ledger path is preserved
+	k, err := imported.Key("ledger")
+	require.NoError(t, err)
+	require.Equal(t, ledger.GetLedger().Path, k.GetLedger().Path)
+}
@@ -43,4 +43,9 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	Same instance as returned by NewRemote. The keys are held by a signer on another host,
+// 			which implements the RemoteSigner gRPC service, e.g. with NewRemoteSignerServer. The
+// 			address of the signer is set with the WithRemoteSigner option. The connection is
+// 			secured with WithRemoteSignerTLS, the signer authenticating its clients with their
+// 			TLS certificates. The keys can only be listed and used to sign.
 package keyring
@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tendermint/crypto/bcrypt"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -34,6 +36,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -121,6 +124,10 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// ImportRecord imports a record, e.g. read from a keyring archive. It
+	// fails if a key with the same name or address already exists.
+	ImportRecord(k *Record) error
 }
 
 // Migrator is implemented by key stores and enables migration of  keys from amino to proto
@@ -156,6 +163,40 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// address of the signer of the remote backend
+	RemoteSignerAddr string
+	// dial options of the connection to the signer of the remote backend
+	RemoteSignerDialOptions []grpc.DialOption
+	// TLS configuration of the connection to the signer of the remote backend
+	RemoteSignerTLSConfig *tls.Config
+	// allow a plaintext connection to the signer of the remote backend
+	RemoteSignerInsecure bool
+}
+
+// WithRemoteSigner sets the address of the signer of the remote backend, and
+// the options to dial it.
+func WithRemoteSigner(addr string, dialOpts ...grpc.DialOption) Option {
+	return func(options *Options) {
+		options.RemoteSignerAddr = addr
+		options.RemoteSignerDialOptions = dialOpts
+	}
+}
+
+// WithRemoteSignerTLS sets the TLS configuration of the connection to the
+// signer of the remote backend, e.g. from RemoteSignerClientTLSConfig.
+func WithRemoteSignerTLS(config *tls.Config) Option {
+	return func(options *Options) {
+		options.RemoteSignerTLSConfig = config
+	}
+}
+
+// WithRemoteSignerInsecure allows a plaintext connection to the signer of the
+// remote backend. It must only be used when the connection is secured by other
+// means, e.g. a local socket or a VPN.
+func WithRemoteSignerInsecure() Option {
+	return func(options *Options) {
+		options.RemoteSignerInsecure = true
+	}
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -167,7 +208,8 @@ func NewInMemory(cdc codec.Codec, opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
+// The signer of the "remote" backend is set with the WithRemoteSigner option.
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -179,6 +221,8 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return dialRemote(cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -207,9 +251,14 @@ type keystore struct {
 }
 
 func newKeystore(kr keyring.Keyring, cdc codec.Codec, opts ...Option) keystore {
+	return keystore{kr, cdc, newOptions(opts...)}
+}
+
+// newOptions applies the options to the default options.
+func newOptions(opts ...Option) Options {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1, hd.Ed25519},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -217,7 +266,7 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, opts ...Option) keystore {
 		optionFn(&options)
 	}
 
-	return keystore{kr, cdc, options}
+	return options
 }
 
 func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
@@ -226,12 +275,17 @@ func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
 		return "", err
 	}
 
+	return armorPubKey(ks.cdc, k)
+}
+
+// armorPubKey returns the public key of a record in ASCII armored format.
+func armorPubKey(cdc codec.Codec, k *Record) (string, error) {
 	key, err := k.GetPubKey()
 	if err != nil {
 		return "", err
 	}
 
-	bz, err := ks.cdc.MarshalInterface(key)
+	bz, err := cdc.MarshalInterface(key)
 	if err != nil {
 		return "", err
 	}
@@ -325,6 +379,18 @@ func (ks keystore) ImportPubKey(uid string, armor string) error {
 	return nil
 }
 
+func (ks keystore) ImportRecord(k *Record) error {
+	if k.Name == "" {
+		return errors.New("the name of the record is empty")
+	}
+
+	if _, err := ks.Key(k.Name); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", k.Name)
+	}
+
+	return ks.writeRecord(k)
+}
+
 func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 	k, err := ks.Key(uid)
 	if err != nil {
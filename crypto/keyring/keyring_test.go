@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -1290,6 +1291,54 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAltKeyring_DefaultSupportedAlgos(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	msg := []byte("message")
+
+	testCases := []struct {
+		algo   SignatureAlgo
+		pubKey types.PubKey
+	}{
+		{hd.Secp256k1, &secp256k1.PubKey{}},
+		{hd.Secp256r1, &secp256r1.PubKey{}},
+		{hd.Ed25519, &ed25519.PubKey{}},
+	}
+
+	for _, tc := range testCases {
+		name := string(tc.algo.Name())
+		k, err := kr.NewAccount(name, mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, tc.algo)
+		require.NoError(t, err, name)
+
+		pubKey, err := k.GetPubKey()
+		require.NoError(t, err, name)
+		require.IsType(t, tc.pubKey, pubKey, name)
+
+		sig, _, err := kr.Sign(name, msg)
+		require.NoError(t, err, name)
+		require.True(t, pubKey.VerifySignature(msg, sig), name)
+
+		// the keys are derived deterministically from the mnemonic
+		derived, err := tc.algo.Derive()(mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath)
+		require.NoError(t, err, name)
+		require.True(t, tc.algo.Generate()(derived).PubKey().Equals(pubKey), name)
+
+		armor, err := kr.ExportPrivKeyArmor(name, "passphrase")
+		require.NoError(t, err, name)
+		require.NoError(t, kr.Delete(name), name)
+		require.NoError(t, kr.ImportPrivKey(name, armor, "passphrase"), name)
+
+		k, err = kr.Key(name)
+		require.NoError(t, err, name)
+		imported, err := k.GetPubKey()
+		require.NoError(t, err, name)
+		require.True(t, imported.Equals(pubKey), name)
+	}
+}
+
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
 	require.Equal(t, []keyring.BackendType{keyring.KWalletBackend}, backend.AllowedBackends)
@@ -295,12 +295,52 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// RecordArchive is a list of records exported from a keyring, which is
+// encrypted in a keyring archive.
+type RecordArchive struct {
+	Records []*Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *RecordArchive) Reset()         { *m = RecordArchive{} }
+func (m *RecordArchive) String() string { return proto.CompactTextString(m) }
+func (*RecordArchive) ProtoMessage()    {}
+func (*RecordArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{1}
+}
+func (m *RecordArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordArchive.Merge(m, src)
+}
+func (m *RecordArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordArchive proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*RecordArchive)(nil), "cosmos.crypto.keyring.v1.RecordArchive")
 }
 
 func init() {
@@ -308,34 +348,36 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x8b, 0xd4, 0x30,
-	0x18, 0xc7, 0x5b, 0xed, 0xb4, 0x4e, 0x16, 0x2f, 0x61, 0x0f, 0xb5, 0x48, 0x19, 0x16, 0xd4, 0x01,
-	0xd9, 0x84, 0xd5, 0x39, 0x2f, 0xec, 0xe0, 0x61, 0x16, 0x15, 0x97, 0xe0, 0x49, 0x84, 0xa5, 0x2f,
-	0x99, 0xb6, 0x4c, 0xdb, 0x84, 0x4c, 0x3b, 0x90, 0x2f, 0x21, 0x7e, 0xac, 0x3d, 0xee, 0xd1, 0xa3,
-	0xce, 0x7c, 0x11, 0xc9, 0x93, 0xf6, 0xe0, 0x82, 0x8e, 0xa7, 0xa6, 0xe4, 0xf7, 0xfc, 0x5f, 0x1e,
-	0x82, 0x5e, 0x64, 0x62, 0xdb, 0x88, 0x2d, 0xcd, 0x94, 0x96, 0x9d, 0xa0, 0x1b, 0xae, 0x55, 0xd5,
-	0x16, 0x74, 0x77, 0x41, 0x15, 0xcf, 0x84, 0xca, 0x89, 0x54, 0xa2, 0x13, 0x38, 0xb4, 0x18, 0xb1,
-	0x18, 0x19, 0x30, 0xb2, 0xbb, 0x88, 0x4e, 0x0b, 0x51, 0x08, 0x80, 0xa8, 0x39, 0x59, 0x3e, 0x7a,
-	0x56, 0x08, 0x51, 0xd4, 0x9c, 0xc2, 0x5f, 0xda, 0xaf, 0x69, 0xd2, 0xea, 0xe1, 0xea, 0xf9, 0x9f,
-	0x8e, 0x65, 0x6e, 0xcc, 0xca, 0xc1, 0xe8, 0xec, 0x9b, 0x87, 0x7c, 0x06, 0xce, 0x18, 0x23, 0xaf,
-	0x4d, 0x1a, 0x1e, 0xba, 0x33, 0x77, 0x3e, 0x65, 0x70, 0xc6, 0xe7, 0x28, 0x90, 0x7d, 0x7a, 0xbb,
-	0xe1, 0x3a, 0x7c, 0x34, 0x73, 0xe7, 0x27, 0x6f, 0x4e, 0x89, 0x75, 0x22, 0xa3, 0x13, 0xb9, 0x6a,
-	0x35, 0xf3, 0x65, 0x9f, 0xbe, 0xe7, 0x1a, 0x5f, 0xa2, 0x49, 0x2d, 0xb2, 0xa4, 0x0e, 0x1f, 0x03,
-	0xfc, 0x92, 0xfc, 0xad, 0x06, 0xb1, 0x9e, 0xe4, 0x83, 0xa1, 0x57, 0x0e, 0xb3, 0x63, 0xf8, 0x0a,
-	0xf9, 0x35, 0xcf, 0x0b, 0xae, 0x42, 0x0f, 0x04, 0x5e, 0x1d, 0x17, 0x00, 0x7c, 0xe5, 0xb0, 0x61,
-	0xd0, 0x44, 0x68, 0xfa, 0xba, 0xab, 0xc2, 0xc9, 0x7f, 0x46, 0xf8, 0x68, 0x68, 0x13, 0x01, 0xc6,
-	0xf0, 0x3b, 0x14, 0x88, 0xf5, 0xba, 0xae, 0x5a, 0x1e, 0xfa, 0xa0, 0x30, 0x3f, 0xaa, 0xf0, 0xc9,
-	0xf2, 0x2b, 0x87, 0x8d, 0xa3, 0xd1, 0x57, 0x34, 0x81, 0x6a, 0x98, 0xa2, 0x27, 0x52, 0x55, 0x3b,
-	0xd8, 0xa0, 0xfb, 0x8f, 0x0d, 0x06, 0x86, 0x32, 0x2b, 0x3c, 0x43, 0x4f, 0xc7, 0x81, 0xdb, 0x4e,
-	0x4b, 0x0e, 0x7b, 0x9f, 0xb2, 0x93, 0xe1, 0xfe, 0xb3, 0x96, 0x3c, 0xba, 0x44, 0xbe, 0xed, 0x8d,
-	0x17, 0xc8, 0x93, 0x49, 0x57, 0x0e, 0xd2, 0xb3, 0x07, 0x51, 0xcb, 0xdc, 0xa4, 0x5c, 0x5e, 0xdf,
-	0x2c, 0x16, 0x37, 0x89, 0x4a, 0x9a, 0x2d, 0x03, 0x3a, 0x0a, 0xd0, 0x04, 0x5a, 0x47, 0x53, 0x14,
-	0x0c, 0xe1, 0x97, 0x3e, 0xf2, 0xaa, 0x8e, 0x37, 0xcb, 0xeb, 0xbb, 0x5f, 0xb1, 0x73, 0xb7, 0x8f,
-	0xdd, 0xfb, 0x7d, 0xec, 0xfe, 0xdc, 0xc7, 0xee, 0xf7, 0x43, 0xec, 0xdc, 0x1f, 0x62, 0xe7, 0xc7,
-	0x21, 0x76, 0xbe, 0xbc, 0x2e, 0xaa, 0xae, 0xec, 0x53, 0x92, 0x89, 0x86, 0x8e, 0xef, 0x0a, 0x3e,
-	0xe7, 0xdb, 0x7c, 0xf3, 0xe0, 0x51, 0xa7, 0x3e, 0x34, 0x7c, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xe8, 0x29, 0x24, 0x50, 0xf4, 0x02, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcb, 0x6a, 0xdb, 0x40,
+	0x18, 0x85, 0xa5, 0x46, 0x96, 0xea, 0x3f, 0x64, 0x33, 0x64, 0xa1, 0x8a, 0x22, 0x8c, 0xa1, 0xad,
+	0xa1, 0x64, 0x86, 0xb4, 0x5e, 0x75, 0x11, 0xb0, 0xe9, 0xc2, 0x21, 0x2d, 0x0d, 0x43, 0x57, 0xa5,
+	0x10, 0x74, 0x19, 0x4b, 0xc2, 0x92, 0x46, 0x8c, 0x2e, 0xa0, 0x97, 0x28, 0x7d, 0xac, 0x2c, 0xb3,
+	0xec, 0xb2, 0xb5, 0x5f, 0xa4, 0xcc, 0x8c, 0xb4, 0x68, 0x7a, 0x49, 0x57, 0x1e, 0x33, 0xdf, 0xf9,
+	0xcf, 0xf9, 0x0f, 0x23, 0x78, 0x16, 0xf1, 0xba, 0xe0, 0x35, 0x89, 0x44, 0x5f, 0x35, 0x9c, 0xec,
+	0x58, 0x2f, 0xb2, 0x32, 0x21, 0xdd, 0x39, 0x11, 0x2c, 0xe2, 0x22, 0xc6, 0x95, 0xe0, 0x0d, 0x47,
+	0xae, 0xc6, 0xb0, 0xc6, 0xf0, 0x80, 0xe1, 0xee, 0xdc, 0x3b, 0x4d, 0x78, 0xc2, 0x15, 0x44, 0xe4,
+	0x49, 0xf3, 0xde, 0x93, 0x84, 0xf3, 0x24, 0x67, 0x44, 0xfd, 0x0b, 0xdb, 0x2d, 0x09, 0xca, 0x7e,
+	0xb8, 0x7a, 0xfa, 0xab, 0x63, 0x1a, 0x4b, 0xb3, 0x74, 0x30, 0x9a, 0x7f, 0xb1, 0xc0, 0xa6, 0xca,
+	0x19, 0x21, 0xb0, 0xca, 0xa0, 0x60, 0xae, 0x39, 0x33, 0x17, 0x53, 0xaa, 0xce, 0xe8, 0x0c, 0x9c,
+	0xaa, 0x0d, 0x6f, 0x76, 0xac, 0x77, 0x1f, 0xcd, 0xcc, 0xc5, 0xf1, 0xab, 0x53, 0xac, 0x9d, 0xf0,
+	0xe8, 0x84, 0x57, 0x65, 0x4f, 0xed, 0xaa, 0x0d, 0xaf, 0x58, 0x8f, 0x2e, 0x60, 0x92, 0xf3, 0x28,
+	0xc8, 0xdd, 0x23, 0x05, 0x3f, 0xc7, 0x7f, 0x5b, 0x03, 0x6b, 0x4f, 0xfc, 0x4e, 0xd2, 0x1b, 0x83,
+	0x6a, 0x19, 0x5a, 0x81, 0x9d, 0xb3, 0x38, 0x61, 0xc2, 0xb5, 0xd4, 0x80, 0x17, 0x0f, 0x0f, 0x50,
+	0xf8, 0xc6, 0xa0, 0x83, 0x50, 0x46, 0x28, 0xda, 0xbc, 0xc9, 0xdc, 0xc9, 0x7f, 0x46, 0x78, 0x2f,
+	0x69, 0x19, 0x41, 0xc9, 0xd0, 0x5b, 0x70, 0xf8, 0x76, 0x9b, 0x67, 0x25, 0x73, 0x6d, 0x35, 0x61,
+	0xf1, 0xe0, 0x84, 0x0f, 0x9a, 0xdf, 0x18, 0x74, 0x94, 0x7a, 0x9f, 0x61, 0xa2, 0x56, 0x43, 0x04,
+	0x1e, 0x57, 0x22, 0xeb, 0x54, 0x83, 0xe6, 0x3f, 0x1a, 0x74, 0x24, 0x25, 0x2b, 0x9c, 0xc3, 0xc9,
+	0x28, 0xb8, 0x69, 0xfa, 0x8a, 0xa9, 0xde, 0xa7, 0xf4, 0x78, 0xb8, 0xff, 0xd8, 0x57, 0xcc, 0xbb,
+	0x00, 0x5b, 0xef, 0x8d, 0x96, 0x60, 0x55, 0x41, 0x93, 0x0e, 0xa3, 0x67, 0xf7, 0xa2, 0xa6, 0xb1,
+	0x4c, 0xb9, 0xbe, 0xbc, 0x5e, 0x2e, 0xaf, 0x03, 0x11, 0x14, 0x35, 0x55, 0xb4, 0xe7, 0xc0, 0x44,
+	0x6d, 0xed, 0x4d, 0xc1, 0x19, 0xc2, 0xaf, 0x6d, 0xb0, 0xb2, 0x86, 0x15, 0xf3, 0x2b, 0x38, 0xd1,
+	0x6b, 0xad, 0x44, 0x94, 0x66, 0x1d, 0x43, 0x6f, 0xc0, 0xd1, 0x4f, 0xb3, 0x76, 0xcd, 0xd9, 0xd1,
+	0x1f, 0x5c, 0x7e, 0x2b, 0x84, 0x8e, 0x82, 0xf5, 0xe5, 0xed, 0x0f, 0xdf, 0xb8, 0xdd, 0xfb, 0xe6,
+	0xdd, 0xde, 0x37, 0xbf, 0xef, 0x7d, 0xf3, 0xeb, 0xc1, 0x37, 0xee, 0x0e, 0xbe, 0xf1, 0xed, 0xe0,
+	0x1b, 0x9f, 0x5e, 0x26, 0x59, 0x93, 0xb6, 0x21, 0x8e, 0x78, 0x41, 0xc6, 0x47, 0xaa, 0x7e, 0xce,
+	0xea, 0x78, 0x77, 0xef, 0x0b, 0x09, 0x6d, 0x55, 0xd7, 0xeb, 0x9f, 0x03, 0x00, 0x54, 0x43, 0x1b,
+	0x36, 0x41, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -596,6 +638,43 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecord(v)
 	base := offset
@@ -723,6 +802,21 @@ func (m *Record_Offline) Size() (n int) {
 	return n
 }
 
+func (m *RecordArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1291,6 +1385,90 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecordArchive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordArchive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordArchive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,405 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Keyring = remoteKeystore{}
+
+// remoteKeystore is a keyring whose keys are held by a remote signer, which
+// implements the RemoteSigner gRPC service. The keys are listed as offline
+// records, and the messages are signed by the remote signer. The keys can't be
+// created, imported, exported or deleted through the keyring.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+// NewRemote creates a keyring whose keys are held by the remote signer served
+// on the given connection.
+func NewRemote(conn grpc.ClientConnInterface, cdc codec.Codec, opts ...Option) Keyring {
+	return remoteKeystore{
+		client:  NewRemoteSignerClient(conn),
+		cdc:     cdc,
+		options: newOptions(opts...),
+	}
+}
+
+// dialRemote connects to the remote signer at the address of the options,
+// with their dial options. The connection is secured with the TLS configuration
+// of the options, and is only made in plaintext if explicitly allowed.
+func dialRemote(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	options := newOptions(opts...)
+	if options.RemoteSignerAddr == "" {
+		return nil, errors.New("the address of the remote signer is required by the remote keyring backend")
+	}
+
+	dialOpts := append([]grpc.DialOption{}, options.RemoteSignerDialOptions...)
+	switch {
+	case options.RemoteSignerTLSConfig != nil:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(options.RemoteSignerTLSConfig)))
+	case options.RemoteSignerInsecure:
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	default:
+		return nil, errors.New("the connection to the remote signer requires a TLS configuration, unless insecure connections are explicitly allowed")
+	}
+
+	conn, err := grpc.Dial(options.RemoteSignerAddr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the remote signer: %w", err)
+	}
+
+	return NewRemote(conn, cdc, opts...), nil
+}
+
+// errRemoteUnsupported returns the error of an operation which isn't supported
+// by the remote keyring.
+func errRemoteUnsupported(op string) error {
+	return fmt.Errorf("%s is not supported by the %s keyring backend", op, BackendRemote)
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	res, err := ks.client.Keys(context.Background(), &KeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, len(res.Keys))
+	for i, key := range res.Keys {
+		pk, err := unpackPubKey(ks.cdc, key.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key of %s: %w", key.Name, err)
+		}
+
+		records[i], err = NewOfflineRecord(key.Name, pk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	records, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range records {
+		if k.Name == uid {
+			return k, nil
+		}
+	}
+
+	return nil, sdkerrors.ErrKeyNotFound.Wrap(uid)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (*Record, error) {
+	records, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range records {
+		addr, err := k.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+		if addr.Equals(address) {
+			return k, nil
+		}
+	}
+
+	return nil, sdkerrors.ErrKeyNotFound.Wrap(fmt.Sprint("key with address ", address, " not found"))
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Name: uid, Msg: msg})
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Address: address.Bytes(), Msg: msg})
+}
+
+func (ks remoteKeystore) sign(req *SignRequest) ([]byte, types.PubKey, error) {
+	res, err := ks.client.Sign(context.Background(), req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pk, err := unpackPubKey(ks.cdc, res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return armorPubKey(ks.cdc, k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return armorPubKey(ks.cdc, k)
+}
+
+func (ks remoteKeystore) MigrateAll() (bool, error) {
+	return false, nil
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return errRemoteUnsupported("deleting keys")
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return errRemoteUnsupported("deleting keys")
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return errRemoteUnsupported("renaming keys")
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", errRemoteUnsupported("creating keys")
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, errRemoteUnsupported("creating keys")
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, errRemoteUnsupported("saving keys")
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, errRemoteUnsupported("saving keys")
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, errRemoteUnsupported("saving keys")
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportRecord(*Record) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+// RemoteSignerClientTLSConfig returns the TLS configuration of a connection to
+// a remote signer. The certificate of the signer is verified with the CA
+// certificates of caFile, or with the system ones if caFile is empty. The client
+// authenticates with the certificate of certFile and keyFile, as required by the
+// signers served with NewRemoteSignerServer.
+func RemoteSignerClientTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// RemoteSignerServerTLSConfig returns the TLS configuration of a remote signer
+// server, which authenticates with the certificate of certFile and keyFile, and
+// requires the clients to authenticate with a certificate signed by a CA of
+// clientCAFile.
+func RemoteSignerServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the server certificate: %w", err)
+	}
+
+	pool, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// loadCertPool returns the pool of the PEM encoded certificates of the file.
+func loadCertPool(file string) (*x509.CertPool, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA certificates: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no valid CA certificate in %s", file)
+	}
+
+	return pool, nil
+}
+
+// remoteSignerServer is a RemoteSigner service signing with the keys of a
+// keyring.
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+// NewRemoteSignerServer returns a RemoteSigner service signing with the keys
+// of the given keyring, e.g. a file keyring on the host of the signer. Only the
+// local and ledger keys, which can sign, are served.
+//
+// The service only serves the clients authenticated with a verified TLS client
+// certificate, so it must be registered on a gRPC server whose credentials
+// require them, e.g. with RemoteSignerServerTLSConfig.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+// authenticate returns an error unless the caller is authenticated with a
+// verified TLS client certificate.
+func (s remoteSignerServer) authenticate(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "unknown peer")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return status.Error(codes.Unauthenticated, "a verified TLS client certificate is required")
+	}
+
+	return nil
+}
+
+func (s remoteSignerServer) Keys(ctx context.Context, _ *KeysRequest) (*KeysResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &KeysResponse{}
+	for _, k := range records {
+		if k.GetLocal() == nil && k.GetLedger() == nil {
+			continue
+		}
+		res.Keys = append(res.Keys, &RemoteKey{Name: k.Name, PubKey: k.PubKey})
+	}
+
+	return res, nil
+}
+
+func (s remoteSignerServer) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	var (
+		sig []byte
+		pk  types.PubKey
+		err error
+	)
+	switch {
+	case req.Name != "" && len(req.Address) > 0:
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("either the name or the address of the key must be set")
+	case req.Name != "":
+		sig, pk, err = s.kr.Sign(req.Name, req.Msg)
+	case len(req.Address) > 0:
+		sig, pk, err = s.kr.SignByAddress(sdk.AccAddress(req.Address), req.Msg)
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("the name or the address of the key is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig, PubKey: any}, nil
+}
+
+// unpackPubKey unpacks a public key packed in an Any.
+func unpackPubKey(cdc codec.Codec, any *codectypes.Any) (types.PubKey, error) {
+	if any == nil {
+		return nil, errors.New("missing public key")
+	}
+
+	bz, err := any.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var pk types.PubKey
+	if err := cdc.UnmarshalInterface(bz, &pk); err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
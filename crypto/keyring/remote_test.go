@@ -0,0 +1,197 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+
+	// the signer serves the keys of an in-memory keyring
+	signerKr := NewInMemory(cdc)
+	signerRecord, _, err := signerKr.NewMnemonic("signer", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	caFile, serverCert, serverKey, clientCert, clientKey := writeTestCerts(t, dir)
+
+	serverTLS, err := RemoteSignerServerTLSConfig(serverCert, serverKey, caFile)
+	require.NoError(t, err)
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(serverTLS)))
+	RegisterRemoteSignerServer(server, NewRemoteSignerServer(signerKr))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	dialer := grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() })
+
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, cdc)
+	require.Error(t, err)
+
+	// plaintext connections must be explicitly allowed
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner("bufnet", dialer))
+	require.Error(t, err)
+
+	// the signer refuses the clients without a certificate
+	noCertTLS, err := RemoteSignerClientTLSConfig(caFile, "", "")
+	require.NoError(t, err)
+	kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner("bufnet", dialer), WithRemoteSignerTLS(noCertTLS))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+
+	clientTLS, err := RemoteSignerClientTLSConfig(caFile, clientCert, clientKey)
+	require.NoError(t, err)
+	kr, err = New("keybasename", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner("bufnet", dialer), WithRemoteSignerTLS(clientTLS))
+	require.NoError(t, err)
+
+	// only the keys which can sign are listed
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "signer", records[0].Name)
+	require.Equal(t, TypeOffline, records[0].GetType())
+
+	addr, err := signerRecord.GetAddress()
+	require.NoError(t, err)
+	k, err := kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "signer", k.Name)
+	_, err = kr.Key("offline")
+	require.Error(t, err)
+
+	pubKey, err := signerRecord.GetPubKey()
+	require.NoError(t, err)
+	msg := []byte("message")
+
+	sig, pk, err := kr.Sign("signer", msg)
+	require.NoError(t, err)
+	require.True(t, pk.Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	sig, pk, err = kr.SignByAddress(addr, msg)
+	require.NoError(t, err)
+	require.True(t, pk.Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("offline", msg)
+	require.Error(t, err)
+	_, _, err = kr.Sign("unknown", msg)
+	require.Error(t, err)
+
+	armor, err := kr.ExportPubKeyArmor("signer")
+	require.NoError(t, err)
+	bz, _, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	pubKeyBz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, pubKeyBz, bz)
+
+	// the keys can't be managed through the remote keyring
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Error(t, err)
+	require.Error(t, kr.Delete("signer"))
+	_, err = kr.ExportPrivKeyArmor("signer", "passphrase")
+	require.Error(t, err)
+}
+
+func TestRemoteSignerServerAuthentication(t *testing.T) {
+	cdc := getCodec()
+
+	signerKr := NewInMemory(cdc)
+	_, _, err := signerKr.NewMnemonic("signer", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the signer serves no client which isn't authenticated with TLS
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewRemoteSignerServer(signerKr))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+	), WithRemoteSignerInsecure())
+	require.NoError(t, err)
+
+	_, err = kr.List()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, _, err = kr.Sign("signer", []byte("message"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// writeTestCerts writes a CA certificate, and the certificates of a signer
+// served as "bufnet" and of a client signed by the CA, to the directory.
+func writeTestCerts(t *testing.T, dir string) (caFile, serverCert, serverKey, clientCert, clientKey string) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caFile = filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0600))
+
+	writeCert := func(name string, serial int64, usage x509.ExtKeyUsage) (string, string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		certFile, keyFile := filepath.Join(dir, name+".pem"), filepath.Join(dir, name+".key")
+		require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+		require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+		return certFile, keyFile
+	}
+
+	serverCert, serverKey = writeCert("bufnet", 2, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey = writeCert("client", 3, x509.ExtKeyUsageClientAuth)
+
+	return caFile, serverCert, serverKey, clientCert, clientKey
+}
@@ -16,6 +16,9 @@ const (
 	pubKeySize = fieldSize + 1
 
 	name = "secp256r1"
+
+	PrivKeyName = "cosmos/PrivKeySecp256r1"
+	PubKeyName  = "cosmos/PubKeySecp256r1"
 )
 
 var secp256r1 elliptic.Curve
@@ -29,7 +32,9 @@ func init() {
 	}
 }
 
-// RegisterInterfaces adds secp256r1 PubKey to pubkey registry
+// RegisterInterfaces adds secp256r1 PubKey to pubkey registry, and PrivKey to
+// privkey registry
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
 }
@@ -1,6 +1,9 @@
 package secp256r1
 
 import (
+	"errors"
+	"math/big"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -11,6 +14,22 @@ func GenPrivKey() (*PrivKey, error) {
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromSecret returns the secp256r1 private key of the given big-endian
+// secret, which must be lower than the curve order.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	d := new(big.Int).SetBytes(secret)
+	if d.Sign() == 0 || d.Cmp(secp256r1.Params().N) >= 0 {
+		return nil, errors.New("invalid secp256r1 private key")
+	}
+
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(d.FillBytes(make([]byte, fieldSize))); err != nil {
+		return nil, err
+	}
+
+	return &PrivKey{sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -48,6 +67,22 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshalling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(bz); err != nil {
+		return err
+	}
+	m.Secret = sk
+
+	return nil
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
@@ -45,6 +45,32 @@ func (m *PubKey) VerifySignature(msg []byte, sig []byte) bool {
 	return m.Key.VerifySignature(msg, sig)
 }
 
+// MarshalAmino overrides Amino binary marshalling.
+func (m PubKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (m *PubKey) UnmarshalAmino(bz []byte) error {
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return err
+	}
+	m.Key = pk
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (m PubKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (m *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaPK struct {
 	ecdsa.PubKey
 }
@@ -53,6 +53,7 @@ require (
 	google.golang.org/genproto v0.0.0-20210828152312-66f60bf46e71
 	google.golang.org/grpc v1.41.0
 	google.golang.org/protobuf v1.27.1
+	pgregory.net/rapid v0.4.7
 	sigs.k8s.io/yaml v1.3.0
 )
 
@@ -33,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -127,8 +128,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -186,6 +192,7 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactory.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -24,6 +24,7 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagLocked       = "locked"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -34,7 +35,8 @@ func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 		Long: `Add a genesis account to genesis.json. The provided account must specify
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
-contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+contain valid denominations. Accounts may optionally be supplied with vesting parameters,
+or with the --locked flag to permanently lock the vesting amount.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -79,6 +81,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			vestingStart, _ := cmd.Flags().GetInt64(flagVestingStart)
 			vestingEnd, _ := cmd.Flags().GetInt64(flagVestingEnd)
 			vestingAmtStr, _ := cmd.Flags().GetString(flagVestingAmt)
+			locked, _ := cmd.Flags().GetBool(flagLocked)
 
 			vestingAmt, err := sdk.ParseCoinsNormalized(vestingAmtStr)
 			if err != nil {
@@ -100,6 +103,13 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				}
 
 				switch {
+				case locked:
+					if vestingStart != 0 || vestingEnd != 0 {
+						return errors.New("permanently locked accounts cannot have a vesting start or end time")
+					}
+
+					genAccount = authvesting.NewPermanentLockedAccount(baseAccount, vestingAmt.Sort())
+
 				case vestingStart != 0 && vestingEnd != 0:
 					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
 
@@ -109,6 +119,8 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				default:
 					return errors.New("invalid vesting parameters; must supply start and end time or end time")
 				}
+			} else if locked {
+				return errors.New("permanently locked accounts must supply a vesting amount")
 			} else {
 				genAccount = baseAccount
 			}
@@ -179,6 +191,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().Bool(flagLocked, false, "permanently lock the vesting amount of the account")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -32,6 +32,7 @@ func TestAddGenesisAccountCmd(t *testing.T) {
 		addr        string
 		denom       string
 		withKeyring bool
+		extraArgs   []string
 		expectErr   bool
 	}{
 		{
@@ -62,6 +63,30 @@ func TestAddGenesisAccountCmd(t *testing.T) {
 			withKeyring: true,
 			expectErr:   false,
 		},
+		{
+			name:        "permanently locked",
+			addr:        addr1.String(),
+			denom:       "1000stake",
+			withKeyring: false,
+			extraArgs:   []string{"--vesting-amount=500stake", "--locked"},
+			expectErr:   false,
+		},
+		{
+			name:        "permanently locked without vesting amount",
+			addr:        addr1.String(),
+			denom:       "1000stake",
+			withKeyring: false,
+			extraArgs:   []string{"--locked"},
+			expectErr:   true,
+		},
+		{
+			name:        "permanently locked with end time",
+			addr:        addr1.String(),
+			denom:       "1000stake",
+			withKeyring: false,
+			extraArgs:   []string{"--vesting-amount=500stake", "--vesting-end-time=4070908800", "--locked"},
+			expectErr:   true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -93,10 +118,10 @@ func TestAddGenesisAccountCmd(t *testing.T) {
 			ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
 
 			cmd := simcmd.AddGenesisAccountCmd(home)
-			cmd.SetArgs([]string{
+			cmd.SetArgs(append([]string{
 				tc.addr,
 				tc.denom,
-				fmt.Sprintf("--%s=home", flags.FlagHome)})
+				fmt.Sprintf("--%s=home", flags.FlagHome)}, tc.extraArgs...))
 
 			if tc.expectErr {
 				require.Error(t, cmd.ExecuteContext(ctx))
@@ -160,6 +160,21 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithListener branches the multistore like CacheMultiStore,
+// but the writes to all the branched stores are reported to the given listener
+// instead of the listeners of the multistore. As the branched stores are
+// cached, the listener is notified when the branch is written.
+func (cms Store) CacheMultiStoreWithListener(listener types.WriteListener) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(cms.stores))
+	for k, v := range cms.stores {
+		stores[k] = v
+		listeners[k] = []types.WriteListener{listener}
+	}
+
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, listeners)
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -22,3 +24,41 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+type recordingListener struct {
+	writes []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.writes = append(l.writes, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func TestCacheMultiStoreWithListener(t *testing.T) {
+	require := require.New(t)
+
+	key := types.NewKVStoreKey("abc")
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("c"), []byte("3"))
+	cms := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{key: parent}, nil, nil, nil, nil)
+
+	listener := &recordingListener{}
+	branch := cms.CacheMultiStoreWithListener(listener)
+	store := branch.GetKVStore(key)
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+	store.Set([]byte("a"), []byte("4"))
+	store.Delete([]byte("c"))
+
+	// the writes are reported once the branch is written
+	require.Empty(listener.writes)
+	branch.Write()
+	require.Equal([]types.StoreKVPair{
+		{StoreKey: "abc", Key: []byte("a"), Value: []byte("4")},
+		{StoreKey: "abc", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "abc", Key: []byte("c"), Delete: true},
+	}, listener.writes)
+
+	require.Equal([]byte("4"), cms.GetKVStore(key).Get([]byte("a")))
+	require.False(cms.ListeningEnabled(key))
+}
@@ -6,6 +6,7 @@ package tx
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -112,6 +113,20 @@ type GetTxsEventRequest struct {
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	OrderBy    OrderBy            `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// query is a Tendermint query string to search the transactions with, used
+	// instead of events, e.g. "message.sender='cosmos1...' AND tx.height>=100".
+	// Besides the equality, the conditions may use the <, <=, >, >=, CONTAINS and
+	// EXISTS operators, and up to 10 queries may be joined with OR to search the
+	// transactions matching any of them.
+	//
+	// The transactions are paginated either by offset or, when the key of the
+	// pagination is set, after the transaction of the key, which is returned by
+	// the next_key of the previous page. The total of the response is only set
+	// when the query has no OR and the pagination has no key. Otherwise, the
+	// offset and limit must not exceed 1000.
+	//
+	// Since: cosmos-sdk 0.46
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
 }
 
 func (m *GetTxsEventRequest) Reset()         { *m = GetTxsEventRequest{} }
@@ -168,6 +183,13 @@ func (m *GetTxsEventRequest) GetOrderBy() OrderBy {
 	return OrderBy_ORDER_BY_UNSPECIFIED
 }
 
+func (m *GetTxsEventRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 // GetTxsEventResponse is the response type for the Service.TxsByEvents
 // RPC method.
 type GetTxsEventResponse struct {
@@ -345,6 +367,9 @@ type SimulateRequest struct {
 	//
 	// Since: cosmos-sdk 0.43
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// include_state_changes makes the response include the changes of the
+	// transaction to the state.
+	IncludeStateChanges bool `protobuf:"varint,3,opt,name=include_state_changes,json=includeStateChanges,proto3" json:"include_state_changes,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -395,6 +420,13 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetIncludeStateChanges() bool {
+	if m != nil {
+		return m.IncludeStateChanges
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -402,6 +434,14 @@ type SimulateResponse struct {
 	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
 	// result is the result of the simulation.
 	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// state_changes are the writes and deletes of the transaction to the KV
+	// stores, sorted by store key and key. They are only set if
+	// include_state_changes was set in the request.
+	StateChanges []*StateChange `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	// balance_changes are the changes of the bank balances decoded from the
+	// state changes, sorted by address and denom. They are only set if
+	// include_state_changes was set in the request.
+	BalanceChanges []*BalanceChange `protobuf:"bytes,4,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
 }
 
 func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
@@ -451,6 +491,164 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+func (m *SimulateResponse) GetStateChanges() []*StateChange {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetBalanceChanges() []*BalanceChange {
+	if m != nil {
+		return m.BalanceChanges
+	}
+	return nil
+}
+
+// StateChange is a write or a delete of a key of a KV store.
+type StateChange struct {
+	// store_key is the name of the KV store.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// key is the written or deleted key.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// delete is true if the key is deleted.
+	Delete bool `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	// previous_value is the value of the key before the transaction, empty if
+	// the key didn't exist.
+	PreviousValue []byte `protobuf:"bytes,4,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	// value is the value of the key after the transaction, empty if the key is
+	// deleted.
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(m, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StateChange) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateChange) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *StateChange) GetPreviousValue() []byte {
+	if m != nil {
+		return m.PreviousValue
+	}
+	return nil
+}
+
+func (m *StateChange) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// BalanceChange is a change of the balance of a denom of an account.
+type BalanceChange struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// before is the balance before the transaction.
+	Before github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=before,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"before"`
+	// after is the balance after the transaction.
+	After github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=after,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"after"`
+	// delta is the difference between the balances after and before the
+	// transaction.
+	Delta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=delta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delta"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -462,7 +660,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +708,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,6 +768,10 @@ func init() {
 	golang_proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
 	golang_proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*StateChange)(nil), "cosmos.tx.v1beta1.StateChange")
+	golang_proto.RegisterType((*StateChange)(nil), "cosmos.tx.v1beta1.StateChange")
+	proto.RegisterType((*BalanceChange)(nil), "cosmos.tx.v1beta1.BalanceChange")
+	golang_proto.RegisterType((*BalanceChange)(nil), "cosmos.tx.v1beta1.BalanceChange")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	golang_proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
@@ -582,59 +784,75 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x3b, 0x65, 0x93, 0xbe, 0x64, 0x4b, 0x3a, 0xbb, 0x14, 0x93, 0x82, 0x37, 0x75, 0xc9,
-	0x36, 0x44, 0xc2, 0x56, 0x03, 0x48, 0x08, 0x71, 0x89, 0x93, 0x74, 0x59, 0x41, 0x9b, 0x6a, 0xb2,
-	0x08, 0x15, 0x21, 0x45, 0x4e, 0x32, 0xf5, 0x5a, 0x6c, 0x3c, 0x59, 0xcf, 0x64, 0xe5, 0xa8, 0xad,
-	0x90, 0x38, 0x72, 0x42, 0xe2, 0x67, 0xf0, 0x27, 0x38, 0x72, 0x5c, 0x89, 0x0b, 0x47, 0xb4, 0xe1,
-	0x47, 0x70, 0x44, 0x1e, 0x4f, 0x12, 0x27, 0xeb, 0x74, 0x11, 0xa7, 0xbc, 0xc9, 0x7c, 0xef, 0x7b,
-	0xdf, 0xfb, 0xe6, 0xcd, 0x18, 0xf6, 0x07, 0x94, 0x8d, 0x28, 0xb3, 0x78, 0x68, 0x9d, 0x3f, 0xec,
-	0x13, 0xee, 0x3c, 0xb4, 0x18, 0x09, 0xce, 0xbd, 0x01, 0x31, 0xc7, 0x01, 0xe5, 0x14, 0xdd, 0x8e,
-	0x01, 0x26, 0x0f, 0x4d, 0x09, 0x28, 0xbd, 0xeb, 0x52, 0xea, 0x9e, 0x12, 0xcb, 0x19, 0x7b, 0x96,
-	0xe3, 0xfb, 0x94, 0x3b, 0xdc, 0xa3, 0x3e, 0x8b, 0x13, 0x4a, 0xf7, 0x25, 0x63, 0xdf, 0x61, 0xc4,
-	0x72, 0xfa, 0x03, 0x6f, 0x41, 0x1c, 0x2d, 0x24, 0xa8, 0x74, 0xb5, 0x2c, 0x0f, 0xe5, 0xde, 0x9e,
-	0x4b, 0x5d, 0x2a, 0x42, 0x2b, 0x8a, 0xe4, 0xbf, 0xb5, 0x24, 0xed, 0xd9, 0x84, 0x04, 0xd3, 0x45,
-	0xe6, 0xd8, 0x71, 0x3d, 0x5f, 0x68, 0x88, 0xb1, 0xc6, 0xaf, 0x0a, 0xa0, 0x43, 0xc2, 0x8f, 0x43,
-	0xd6, 0x3e, 0x27, 0x3e, 0xc7, 0xe4, 0x6c, 0x42, 0x18, 0x47, 0x77, 0x60, 0x9b, 0x44, 0x6b, 0xa6,
-	0x29, 0xe5, 0x4c, 0xf5, 0x26, 0x96, 0x2b, 0xf4, 0x08, 0x60, 0x49, 0xa1, 0xa9, 0x65, 0xa5, 0x9a,
-	0xaf, 0x1f, 0x98, 0xb2, 0xef, 0xa8, 0x9e, 0x29, 0xea, 0xcd, 0xfb, 0x37, 0x9f, 0x3a, 0x2e, 0x91,
-	0x9c, 0x38, 0x91, 0x89, 0x3e, 0x81, 0x1c, 0x0d, 0x86, 0x24, 0xe8, 0xf5, 0xa7, 0x5a, 0xa6, 0xac,
-	0x54, 0x6f, 0xd5, 0x4b, 0xe6, 0x15, 0xf7, 0xcc, 0x4e, 0x04, 0xb1, 0xa7, 0x38, 0x4b, 0xe3, 0xc0,
-	0xb8, 0x50, 0x60, 0x77, 0x45, 0x2d, 0x1b, 0x53, 0x9f, 0x11, 0xf4, 0x00, 0x32, 0x3c, 0x8c, 0xb5,
-	0xe6, 0xeb, 0x6f, 0xa5, 0x30, 0x1d, 0x87, 0x38, 0x42, 0xa0, 0x43, 0x28, 0xf0, 0xb0, 0x17, 0xc8,
-	0x3c, 0xa6, 0xa9, 0x22, 0xe3, 0xfd, 0x95, 0x0e, 0x84, 0xf7, 0x89, 0x44, 0x09, 0xc6, 0x79, 0xbe,
-	0x88, 0x23, 0xa2, 0xa4, 0x11, 0x19, 0x61, 0xc4, 0x83, 0x6b, 0x8d, 0x90, 0x4c, 0x89, 0x54, 0x83,
-	0x00, 0xb2, 0x03, 0xea, 0x0c, 0x07, 0x0e, 0xe3, 0x51, 0xb1, 0xd8, 0xff, 0x77, 0x20, 0xc7, 0xc3,
-	0x5e, 0x7f, 0xca, 0x49, 0xd4, 0x95, 0x52, 0x2d, 0xe0, 0x2c, 0x0f, 0xed, 0x68, 0x89, 0x3e, 0x86,
-	0x1b, 0x23, 0x3a, 0x24, 0xc2, 0xfc, 0x5b, 0xf5, 0x72, 0x4a, 0xb3, 0x0b, 0xbe, 0xc7, 0x74, 0x48,
-	0xb0, 0x40, 0x1b, 0xdf, 0xc1, 0xee, 0x4a, 0x19, 0x69, 0x5c, 0x1b, 0xf2, 0x09, 0x3f, 0x44, 0xa9,
-	0xff, 0x6a, 0x07, 0x2c, 0xed, 0x30, 0xbe, 0x81, 0x37, 0xbb, 0xde, 0x68, 0x72, 0xea, 0xf0, 0xf9,
-	0x69, 0xa3, 0x0f, 0x40, 0xe5, 0xa1, 0x24, 0x4c, 0x3f, 0x11, 0x5b, 0xd5, 0x14, 0xac, 0xf2, 0x70,
-	0xa5, 0x59, 0x75, 0xa5, 0x59, 0xe3, 0x27, 0x05, 0x8a, 0x4b, 0x66, 0x29, 0xfa, 0x73, 0xc8, 0xb9,
-	0x0e, 0xeb, 0x79, 0xfe, 0x73, 0x2a, 0x0b, 0xdc, 0xdb, 0xac, 0xf8, 0xd0, 0x61, 0x47, 0xfe, 0x73,
-	0x8a, 0xb3, 0x6e, 0x1c, 0xa0, 0x4f, 0x61, 0x3b, 0x20, 0x6c, 0x72, 0xca, 0xe5, 0xf8, 0x96, 0x37,
-	0xe7, 0x62, 0x81, 0xc3, 0x12, 0x6f, 0x18, 0x50, 0x10, 0xc3, 0x37, 0x6f, 0x11, 0xc1, 0x8d, 0x13,
-	0x87, 0x9d, 0x08, 0x0d, 0x37, 0xb1, 0x88, 0x8d, 0x57, 0xb0, 0x23, 0x31, 0x52, 0x6c, 0xe5, 0x5a,
-	0x1f, 0x84, 0x07, 0x6b, 0x07, 0xa1, 0xfe, 0xbf, 0x83, 0xa8, 0x7d, 0x01, 0x59, 0x79, 0x69, 0x90,
-	0x06, 0x7b, 0x1d, 0xdc, 0x6a, 0xe3, 0x9e, 0xfd, 0xac, 0xf7, 0xf5, 0x93, 0xee, 0xd3, 0x76, 0xf3,
-	0xe8, 0xd1, 0x51, 0xbb, 0x55, 0xdc, 0x42, 0x45, 0x28, 0x2c, 0x76, 0x1a, 0xdd, 0x66, 0x51, 0x41,
-	0xb7, 0x61, 0x67, 0xf1, 0x4f, 0xab, 0xdd, 0x6d, 0x16, 0xd5, 0xda, 0x4b, 0xd8, 0x59, 0x99, 0x23,
-	0xa4, 0x43, 0xc9, 0xc6, 0x9d, 0x46, 0xab, 0xd9, 0xe8, 0x1e, 0xf7, 0x1e, 0x77, 0x5a, 0xed, 0x35,
-	0x56, 0x0d, 0xf6, 0xd6, 0xf6, 0xed, 0xaf, 0x3a, 0xcd, 0x2f, 0x8b, 0x0a, 0x7a, 0x1b, 0x76, 0xd7,
-	0x76, 0xba, 0xcf, 0x9e, 0x34, 0x8b, 0x6a, 0x4a, 0x4a, 0x43, 0xec, 0x64, 0xea, 0xff, 0x64, 0x20,
-	0xdb, 0x8d, 0x1f, 0x57, 0xf4, 0x02, 0x72, 0xf3, 0x11, 0x40, 0x46, 0x8a, 0x83, 0x6b, 0x93, 0x57,
-	0xba, 0xff, 0x5a, 0x8c, 0x9c, 0xd8, 0x83, 0x1f, 0xff, 0xf8, 0xfb, 0x17, 0xb5, 0x6c, 0xdc, 0xb5,
-	0x52, 0x5e, 0x75, 0x09, 0xfe, 0x4c, 0xa9, 0xa1, 0x33, 0x78, 0x43, 0x9c, 0x27, 0xda, 0x4f, 0x61,
-	0x4d, 0x4e, 0x43, 0xa9, 0xbc, 0x19, 0x20, 0x6b, 0x56, 0x44, 0xcd, 0x7d, 0xf4, 0x9e, 0x95, 0xf6,
-	0xa4, 0x33, 0xeb, 0x45, 0x34, 0x41, 0xaf, 0xd0, 0x0f, 0x90, 0x4f, 0x5c, 0x55, 0x54, 0x79, 0xdd,
-	0x0d, 0x5f, 0x96, 0x3f, 0xb8, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x1a, 0x77, 0xd2, 0x45,
-	0x44, 0x3d, 0xbf, 0x84, 0x7c, 0xe2, 0x91, 0x4d, 0x15, 0x70, 0xf5, 0x93, 0x91, 0x2a, 0x20, 0xe5,
-	0xad, 0x36, 0x74, 0x21, 0x40, 0x43, 0x1b, 0x04, 0xd8, 0xcd, 0xdf, 0x2f, 0x75, 0xe5, 0xe2, 0x52,
-	0x57, 0xfe, 0xba, 0xd4, 0x95, 0x9f, 0x67, 0xfa, 0xd6, 0x6f, 0x33, 0x5d, 0xb9, 0x98, 0xe9, 0x5b,
-	0x7f, 0xce, 0xf4, 0xad, 0x6f, 0x2b, 0xae, 0xc7, 0x4f, 0x26, 0x7d, 0x73, 0x40, 0x47, 0xf3, 0xfc,
-	0xf8, 0xe7, 0x43, 0x36, 0xfc, 0xde, 0xe2, 0xd3, 0x31, 0x89, 0x08, 0xfb, 0xdb, 0xe2, 0xeb, 0xf6,
-	0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x86, 0xcd, 0x5c, 0xb4, 0x07, 0x00, 0x00,
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xae, 0x93, 0xd8, 0x7e, 0xb6, 0x53, 0x77, 0x92, 0xf6, 0xbb, 0x5f, 0x17, 0x1c, 0x77,
+	0x4b, 0xd2, 0x10, 0x89, 0x5d, 0xd5, 0x80, 0x84, 0x10, 0x97, 0xf8, 0x47, 0x43, 0x54, 0xda, 0x54,
+	0xe3, 0x80, 0x54, 0x84, 0xb4, 0x1a, 0x7b, 0x27, 0x8e, 0x55, 0x67, 0xc7, 0xd9, 0x19, 0x47, 0x6b,
+	0xb5, 0x15, 0x12, 0x57, 0x2e, 0xa0, 0xde, 0xf8, 0x6b, 0xe0, 0xd6, 0x63, 0x24, 0x2e, 0x88, 0x43,
+	0x85, 0x12, 0xfe, 0x08, 0x8e, 0x68, 0x67, 0xc7, 0xf6, 0x3a, 0xd9, 0x34, 0x28, 0xa7, 0x9d, 0x37,
+	0xef, 0xf3, 0x3e, 0xef, 0xc7, 0xcc, 0x7b, 0x3b, 0xb0, 0xda, 0x61, 0xfc, 0x90, 0x71, 0x5b, 0x04,
+	0xf6, 0xf1, 0x83, 0x36, 0x15, 0xe4, 0x81, 0xcd, 0xa9, 0x7f, 0xdc, 0xeb, 0x50, 0x6b, 0xe0, 0x33,
+	0xc1, 0xd0, 0xcd, 0x08, 0x60, 0x89, 0xc0, 0x52, 0x80, 0xd2, 0x7b, 0x5d, 0xc6, 0xba, 0x7d, 0x6a,
+	0x93, 0x41, 0xcf, 0x26, 0x9e, 0xc7, 0x04, 0x11, 0x3d, 0xe6, 0xf1, 0xc8, 0xa0, 0x74, 0x4f, 0x31,
+	0xb6, 0x09, 0xa7, 0x36, 0x69, 0x77, 0x7a, 0x13, 0xe2, 0x50, 0x50, 0xa0, 0xd2, 0x45, 0xb7, 0x22,
+	0x50, 0xba, 0x95, 0x2e, 0xeb, 0x32, 0xb9, 0xb4, 0xc3, 0x95, 0xda, 0xdd, 0x8c, 0xd3, 0x1e, 0x0d,
+	0xa9, 0x3f, 0x9a, 0x58, 0x0e, 0x48, 0xb7, 0xe7, 0xc9, 0x18, 0x22, 0xac, 0xf9, 0x9b, 0x06, 0x68,
+	0x9b, 0x8a, 0xbd, 0x80, 0x37, 0x8f, 0xa9, 0x27, 0x30, 0x3d, 0x1a, 0x52, 0x2e, 0xd0, 0x6d, 0x58,
+	0xa4, 0xa1, 0xcc, 0x0d, 0xad, 0x92, 0xda, 0xc8, 0x62, 0x25, 0xa1, 0x87, 0x00, 0x53, 0x0a, 0x43,
+	0xaf, 0x68, 0x1b, 0xb9, 0xea, 0xba, 0xa5, 0xf2, 0x0e, 0xfd, 0x59, 0xd2, 0xdf, 0x38, 0x7f, 0xeb,
+	0x29, 0xe9, 0x52, 0xc5, 0x89, 0x63, 0x96, 0xe8, 0x53, 0xc8, 0x30, 0xdf, 0xa5, 0xbe, 0xd3, 0x1e,
+	0x19, 0xa9, 0x8a, 0xb6, 0xb1, 0x54, 0x2d, 0x59, 0x17, 0xaa, 0x67, 0xed, 0x86, 0x90, 0xda, 0x08,
+	0xa7, 0x59, 0xb4, 0x40, 0x2b, 0xb0, 0x20, 0xf9, 0x8d, 0xf9, 0x8a, 0xb6, 0x91, 0xc5, 0x91, 0x60,
+	0x9e, 0x68, 0xb0, 0x3c, 0x93, 0x03, 0x1f, 0x30, 0x8f, 0x53, 0x74, 0x1f, 0x52, 0x22, 0x88, 0x32,
+	0xc8, 0x55, 0x6f, 0x25, 0xf0, 0xef, 0x05, 0x38, 0x44, 0xa0, 0x6d, 0xc8, 0x8b, 0xc0, 0xf1, 0x95,
+	0x1d, 0x37, 0x74, 0x69, 0xf1, 0xc1, 0x4c, 0x5e, 0xf2, 0x44, 0x62, 0x86, 0x0a, 0x8c, 0x73, 0x62,
+	0xb2, 0x0e, 0x89, 0xe2, 0xe5, 0x49, 0xc9, 0xf2, 0xdc, 0xbf, 0xb2, 0x3c, 0x8a, 0x29, 0x66, 0x6a,
+	0x52, 0x40, 0x35, 0x9f, 0x11, 0xb7, 0x43, 0xb8, 0xd8, 0x0b, 0x54, 0x05, 0xd1, 0xff, 0x21, 0x23,
+	0x02, 0xa7, 0x3d, 0x12, 0x34, 0xcc, 0x4a, 0xdb, 0xc8, 0xe3, 0xb4, 0x08, 0x6a, 0xa1, 0x88, 0x3e,
+	0x81, 0xf9, 0x43, 0xe6, 0x52, 0x79, 0x24, 0x4b, 0xd5, 0x4a, 0x42, 0xb2, 0x13, 0xbe, 0xc7, 0xcc,
+	0xa5, 0x58, 0xa2, 0xcd, 0xef, 0x60, 0x79, 0xc6, 0x8d, 0x2a, 0x5c, 0x13, 0x72, 0xb1, 0x7a, 0x48,
+	0x57, 0xff, 0xb5, 0x1c, 0x30, 0x2d, 0x87, 0xf9, 0xa3, 0x06, 0x37, 0x5a, 0xbd, 0xc3, 0x61, 0x9f,
+	0x88, 0xf1, 0x25, 0x40, 0x1f, 0x82, 0x2e, 0x02, 0xc5, 0x98, 0x7c, 0x24, 0x35, 0xdd, 0xd0, 0xb0,
+	0x2e, 0x82, 0x99, 0x6c, 0xf5, 0xd9, 0x6c, 0xab, 0x70, 0xab, 0xe7, 0x75, 0xfa, 0x43, 0x97, 0x3a,
+	0x5c, 0x10, 0x41, 0x9d, 0xce, 0x01, 0xf1, 0xba, 0x94, 0xcb, 0x92, 0x67, 0xf0, 0xb2, 0x52, 0xb6,
+	0x42, 0x5d, 0x3d, 0x52, 0x99, 0xbf, 0xe8, 0x50, 0x9c, 0x46, 0xa3, 0x32, 0xfd, 0x02, 0x32, 0x5d,
+	0xc2, 0x9d, 0x9e, 0xb7, 0xcf, 0x54, 0x50, 0x77, 0x2f, 0x4f, 0x73, 0x9b, 0xf0, 0x1d, 0x6f, 0x9f,
+	0xe1, 0x74, 0x37, 0x5a, 0xa0, 0xcf, 0x60, 0xd1, 0xa7, 0x7c, 0xd8, 0x17, 0xaa, 0x13, 0x2a, 0x97,
+	0xdb, 0x62, 0x89, 0xc3, 0x0a, 0x8f, 0xea, 0x50, 0x38, 0x1f, 0x78, 0x78, 0xe5, 0xca, 0x09, 0x15,
+	0x89, 0x25, 0x81, 0xf3, 0x7c, 0x2a, 0x70, 0xb4, 0x03, 0x37, 0xda, 0xa4, 0x4f, 0xbc, 0xce, 0x94,
+	0x66, 0xbe, 0x92, 0x8a, 0xc7, 0x11, 0x3f, 0xfe, 0x08, 0xa9, 0x88, 0x96, 0xda, 0x71, 0x91, 0x9b,
+	0x3f, 0x6b, 0x90, 0x8b, 0x39, 0x42, 0x77, 0x20, 0xcb, 0x05, 0xf3, 0xa9, 0xf3, 0x9c, 0x8e, 0x64,
+	0x61, 0xb2, 0x38, 0x23, 0x37, 0x1e, 0xd1, 0x11, 0x2a, 0x42, 0x2a, 0xdc, 0x8e, 0xce, 0x24, 0x5c,
+	0x86, 0xe3, 0xc2, 0xa5, 0x7d, 0x2a, 0xa8, 0x3a, 0x00, 0x25, 0xa1, 0x35, 0x58, 0x1a, 0xf8, 0xf4,
+	0xb8, 0xc7, 0x86, 0xdc, 0x39, 0x26, 0xfd, 0x21, 0x95, 0x8d, 0x9b, 0xc7, 0x85, 0xf1, 0xee, 0x37,
+	0xe1, 0x66, 0xd8, 0xd6, 0x91, 0x76, 0x41, 0x6a, 0x23, 0xc1, 0x7c, 0xad, 0x43, 0x61, 0x26, 0x6a,
+	0x64, 0x40, 0x9a, 0xb8, 0xae, 0x4f, 0x39, 0x57, 0x31, 0x8d, 0xc5, 0x90, 0xc1, 0xa5, 0x1e, 0x3b,
+	0x94, 0x41, 0x65, 0x71, 0x24, 0xa0, 0x87, 0xb0, 0xd8, 0xa6, 0xfb, 0xcc, 0x8f, 0xc2, 0xca, 0xd6,
+	0xac, 0x37, 0x6f, 0x57, 0xe7, 0xfe, 0x7c, 0xbb, 0xba, 0xde, 0xed, 0x89, 0x83, 0x61, 0xdb, 0xea,
+	0xb0, 0x43, 0x5b, 0xcd, 0xca, 0xe8, 0xf3, 0x11, 0x77, 0x9f, 0xdb, 0x62, 0x34, 0xa0, 0xdc, 0xda,
+	0xf1, 0x04, 0x56, 0xd6, 0xa8, 0x01, 0x0b, 0x64, 0x5f, 0x50, 0xdf, 0x98, 0xbf, 0x16, 0x4d, 0x64,
+	0x1c, 0xb2, 0xb8, 0xb4, 0x2f, 0x88, 0xb1, 0x70, 0x3d, 0x16, 0x69, 0x6c, 0x9a, 0x90, 0x97, 0xb3,
+	0x6e, 0xdc, 0x50, 0x08, 0xe6, 0x0f, 0x08, 0x3f, 0x50, 0x05, 0x91, 0x6b, 0xf3, 0x15, 0x14, 0x14,
+	0x46, 0x5d, 0xf3, 0xb5, 0x2b, 0xbb, 0x4e, 0x76, 0xdc, 0xb9, 0xbe, 0xd7, 0xaf, 0xd7, 0xf7, 0x9b,
+	0x5f, 0x42, 0x5a, 0x4d, 0x6e, 0x64, 0xc0, 0xca, 0x2e, 0x6e, 0x34, 0xb1, 0x53, 0x7b, 0xe6, 0x7c,
+	0xfd, 0xa4, 0xf5, 0xb4, 0x59, 0xdf, 0x79, 0xb8, 0xd3, 0x6c, 0x14, 0xe7, 0x50, 0x11, 0xf2, 0x13,
+	0xcd, 0x56, 0xab, 0x5e, 0xd4, 0xd0, 0x4d, 0x28, 0x4c, 0x76, 0x1a, 0xcd, 0x56, 0xbd, 0xa8, 0x6f,
+	0xbe, 0x84, 0xc2, 0xcc, 0xd8, 0x42, 0x65, 0x28, 0xd5, 0xf0, 0xee, 0x56, 0xa3, 0xbe, 0xd5, 0xda,
+	0x73, 0x1e, 0xef, 0x36, 0x9a, 0xe7, 0x58, 0x0d, 0x58, 0x39, 0xa7, 0xaf, 0x7d, 0xb5, 0x5b, 0x7f,
+	0x54, 0xd4, 0xd0, 0xff, 0x60, 0xf9, 0x9c, 0xa6, 0xf5, 0xec, 0x49, 0xbd, 0xa8, 0x27, 0x98, 0x6c,
+	0x49, 0x4d, 0xaa, 0xfa, 0x4f, 0x0a, 0xd2, 0xad, 0xe8, 0x0f, 0x8f, 0x5e, 0x40, 0x66, 0x3c, 0x3c,
+	0x90, 0x99, 0xd4, 0xa5, 0xb3, 0x73, 0xae, 0x74, 0xef, 0x9d, 0x18, 0x35, 0x20, 0xd7, 0x7f, 0xf8,
+	0xfd, 0xef, 0xd7, 0x7a, 0xc5, 0xbc, 0x63, 0x27, 0x3c, 0x2d, 0x14, 0xf8, 0x73, 0x6d, 0x13, 0x1d,
+	0xc1, 0x82, 0x3c, 0x4f, 0xb4, 0x9a, 0xc0, 0x1a, 0xbf, 0x0d, 0xa5, 0xca, 0xe5, 0x00, 0xe5, 0x73,
+	0x4d, 0xfa, 0x5c, 0x45, 0xef, 0xdb, 0x49, 0xef, 0x0a, 0x6e, 0xbf, 0x08, 0x6f, 0xd0, 0x2b, 0xf4,
+	0x3d, 0xe4, 0x62, 0x7f, 0x06, 0xb4, 0xf6, 0xae, 0x1f, 0xca, 0xd4, 0xfd, 0xfa, 0x55, 0x30, 0x15,
+	0xc4, 0x5d, 0x19, 0xc4, 0x1d, 0xf3, 0x76, 0x72, 0x10, 0x61, 0xce, 0x2f, 0x21, 0x17, 0xfb, 0xa7,
+	0x27, 0x06, 0x70, 0xf1, 0xdd, 0x52, 0x5a, 0xbf, 0x0a, 0xa6, 0x02, 0x28, 0xcb, 0x00, 0x0c, 0x74,
+	0x49, 0x00, 0xb5, 0xfa, 0x9b, 0xd3, 0xb2, 0x76, 0x72, 0x5a, 0xd6, 0xfe, 0x3a, 0x2d, 0x6b, 0x3f,
+	0x9d, 0x95, 0xe7, 0x7e, 0x3d, 0x2b, 0x6b, 0x27, 0x67, 0xe5, 0xb9, 0x3f, 0xce, 0xca, 0x73, 0xdf,
+	0xae, 0x5d, 0xdd, 0xb2, 0xb6, 0x08, 0xda, 0x8b, 0xf2, 0x89, 0xf5, 0xf1, 0xbf, 0x03, 0x00, 0xac,
+	0x3b, 0x01, 0x6e, 0x39, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -853,6 +1071,13 @@ func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.OrderBy != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.OrderBy))
 		i--
@@ -1035,6 +1260,16 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeStateChanges {
+		i--
+		if m.IncludeStateChanges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1077,6 +1312,34 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BalanceChanges) > 0 {
+		for iNdEx := len(m.BalanceChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -1104,6 +1367,134 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PreviousValue) > 0 {
+		i -= len(m.PreviousValue)
+		copy(dAtA[i:], m.PreviousValue)
+		i = encodeVarintService(dAtA, i, uint64(len(m.PreviousValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.After.Size()
+		i -= size
+		if _, err := m.After.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Before.Size()
+		i -= size
+		if _, err := m.Before.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1211,6 +1602,10 @@ func (m *GetTxsEventRequest) Size() (n int) {
 	if m.OrderBy != 0 {
 		n += 1 + sovService(uint64(m.OrderBy))
 	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -1282,6 +1677,9 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.IncludeStateChanges {
+		n += 2
+	}
 	return n
 }
 
@@ -1299,6 +1697,69 @@ func (m *SimulateResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.BalanceChanges) > 0 {
+		for _, e := range m.BalanceChanges {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.PreviousValue)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = m.Before.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovService(uint64(l))
 	return n
 }
 
@@ -1454,24 +1915,56 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -1917,6 +2410,26 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeStateChanges", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeStateChanges = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2039,6 +2552,494 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &StateChange{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceChanges = append(m.BalanceChanges, &BalanceChange{})
+			if err := m.BalanceChanges[len(m.BalanceChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValue = append(m.PreviousValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousValue == nil {
+				m.PreviousValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20,6 +20,7 @@ import (
 
 const (
 	flagEvents = "events"
+	flagQuery  = "query"
 	flagType   = "type"
 
 	typeHash   = "hash"
@@ -175,7 +176,7 @@ func QueryModuleAccountsCmd() *cobra.Command {
 func QueryTxsByEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "txs",
-		Short: "Query for paginated transactions that match a set of events",
+		Short: "Query for paginated transactions that match a set of events or a query",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Search for transactions that match the exact given events where results are paginated.
@@ -183,16 +184,40 @@ Each event takes the form of '%s'. Please refer
 to each module's documentation for the full set of events to query for. Each module
 documents its respective events under 'xx_events.md'.
 
+Alternatively, --%s takes a raw Tendermint query, whose conditions may also use the
+<, <=, >, >=, CONTAINS and EXISTS operators. Several queries may be joined with OR
+to search for the transactions matching any of them.
+
 Example:
 $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator_reward' --page 1 --limit 30
-`, eventFormat, version.AppName, flagEvents),
+$ %s query txs --%s "message.sender='cosmos1...' AND tx.height>=100 OR transfer.recipient='cosmos1...'"
+`, eventFormat, flagQuery, version.AppName, flagEvents, version.AppName, flagQuery),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+
+			page, _ := cmd.Flags().GetInt(flags.FlagPage)
+			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
+
 			eventsRaw, _ := cmd.Flags().GetString(flagEvents)
+			tmQuery, _ := cmd.Flags().GetString(flagQuery)
+			switch {
+			case eventsRaw != "" && tmQuery != "":
+				return fmt.Errorf("either --%s or --%s must be set", flagEvents, flagQuery)
+			case tmQuery != "":
+				txs, _, err := authtx.QueryTxsByQuery(clientCtx, tmQuery, page, limit, nil, "")
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(txs)
+			case eventsRaw == "":
+				return fmt.Errorf("--%s or --%s is required", flagEvents, flagQuery)
+			}
+
 			eventsStr := strings.Trim(eventsRaw, "'")
 
 			var events []string
@@ -221,9 +246,6 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 				tmEvents = append(tmEvents, event)
 			}
 
-			page, _ := cmd.Flags().GetInt(flags.FlagPage)
-			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
-
 			txs, err := authtx.QueryTxsByEvents(clientCtx, tmEvents, page, limit, "")
 			if err != nil {
 				return err
@@ -237,7 +259,7 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 	cmd.Flags().Int(flags.FlagPage, query.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Int(flags.FlagLimit, query.DefaultLimit, "Query number of transactions results per page returned")
 	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
-	cmd.MarkFlagRequired(flagEvents)
+	cmd.Flags().String(flagQuery, "", "Tendermint query of the transactions, whose queries may be joined with OR")
 
 	return cmd
 }
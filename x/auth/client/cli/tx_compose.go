@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagMaxGasPerTx = "max-gas-per-tx"
+	flagMaxTxBytes  = "max-tx-bytes"
+)
+
+// composeFile is the content of the messages file of the compose command.
+type composeFile struct {
+	Messages []json.RawMessage `json:"messages"`
+}
+
+// GetComposeCommand returns the tx compose command.
+func GetComposeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compose [file]",
+		Short: "Build, sign and broadcast transactions from a file of messages",
+		Long: strings.TrimSpace(`Read a JSON or YAML file of messages, which are resolved by their
+@type URL, and send them in as few transactions as possible. The gas of the
+messages is estimated by simulation, and the messages are split, in order, into
+multiple transactions if the --max-gas-per-tx or --max-tx-bytes limits are
+exceeded. All the messages must be signed by the --from account only, and the
+transactions are signed with sequential account sequences.
+
+With --generate-only, the unsigned transactions are printed one per line, which
+can be signed with the sign-batch command.
+
+Example file:
+
+messages:
+- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: cosmos1...
+  to_address: cosmos1...
+  amount: [{denom: stake, amount: "10"}]
+- "@type": /cosmos.staking.v1beta1.MsgDelegate
+  delegator_address: cosmos1...
+  validator_address: cosmosvaloper1...
+  amount: {denom: stake, amount: "100"}
+
+$ <appd> tx compose msgs.yaml --from mykey --max-gas-per-tx 1000000
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := readComposeFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			for i, msg := range msgs {
+				signers := msg.GetSigners()
+				if len(signers) != 1 || !signers[0].Equals(clientCtx.GetFromAddress()) {
+					return fmt.Errorf("message #%d must be signed by %s only, got signers %v", i, clientCtx.GetFromAddress(), signers)
+				}
+			}
+
+			maxGas, err := cmd.Flags().GetUint64(flagMaxGasPerTx)
+			if err != nil {
+				return err
+			}
+			maxBytes, err := cmd.Flags().GetUint64(flagMaxTxBytes)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			batches, err := tx.BatchMsgs(clientCtx, txf, tx.BatchLimits{MaxGas: maxGas, MaxBytes: maxBytes}, msgs)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastMsgBatches(clientCtx, txf, batches)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxGasPerTx, 0, "Maximum gas limit of a transaction, unlimited if 0")
+	cmd.Flags().Uint64(flagMaxTxBytes, 0, "Maximum size in bytes of a transaction, unlimited if 0")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readComposeFile reads the messages of a JSON or YAML file, or of the
+// standard input if the filename is a dash.
+func readComposeFile(cdc codec.Codec, filename string) ([]sdk.Msg, error) {
+	var (
+		bz  []byte
+		err error
+	)
+	if filename == "-" {
+		bz, err = io.ReadAll(os.Stdin)
+	} else {
+		bz, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML is a superset of JSON, so JSON files are converted as is
+	bz, err = yaml.YAMLToJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var file composeFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("invalid messages file: %w", err)
+	}
+	if len(file.Messages) == 0 {
+		return nil, fmt.Errorf("no messages in %s", filename)
+	}
+
+	msgs := make([]sdk.Msg, len(file.Messages))
+	for i, rawMsg := range file.Messages {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid message #%d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
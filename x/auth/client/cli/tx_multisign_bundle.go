@@ -0,0 +1,471 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const flagFinalize = "finalize"
+
+// multisignBundleJSON is the JSON encoding of a multisignBundle.
+type multisignBundleJSON struct {
+	Tx             json.RawMessage `json:"tx"`
+	MultisigPubKey json.RawMessage `json:"multisig_pub_key"`
+	ChainID        string          `json:"chain_id"`
+	AccountNumber  uint64          `json:"account_number,string"`
+	Sequence       uint64          `json:"sequence,string"`
+	Signatures     json.RawMessage `json:"signatures"`
+}
+
+// multisignBundle is an unsigned multisig transaction along with the
+// signatures of the members of the multisig collected so far, and the signer
+// data they sign.
+type multisignBundle struct {
+	tx             sdk.Tx
+	multisigPubKey *kmultisig.LegacyAminoPubKey
+	chainID        string
+	accountNumber  uint64
+	sequence       uint64
+	signatures     []signingtypes.SignatureV2
+}
+
+// multisignMemberStatus is the status of the signature of a member of a
+// multisig, printed by the multisign-status command.
+type multisignMemberStatus struct {
+	Address string `json:"address" yaml:"address"`
+	Signed  bool   `json:"signed" yaml:"signed"`
+}
+
+// multisignStatus is the status of a multisig bundle, printed by the
+// multisign-status command.
+type multisignStatus struct {
+	Address   string                  `json:"address" yaml:"address"`
+	Threshold uint32                  `json:"threshold" yaml:"threshold"`
+	Signed    uint32                  `json:"signed" yaml:"signed"`
+	Complete  bool                    `json:"complete" yaml:"complete"`
+	Members   []multisignMemberStatus `json:"members" yaml:"members"`
+}
+
+// GetMultiSignInitCommand returns the multisign-init command.
+func GetMultiSignInitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-init [file] [name]",
+		Short: "Start collecting the signatures of a multisig transaction generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a partial-signature bundle for the transaction read from [file], which
+must be signed by the multisig key [name]. The bundle contains the unsigned
+transaction, the multisig public key and the signer data, and is printed or
+written to --output-document. It is passed to the members of the multisig,
+whose signatures are added with the multisign-add command.
+
+If the --offline flag is on, the account number and sequence of the multisig
+account are read from the --account-number and --sequence flags.
+
+Example:
+$ %s tx multisign-init transaction.json k1k2k3 --output-document bundle.json
+$ %s tx sign transaction.json --from k1 --multisig k1k2k3 --output-document k1sig.json
+$ %s tx multisign-add bundle.json k1sig.json
+$ %s tx multisign-status bundle.json --finalize
+`,
+				version.AppName, version.AppName, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			k, err := getMultisigRecord(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[1])
+			}
+
+			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if txFactory.ChainID() == "" {
+				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+			}
+			if !clientCtx.Offline {
+				accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(multisigPub.Address()))
+				if err != nil {
+					return err
+				}
+
+				txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+			}
+
+			bundle := multisignBundle{
+				tx:             parsedTx,
+				multisigPubKey: multisigPub,
+				chainID:        txFactory.ChainID(),
+				accountNumber:  txFactory.AccountNumber(),
+				sequence:       txFactory.Sequence(),
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			return writeMultisignBundle(cmd, clientCtx, bundle, outputDoc)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagChainID, "", "network chain ID")
+
+	return cmd
+}
+
+// GetMultiSignAddCommand returns the multisign-add command.
+func GetMultiSignAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-add [bundle] [[signature]...]",
+		Short: "Add the signatures of members of a multisig to a partial-signature bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Read the signatures of members of a multisig from the [signature] files,
+generated by the sign command with the --multisig flag, and add them to the
+partial-signature bundle created by the multisign-init command. Each signature
+is verified against the transaction and the signer data of the bundle, and must
+be signed by a member of the multisig whose signature isn't collected yet.
+
+The bundle file is updated in place, unless --output-document is set.
+
+Example:
+$ %s tx multisign-add bundle.json k1sig.json k2sig.json
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bundle, err := readMultisignBundle(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range args[1:] {
+				sigs, err := unmarshalSignatureJSON(clientCtx, filename)
+				if err != nil {
+					return err
+				}
+
+				for _, sig := range sigs {
+					if err := bundle.addSignature(cmd.Context(), clientCtx.TxConfig, sig); err != nil {
+						return fmt.Errorf("invalid signature in %s: %w", filename, err)
+					}
+				}
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				outputDoc = args[0]
+			}
+
+			return writeMultisignBundle(cmd, clientCtx, bundle, outputDoc)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of being updated in place")
+
+	return cmd
+}
+
+// GetMultiSignStatusCommand returns the multisign-status command.
+func GetMultiSignStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-status [bundle]",
+		Short: "Show the signatures collected in a partial-signature bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Show which members of the multisig have signed the transaction of the
+partial-signature bundle, and which are still missing.
+
+If the --finalize flag is on and the threshold of the multisig is reached, the
+multisig signature is assembled from the collected signatures, and the signed
+transaction is printed or written to --output-document instead.
+
+Example:
+$ %s tx multisign-status bundle.json
+$ %s tx multisign-status bundle.json --finalize --output-document signed.json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bundle, err := readMultisignBundle(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			status := bundle.status()
+
+			if finalize, _ := cmd.Flags().GetBool(flagFinalize); !finalize {
+				return clientCtx.PrintObjectLegacy(status)
+			}
+
+			if !status.Complete {
+				return fmt.Errorf("%d of the %d signatures required by the multisig are collected", status.Signed, status.Threshold)
+			}
+
+			txBuilder, err := bundle.signedTx(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			bz, err := marshalSignatureJSON(clientCtx.TxConfig, txBuilder, false)
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", bz)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagFinalize, false, "Print the transaction signed by the multisig once the threshold is reached")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed transaction is written to the given file instead of STDOUT")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readMultisignBundle reads a partial-signature bundle from a file. The
+// signatures of the bundle are checked as they are by addSignature, so that a
+// bundle edited by hand can't hold the signature of a non-member or an invalid
+// one.
+func readMultisignBundle(ctx context.Context, clientCtx client.Context, filename string) (multisignBundle, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return multisignBundle{}, err
+	}
+
+	var bundleJSON multisignBundleJSON
+	if err := json.Unmarshal(bz, &bundleJSON); err != nil {
+		return multisignBundle{}, fmt.Errorf("invalid multisig bundle: %w", err)
+	}
+
+	parsedTx, err := clientCtx.TxConfig.TxJSONDecoder()(bundleJSON.Tx)
+	if err != nil {
+		return multisignBundle{}, err
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bundleJSON.MultisigPubKey, &pubKey); err != nil {
+		return multisignBundle{}, err
+	}
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return multisignBundle{}, fmt.Errorf("invalid multisig bundle: %T is not a multisig public key", pubKey)
+	}
+
+	sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bundleJSON.Signatures)
+	if err != nil {
+		return multisignBundle{}, err
+	}
+
+	bundle := multisignBundle{
+		tx:             parsedTx,
+		multisigPubKey: multisigPub,
+		chainID:        bundleJSON.ChainID,
+		accountNumber:  bundleJSON.AccountNumber,
+		sequence:       bundleJSON.Sequence,
+	}
+	for _, sig := range sigs {
+		if err := bundle.addSignature(ctx, clientCtx.TxConfig, sig); err != nil {
+			return multisignBundle{}, fmt.Errorf("invalid multisig bundle: %w", err)
+		}
+	}
+
+	return bundle, nil
+}
+
+// writeMultisignBundle writes a partial-signature bundle to a file, or prints
+// it if the filename is empty.
+func writeMultisignBundle(cmd *cobra.Command, clientCtx client.Context, bundle multisignBundle, filename string) error {
+	txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(bundle.tx)
+	if err != nil {
+		return err
+	}
+
+	pubKeyJSON, err := clientCtx.Codec.MarshalInterfaceJSON(bundle.multisigPubKey)
+	if err != nil {
+		return err
+	}
+
+	sigsJSON, err := clientCtx.TxConfig.MarshalSignatureJSON(bundle.signatures)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(multisignBundleJSON{
+		Tx:             txJSON,
+		MultisigPubKey: pubKeyJSON,
+		ChainID:        bundle.chainID,
+		AccountNumber:  bundle.accountNumber,
+		Sequence:       bundle.sequence,
+		Signatures:     sigsJSON,
+	})
+	if err != nil {
+		return err
+	}
+
+	if filename == "" {
+		cmd.Printf("%s\n", bz)
+		return nil
+	}
+
+	return os.WriteFile(filename, append(bz, '\n'), 0644)
+}
+
+// memberIndex returns the index of the member of the multisig with the given
+// public key, or -1.
+func (b multisignBundle) memberIndex(pubKey cryptotypes.PubKey) int {
+	for i, member := range b.multisigPubKey.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// signerIndex returns the position of the multisig among the signers of the
+// transaction.
+func (b multisignBundle) signerIndex() (int, error) {
+	addr := sdk.AccAddress(b.multisigPubKey.Address())
+
+	for i, signer := range b.tx.(signing.SigVerifiableTx).GetSigners() {
+		if signer.Equals(addr) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("the multisig %s is not a signer of the transaction", addr)
+}
+
+// addSignature verifies the signature of a member of the multisig and adds it
+// to the bundle.
+func (b *multisignBundle) addSignature(ctx context.Context, txConfig client.TxConfig, sig signingtypes.SignatureV2) error {
+	if sig.PubKey == nil {
+		return fmt.Errorf("signature without public key")
+	}
+	addr := sdk.AccAddress(sig.PubKey.Address())
+
+	if b.memberIndex(sig.PubKey) < 0 {
+		return fmt.Errorf("%s is not a member of the multisig", addr)
+	}
+	for _, collected := range b.signatures {
+		if collected.PubKey.Equals(sig.PubKey) {
+			return fmt.Errorf("the signature of %s is already collected", addr)
+		}
+	}
+
+	signerIndex, err := b.signerIndex()
+	if err != nil {
+		return err
+	}
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       b.chainID,
+		AccountNumber: b.accountNumber,
+		Sequence:      b.sequence,
+		SignerIndex:   signerIndex,
+	}
+	if err := signing.VerifySignatureWithContext(ctx, sig.PubKey, signingData, sig.Data, txConfig.SignModeHandler(), b.tx); err != nil {
+		return fmt.Errorf("couldn't verify signature for address %s: %w", addr, err)
+	}
+
+	b.signatures = append(b.signatures, sig)
+
+	return nil
+}
+
+// status returns the signature status of the members of the multisig.
+func (b multisignBundle) status() multisignStatus {
+	members := b.multisigPubKey.GetPubKeys()
+	status := multisignStatus{
+		Address:   sdk.AccAddress(b.multisigPubKey.Address()).String(),
+		Threshold: b.multisigPubKey.Threshold,
+		Signed:    uint32(len(b.signatures)),
+		Members:   make([]multisignMemberStatus, len(members)),
+	}
+
+	for i, member := range members {
+		status.Members[i].Address = sdk.AccAddress(member.Address()).String()
+	}
+	for _, sig := range b.signatures {
+		status.Members[b.memberIndex(sig.PubKey)].Signed = true
+	}
+	status.Complete = status.Signed >= status.Threshold
+
+	return status
+}
+
+// signedTx returns the transaction of the bundle signed by the multisig,
+// whose signature is assembled from the collected signatures.
+func (b multisignBundle) signedTx(txConfig client.TxConfig) (client.TxBuilder, error) {
+	txBuilder, err := txConfig.WrapTxBuilder(b.tx)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(b.multisigPubKey.PubKeys))
+	for _, sig := range b.signatures {
+		if err := multisig.AddSignatureV2(multisigSig, sig, b.multisigPubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   b.multisigPubKey,
+		Data:     multisigSig,
+		Sequence: b.sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
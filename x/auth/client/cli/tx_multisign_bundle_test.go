@@ -0,0 +1,173 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	bundleChainID  = "test-chain"
+	bundleAccNum   = 7
+	bundleSequence = 3
+)
+
+// multisignBundleFixture holds an unsigned multisig transaction along with
+// the keys of the members of the 2-of-2 multisig and of a non-member.
+type multisignBundleFixture struct {
+	clientCtx   client.Context
+	txBuilder   client.TxBuilder
+	multisigPub *kmultisig.LegacyAminoPubKey
+	members     []cryptotypes.PrivKey
+	nonMember   cryptotypes.PrivKey
+}
+
+func newMultisignBundleFixture(t *testing.T) multisignBundleFixture {
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithLegacyAmino(encCfg.Amino).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	members := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{members[0].PubKey(), members[1].PubKey()})
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	msg := banktypes.NewMsgSend(sdk.AccAddress(multisigPub.Address()), sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	return multisignBundleFixture{
+		clientCtx:   clientCtx,
+		txBuilder:   txBuilder,
+		multisigPub: multisigPub,
+		members:     members,
+		nonMember:   secp256k1.GenPrivKey(),
+	}
+}
+
+// sign returns the signature of the transaction by the key, for the given
+// sequence.
+func (f multisignBundleFixture) sign(t *testing.T, priv cryptotypes.PrivKey, sequence uint64) signingtypes.SignatureV2 {
+	signerData := authsigning.SignerData{
+		Address:       sdk.AccAddress(priv.PubKey().Address()).String(),
+		ChainID:       bundleChainID,
+		AccountNumber: bundleAccNum,
+		Sequence:      sequence,
+	}
+	sig, err := tx.SignWithPrivKey(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, f.txBuilder, priv, f.clientCtx.TxConfig, sequence)
+	require.NoError(t, err)
+
+	return sig
+}
+
+// writeBundle writes a bundle with the given signatures to a temporary file
+// and returns its name.
+func (f multisignBundleFixture) writeBundle(t *testing.T, sigs ...signingtypes.SignatureV2) string {
+	txJSON, err := f.clientCtx.TxConfig.TxJSONEncoder()(f.txBuilder.GetTx())
+	require.NoError(t, err)
+	pubKeyJSON, err := f.clientCtx.Codec.MarshalInterfaceJSON(f.multisigPub)
+	require.NoError(t, err)
+	sigsJSON, err := f.clientCtx.TxConfig.MarshalSignatureJSON(sigs)
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(map[string]interface{}{
+		"tx":               json.RawMessage(txJSON),
+		"multisig_pub_key": json.RawMessage(pubKeyJSON),
+		"chain_id":         bundleChainID,
+		"account_number":   fmt.Sprint(bundleAccNum),
+		"sequence":         fmt.Sprint(bundleSequence),
+		"signatures":       json.RawMessage(sigsJSON),
+	})
+	require.NoError(t, err)
+
+	return testutil.WriteToNewTempFile(t, string(bz)).Name()
+}
+
+func TestMultiSignStatusBundleSignatures(t *testing.T) {
+	f := newMultisignBundleFixture(t)
+
+	member := f.sign(t, f.members[0], bundleSequence)
+
+	tampered := f.sign(t, f.members[1], bundleSequence)
+	tamperedData := *tampered.Data.(*signingtypes.SingleSignatureData)
+	tamperedData.Signature = append([]byte{}, tamperedData.Signature...)
+	tamperedData.Signature[0] ^= 0xff
+	tampered.Data = &tamperedData
+
+	testCases := []struct {
+		name   string
+		sigs   []signingtypes.SignatureV2
+		expErr string
+	}{
+		{"no signatures", nil, ""},
+		{"member signature", []signingtypes.SignatureV2{member}, ""},
+		{"foreign signature", []signingtypes.SignatureV2{f.sign(t, f.nonMember, bundleSequence)}, "is not a member of the multisig"},
+		{"duplicate signature", []signingtypes.SignatureV2{member, member}, "is already collected"},
+		{"tampered signature", []signingtypes.SignatureV2{member, tampered}, "couldn't verify signature"},
+		{"signature for another sequence", []signingtypes.SignatureV2{f.sign(t, f.members[1], bundleSequence+1)}, "couldn't verify signature"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundleFile := f.writeBundle(t, tc.sigs...)
+
+			_, err := clitestutil.ExecTestCLICmd(f.clientCtx, cli.GetMultiSignStatusCommand(), []string{bundleFile, "--output=json"})
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = clitestutil.ExecTestCLICmd(f.clientCtx, cli.GetMultiSignStatusCommand(), []string{bundleFile, "--finalize"})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMultiSignStatusFinalize(t *testing.T) {
+	f := newMultisignBundleFixture(t)
+
+	bundleFile := f.writeBundle(t, f.sign(t, f.members[0], bundleSequence), f.sign(t, f.members[1], bundleSequence))
+
+	out, err := clitestutil.ExecTestCLICmd(f.clientCtx, cli.GetMultiSignStatusCommand(), []string{bundleFile, "--finalize"})
+	require.NoError(t, err)
+
+	signedTx, err := f.clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+	sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, f.multisigPub.Equals(sigs[0].PubKey))
+}
+
+func TestMultiSignStatusNotSigner(t *testing.T) {
+	f := newMultisignBundleFixture(t)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("sender"), sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, f.txBuilder.SetMsgs(msg))
+	bundleFile := f.writeBundle(t, f.sign(t, f.members[0], bundleSequence))
+
+	_, err := clitestutil.ExecTestCLICmd(f.clientCtx, cli.GetMultiSignStatusCommand(), []string{bundleFile})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not a signer of the transaction")
+}
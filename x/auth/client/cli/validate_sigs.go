@@ -112,7 +112,7 @@ func printAndValidateSigs(
 				Sequence:      accSeq,
 				SignerIndex:   i,
 			}
-			err = authsigning.VerifySignature(pubKey, signingData, sig.Data, signModeHandler, sigTx)
+			err = authsigning.VerifySignatureWithContext(cmd.Context(), pubKey, signingData, sig.Data, signModeHandler, sigTx)
 			if err != nil {
 				return false
 			}
@@ -67,6 +67,31 @@ func TxMultiSignExec(clientCtx client.Context, from string, filename string, ext
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignCommand(), append(args, extraArgs...))
 }
 
+func TxMultiSignInitExec(clientCtx client.Context, from string, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, clientCtx.ChainID),
+		filename,
+		from,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignInitCommand(), append(args, extraArgs...))
+}
+
+func TxMultiSignAddExec(clientCtx client.Context, bundleFile string, sigFiles ...string) (testutil.BufferWriter, error) {
+	args := append([]string{bundleFile}, sigFiles...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignAddCommand(), args)
+}
+
+func TxMultiSignStatusExec(clientCtx client.Context, bundleFile string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		bundleFile,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignStatusCommand(), append(args, extraArgs...))
+}
+
 func TxSignBatchExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
@@ -77,6 +102,16 @@ func TxSignBatchExec(clientCtx client.Context, from fmt.Stringer, filename strin
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetSignBatchCommand(), append(args, extraArgs...))
 }
 
+func TxComposeExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from.String()),
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetComposeCommand(), append(args, extraArgs...))
+}
+
 func TxDecodeExec(clientCtx client.Context, encodedTx string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
@@ -277,6 +277,75 @@ func (s *IntegrationTestSuite) TestCLISignBatch() {
 	s.Require().Error(err)
 }
 
+func (s *IntegrationTestSuite) TestCLICompose() {
+	val := s.network.Validators[0]
+	_, _, toAddr := testdata.KeyTestPubAddr()
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	msgSend := fmt.Sprintf(`- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: %s
+  to_address: %s
+  amount: [{denom: %s, amount: "10"}]
+`, val.Address, toAddr, s.cfg.BondDenom)
+	oneMsgFile := testutil.WriteToNewTempFile(s.T(), "messages:\n"+msgSend)
+	msgsFile := testutil.WriteToNewTempFile(s.T(), "messages:\n"+strings.Repeat(msgSend, 3))
+
+	feeFlag := fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))))
+	generateOnlyFlag := fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)
+
+	// the messages fit in a single transaction without limits
+	res, err := TxComposeExec(val.ClientCtx, val.Address, msgsFile.Name(), feeFlag, generateOnlyFlag)
+	s.Require().NoError(err)
+	txs := strings.Split(strings.Trim(res.String(), "\n"), "\n")
+	s.Require().Len(txs, 1)
+	stdTx, err := val.ClientCtx.TxConfig.TxJSONDecoder()([]byte(txs[0]))
+	s.Require().NoError(err)
+	s.Require().Len(stdTx.GetMsgs(), 3)
+
+	// the gas of a single message is used to limit the transactions to two
+	// messages
+	res, err = TxComposeExec(val.ClientCtx, val.Address, oneMsgFile.Name(), feeFlag, generateOnlyFlag)
+	s.Require().NoError(err)
+	stdTx, err = val.ClientCtx.TxConfig.TxJSONDecoder()(res.Bytes())
+	s.Require().NoError(err)
+	msgGas := stdTx.(sdk.FeeTx).GetGas()
+	maxGasFlag := fmt.Sprintf("--%s=%d", "max-gas-per-tx", 2*msgGas)
+
+	res, err = TxComposeExec(val.ClientCtx, val.Address, msgsFile.Name(), feeFlag, generateOnlyFlag, maxGasFlag)
+	s.Require().NoError(err)
+	txs = strings.Split(strings.Trim(res.String(), "\n"), "\n")
+	s.Require().Len(txs, 2)
+
+	// a single message can't exceed the limit
+	_, err = TxComposeExec(val.ClientCtx, val.Address, msgsFile.Name(), feeFlag, generateOnlyFlag,
+		fmt.Sprintf("--%s=%d", "max-gas-per-tx", msgGas/2))
+	s.Require().Error(err)
+
+	// the messages must be signed by the sender only
+	_, err = TxComposeExec(val.ClientCtx, s.network.Validators[1].Address, msgsFile.Name(), feeFlag, generateOnlyFlag)
+	s.Require().Error(err)
+
+	// broadcast the two transactions with sequential sequences
+	res, err = TxComposeExec(val.ClientCtx, val.Address, msgsFile.Name(), feeFlag, maxGasFlag,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	)
+	s.Require().NoError(err)
+	txResponses := strings.Split(strings.Trim(res.String(), "\n"), "\n")
+	s.Require().Len(txResponses, 2)
+	for _, txResponse := range txResponses {
+		var txRes sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON([]byte(txResponse), &txRes), txResponse)
+		s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+	}
+
+	resp, err := bankcli.QueryBalancesExec(val.ClientCtx, toAddr)
+	s.Require().NoError(err)
+	var balRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(resp.Bytes(), &balRes))
+	s.Require().Equal(amount.Add(amount...).Add(amount...), balRes.Balances)
+}
+
 func (s *IntegrationTestSuite) TestCLISignAminoJSON() {
 	require := s.Require()
 	val1 := s.network.Validators[0]
@@ -615,6 +684,25 @@ func (s *IntegrationTestSuite) TestCLIQueryTxsCmdByEvents() {
 			},
 			true,
 		},
+		{
+			"fee query with or",
+			[]string{
+				fmt.Sprintf("--query=tx.fee='%s' OR tx.fee='%s'",
+					sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(0))).String(),
+					sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+		{
+			"no matching height range query",
+			[]string{
+				fmt.Sprintf("--query=tx.fee='%s' AND tx.height<0",
+					sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -967,6 +1055,123 @@ func (s *IntegrationTestSuite) TestCLIMultisignSortSignatures() {
 	s.Require().NoError(s.network.WaitForNextBlock())
 }
 
+func (s *IntegrationTestSuite) TestCLIMultisignBundle() {
+	val1 := s.network.Validators[0]
+
+	account1, err := val1.ClientCtx.Keyring.Key("newAccount1")
+	s.Require().NoError(err)
+
+	account2, err := val1.ClientCtx.Keyring.Key("newAccount2")
+	s.Require().NoError(err)
+
+	multisigRecord, err := val1.ClientCtx.Keyring.Key("multi")
+	s.Require().NoError(err)
+
+	addr, err := multisigRecord.GetAddress()
+	s.Require().NoError(err)
+
+	// Send coins from validator to multisig.
+	_, err = s.createBankMsg(val1, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 20)))
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// Generate multisig transaction.
+	multiGeneratedTx, err := bankcli.MsgSendExec(
+		val1.ClientCtx,
+		addr,
+		val1.Address,
+		sdk.NewCoins(
+			sdk.NewInt64Coin(s.cfg.BondDenom, 3),
+		),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+
+	// Create the bundle.
+	bundle, err := TxMultiSignInitExec(val1.ClientCtx, multisigRecord.Name, multiGeneratedTxFile.Name())
+	s.Require().NoError(err)
+	bundleFile := testutil.WriteToNewTempFile(s.T(), bundle.String())
+
+	_, err = TxMultiSignInitExec(val1.ClientCtx, "newAccount1", multiGeneratedTxFile.Name())
+	s.Require().Error(err)
+
+	// Sign with the members.
+	val1.ClientCtx.HomeDir = strings.Replace(val1.ClientCtx.HomeDir, "simd", "simcli", 1)
+	addr1, err := account1.GetAddress()
+	s.Require().NoError(err)
+	account1Signature, err := TxSignExec(val1.ClientCtx, addr1, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	sign1File := testutil.WriteToNewTempFile(s.T(), account1Signature.String())
+
+	addr2, err := account2.GetAddress()
+	s.Require().NoError(err)
+	account2Signature, err := TxSignExec(val1.ClientCtx, addr2, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	sign2File := testutil.WriteToNewTempFile(s.T(), account2Signature.String())
+
+	// A signature of the validator, which isn't a member, is rejected.
+	valSignature, err := TxSignExec(val1.ClientCtx, val1.Address, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	valSignFile := testutil.WriteToNewTempFile(s.T(), valSignature.String())
+	_, err = TxMultiSignAddExec(val1.ClientCtx, bundleFile.Name(), valSignFile.Name())
+	s.Require().Error(err)
+
+	// Add the signature of account1.
+	_, err = TxMultiSignAddExec(val1.ClientCtx, bundleFile.Name(), sign1File.Name())
+	s.Require().NoError(err)
+	_, err = TxMultiSignAddExec(val1.ClientCtx, bundleFile.Name(), sign1File.Name())
+	s.Require().Error(err)
+
+	out, err := TxMultiSignStatusExec(val1.ClientCtx, bundleFile.Name(), fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+	s.Require().NoError(err)
+	var status struct {
+		Threshold int  `json:"threshold"`
+		Signed    int  `json:"signed"`
+		Complete  bool `json:"complete"`
+		Members   []struct {
+			Address string `json:"address"`
+			Signed  bool   `json:"signed"`
+		} `json:"members"`
+	}
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &status))
+	s.Require().Equal(2, status.Threshold)
+	s.Require().Equal(1, status.Signed)
+	s.Require().False(status.Complete)
+	s.Require().Len(status.Members, 2)
+	for _, member := range status.Members {
+		s.Require().Equal(member.Address == addr1.String(), member.Signed)
+	}
+
+	_, err = TxMultiSignStatusExec(val1.ClientCtx, bundleFile.Name(), "--finalize")
+	s.Require().Error(err)
+
+	// Add the signature of account2 and finalize the transaction.
+	_, err = TxMultiSignAddExec(val1.ClientCtx, bundleFile.Name(), sign2File.Name())
+	s.Require().NoError(err)
+
+	out, err = TxMultiSignStatusExec(val1.ClientCtx, bundleFile.Name(), fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+	s.Require().NoError(err)
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &status))
+	s.Require().True(status.Complete)
+
+	signedTx, err := TxMultiSignStatusExec(val1.ClientCtx, bundleFile.Name(), "--finalize")
+	s.Require().NoError(err)
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String())
+
+	_, err = TxValidateSignaturesExec(val1.ClientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+
+	val1.ClientCtx.BroadcastMode = flags.BroadcastBlock
+	out, err = TxBroadcastExec(val1.ClientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+
+	var txRes sdk.TxResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+}
+
 func (s *IntegrationTestSuite) TestCLIMultisign() {
 	val1 := s.network.Validators[0]
 
@@ -900,16 +900,12 @@ func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []cryptoty
 	pubkeys = make([]cryptotypes.PubKey, n)
 	signatures = make([][]byte, n)
 	for i := 0; i < n; i++ {
-		var privkey cryptotypes.PrivKey = secp256k1.GenPrivKey()
-
-		// TODO: also generate ed25519 keys as below when ed25519 keys are
-		//  actually supported, https://github.com/cosmos/cosmos-sdk/issues/4789
-		// for now this fails:
-		//if rand.Int63()%2 == 0 {
-		//	privkey = ed25519.GenPrivKey()
-		//} else {
-		//	privkey = secp256k1.GenPrivKey()
-		//}
+		var privkey cryptotypes.PrivKey
+		if i%2 == 0 {
+			privkey = ed25519.GenPrivKey()
+		} else {
+			privkey = secp256k1.GenPrivKey()
+		}
 
 		pubkeys[i] = privkey.PubKey()
 		signatures[i], _ = privkey.Sign(msg)
@@ -188,6 +188,7 @@ func TestMigrate(t *testing.T) {
           }
         ]
       },
+      "funder_address": "",
       "start_time": "1580309975",
       "vesting_periods": [
         {
@@ -95,7 +95,7 @@ func TestVerifySignature(t *testing.T) {
 	stdTx = legacytx.NewStdTx(msgs, fee, []legacytx.StdSignature{stdSig1, stdSig2}, memo)
 	stdTx.TimeoutHeight = 10
 
-	err = signing.VerifySignature(multisigKey, signerData, multisignature, handler, stdTx)
+	err = signing.VerifySignatureWithContext(sdk.WrapSDKContext(ctx), multisigKey, signerData, multisignature, handler, stdTx)
 	require.NoError(t, err)
 }
 
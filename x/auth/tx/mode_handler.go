@@ -5,6 +5,7 @@ import (
 
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -15,8 +16,9 @@ var DefaultSignModes = []signingtypes.SignMode{
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON, as
+// well as SIGN_MODE_TEXTUAL if a textual handler is provided.
+func makeSignModeHandler(modes []signingtypes.SignMode, textualHandler *textual.SignModeHandler) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -31,6 +33,11 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeLegacyAminoJSONHandler{}
 		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
 			handlers[i] = signModeDirectAuxHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			if textualHandler == nil {
+				panic(fmt.Errorf("%s requires a textual sign mode handler", mode))
+			}
+			handlers[i] = signModeTextualHandler{t: textualHandler}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -2,12 +2,16 @@ package tx
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -64,6 +68,261 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 	return result, nil
 }
 
+const (
+	// maxTxSearchPerPage is the maximum number of transactions per page returned
+	// by the Tendermint tx search.
+	maxTxSearchPerPage = 100
+
+	// maxTxQueryGroups is the maximum number of queries joined with 'OR'.
+	maxTxQueryGroups = 10
+
+	// maxTxQueryResults bounds the number of transactions searched for each
+	// query joined with 'OR', i.e. offset+limit without a cursor and limit with
+	// a cursor. The transactions beyond it are paginated with the cursor.
+	maxTxQueryResults = 1000
+)
+
+// QueryTxsByQuery performs a search for transactions matching a query via the
+// Tendermint RPC. The query is made of Tendermint queries, e.g.
+// "message.sender='cosmos1...' AND tx.height>=100", which may be joined with an
+// 'OR' operand to search the transactions matching any of them.
+//
+// The transactions are ordered by height and index, following orderBy, and are
+// either those of the given page or, if cursor is set, the limit transactions
+// following the cursor. The key of the last returned transaction is returned
+// when more transactions may match the query, as the cursor of the next page.
+// The total count of the result is only set when the query has no 'OR' and no
+// cursor is given. Otherwise, the first offset+limit transactions of each query
+// are searched, up to maxTxQueryResults.
+func QueryTxsByQuery(clientCtx client.Context, query string, page, limit int, cursor []byte, orderBy string) (*sdk.SearchTxsResult, []byte, error) {
+	groups, err := splitTxQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if page <= 0 {
+		return nil, nil, errors.New("page must greater than 0")
+	}
+
+	if limit <= 0 {
+		return nil, nil, errors.New("limit must greater than 0")
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		resTxs     []*ctypes.ResultTx
+		totalCount int
+		more       bool
+	)
+	if len(groups) == 1 && len(cursor) == 0 {
+		// TODO: this may not always need to be proven
+		// https://github.com/cosmos/cosmos-sdk/issues/6807
+		res, err := node.TxSearch(context.Background(), groups[0], true, &page, &limit, orderBy)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resTxs, totalCount, more = res.Txs, res.TotalCount, page*limit < res.TotalCount
+	} else {
+		var after *txKey
+		offset := (page - 1) * limit
+		if len(cursor) > 0 {
+			key, err := decodeTxKey(cursor)
+			if err != nil {
+				return nil, nil, err
+			}
+			after, offset = &key, 0
+		}
+		if offset+limit > maxTxQueryResults {
+			return nil, nil, fmt.Errorf("offset and limit of queries with OR or a pagination key must not exceed %d, use the pagination key to get the next transactions", maxTxQueryResults)
+		}
+
+		// the first offset+limit transactions of each query are merged, as the
+		// transactions of the page are among them
+		desc := orderBy == "desc"
+		for _, group := range groups {
+			res, groupMore, err := searchTxsAfter(node, group, orderBy, after, offset+limit)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			resTxs = append(resTxs, res...)
+			more = more || groupMore
+		}
+
+		sort.Slice(resTxs, func(i, j int) bool {
+			return newTxKey(resTxs[i]).precedes(newTxKey(resTxs[j]), desc)
+		})
+		resTxs = dedupTxResults(resTxs)
+
+		if len(resTxs) > offset+limit {
+			more = true
+		}
+		switch {
+		case len(resTxs) <= offset:
+			resTxs = nil
+		case len(resTxs) > offset+limit:
+			resTxs = resTxs[offset : offset+limit]
+		default:
+			resTxs = resTxs[offset:]
+		}
+	}
+
+	resBlocks, err := getBlocksForTxResults(clientCtx, resTxs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs, err := formatTxResults(clientCtx.TxConfig, resTxs, resBlocks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextKey []byte
+	if more && len(resTxs) > 0 {
+		nextKey = newTxKey(resTxs[len(resTxs)-1]).bytes()
+	}
+
+	result := sdk.NewSearchTxsResult(uint64(totalCount), uint64(len(txs)), uint64(page), uint64(limit), txs)
+
+	return result, nextKey, nil
+}
+
+// splitTxQuery splits a query on its 'OR' operands, which aren't quoted, and
+// checks each of the resulting Tendermint queries.
+func splitTxQuery(query string) ([]string, error) {
+	const or = " OR "
+
+	var (
+		groups []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i <= len(query); i++ {
+		switch {
+		case i == len(query):
+		case query[i] == '\'':
+			quoted = !quoted
+			continue
+		case quoted || !strings.HasPrefix(query[i:], or):
+			continue
+		}
+
+		group := strings.TrimSpace(query[start:i])
+		if _, err := tmquery.New(group); err != nil {
+			return nil, fmt.Errorf("invalid query %q: %w", group, err)
+		}
+		groups = append(groups, group)
+		if len(groups) > maxTxQueryGroups {
+			return nil, fmt.Errorf("the query must not join more than %d queries with OR", maxTxQueryGroups)
+		}
+
+		start = i + len(or)
+		i = start - 1
+	}
+
+	return groups, nil
+}
+
+// searchTxsAfter returns the first n transactions matching the Tendermint
+// query which follow the transaction of the key, if any, in the given order.
+// It also returns whether more transactions match the query.
+func searchTxsAfter(node rpcclient.Client, query, orderBy string, after *txKey, n int) ([]*ctypes.ResultTx, bool, error) {
+	desc := orderBy == "desc"
+	if after != nil {
+		op := ">="
+		if desc {
+			op = "<="
+		}
+		query = fmt.Sprintf("%s AND tx.height %s %d", query, op, after.height)
+	}
+
+	var resTxs []*ctypes.ResultTx
+	for page := 1; ; page++ {
+		perPage := maxTxSearchPerPage
+		// the merged transactions aren't proven, as their proofs aren't returned
+		res, err := node.TxSearch(context.Background(), query, false, &page, &perPage, orderBy)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, resTx := range res.Txs {
+			if after != nil && !after.precedes(newTxKey(resTx), desc) {
+				continue
+			}
+			if len(resTxs) == n {
+				return resTxs, true, nil
+			}
+			resTxs = append(resTxs, resTx)
+		}
+
+		if page*perPage >= res.TotalCount {
+			return resTxs, false, nil
+		}
+	}
+}
+
+// dedupTxResults removes the duplicates of the sorted transactions.
+func dedupTxResults(resTxs []*ctypes.ResultTx) []*ctypes.ResultTx {
+	out := resTxs[:0]
+	for i, resTx := range resTxs {
+		if i > 0 && newTxKey(resTx) == newTxKey(out[len(out)-1]) {
+			continue
+		}
+		out = append(out, resTx)
+	}
+
+	return out
+}
+
+// txKey is the position of a transaction in the chain, which is used as the
+// cursor of the tx search pagination.
+type txKey struct {
+	height int64
+	index  uint32
+}
+
+// txKeyLen is the length of an encoded txKey: 8 bytes for the height and 4 for
+// the index.
+const txKeyLen = 12
+
+func newTxKey(resTx *ctypes.ResultTx) txKey {
+	return txKey{height: resTx.Height, index: resTx.Index}
+}
+
+func decodeTxKey(bz []byte) (txKey, error) {
+	if len(bz) != txKeyLen {
+		return txKey{}, fmt.Errorf("invalid pagination key length, expected %d bytes, got %d", txKeyLen, len(bz))
+	}
+
+	return txKey{
+		height: int64(binary.BigEndian.Uint64(bz)),
+		index:  binary.BigEndian.Uint32(bz[8:]),
+	}, nil
+}
+
+func (k txKey) bytes() []byte {
+	bz := make([]byte, txKeyLen)
+	binary.BigEndian.PutUint64(bz, uint64(k.height))
+	binary.BigEndian.PutUint32(bz[8:], k.index)
+
+	return bz
+}
+
+// precedes returns whether the transaction of k comes before the one of other
+// in the ascending order, or in the descending order if desc is true.
+func (k txKey) precedes(other txKey, desc bool) bool {
+	if k.height != other.height {
+		return (k.height < other.height) != desc
+	}
+
+	return k.index != other.index && (k.index < other.index) != desc
+}
+
 // QueryTx queries for a single transaction by a hash string in hex format. An
 // error is returned if the transaction does not exist or cannot be queried.
 func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
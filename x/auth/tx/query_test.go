@@ -0,0 +1,73 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTxQuery(t *testing.T) {
+	testCases := []struct {
+		name      string
+		query     string
+		expGroups []string
+		expErr    bool
+	}{
+		{
+			"single query",
+			"message.sender='cosmos1abc' AND tx.height>=10",
+			[]string{"message.sender='cosmos1abc' AND tx.height>=10"},
+			false,
+		},
+		{
+			"or queries",
+			"message.sender='cosmos1abc'  OR  transfer.recipient='cosmos1abc' OR tx.height<5",
+			[]string{"message.sender='cosmos1abc'", "transfer.recipient='cosmos1abc'", "tx.height<5"},
+			false,
+		},
+		{
+			"quoted or",
+			"message.memo='foo OR bar'",
+			[]string{"message.memo='foo OR bar'"},
+			false,
+		},
+		{"empty query", "", nil, true},
+		{"empty or query", "message.sender='cosmos1abc' OR ", nil, true},
+		{"invalid query", "message.sender=", nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			groups, err := splitTxQuery(tc.query)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expGroups, groups)
+		})
+	}
+}
+
+func TestTxKey(t *testing.T) {
+	key := txKey{height: 42, index: 3}
+
+	decoded, err := decodeTxKey(key.bytes())
+	require.NoError(t, err)
+	require.Equal(t, key, decoded)
+
+	_, err = decodeTxKey([]byte("foo"))
+	require.Error(t, err)
+
+	require.True(t, key.precedes(txKey{height: 42, index: 4}, false))
+	require.True(t, key.precedes(txKey{height: 43, index: 0}, false))
+	require.False(t, key.precedes(txKey{height: 42, index: 2}, false))
+	require.False(t, key.precedes(key, false))
+
+	require.True(t, key.precedes(txKey{height: 42, index: 2}, true))
+	require.True(t, key.precedes(txKey{height: 41, index: 5}, true))
+	require.False(t, key.precedes(txKey{height: 43, index: 0}, true))
+	require.False(t, key.precedes(key, true))
+}
@@ -16,16 +16,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	pagination "github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithStateChangesFn is the signature of the
+// Baseapp#SimulateWithStateChanges function.
+type baseAppSimulateWithStateChangesFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, []*txtypes.StateChange, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx                client.Context
+	simulate                 baseAppSimulateFn
+	simulateWithStateChanges baseAppSimulateWithStateChangesFn
+	interfaceRegistry        codectypes.InterfaceRegistry
 }
 
 // NewTxServer creates a new Tx service server.
@@ -37,6 +43,22 @@ func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interface
 	}
 }
 
+// NewTxServerWithStateChanges creates a new Tx service server, whose Simulate
+// method also supports returning the state changes of the transactions.
+func NewTxServerWithStateChanges(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	simulateWithStateChanges baseAppSimulateWithStateChangesFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) txtypes.ServiceServer {
+	return txServer{
+		clientCtx:                clientCtx,
+		simulate:                 simulate,
+		simulateWithStateChanges: simulateWithStateChanges,
+		interfaceRegistry:        interfaceRegistry,
+	}
+}
+
 var _ txtypes.ServiceServer = txServer{}
 
 const (
@@ -55,7 +77,14 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 	}
 	orderBy := parseOrderBy(req.OrderBy)
 
-	if len(req.Events) == 0 {
+	switch {
+	case req.Query != "" && len(req.Events) > 0:
+		return nil, status.Error(codes.InvalidArgument, "either events or query must be set")
+	case req.Query != "":
+		if _, err := splitTxQuery(req.Query); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	case len(req.Events) == 0:
 		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
 	}
 
@@ -65,7 +94,25 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		}
 	}
 
-	result, err := QueryTxsByEvents(s.clientCtx, req.Events, page, limit, orderBy)
+	var (
+		result  *sdk.SearchTxsResult
+		nextKey []byte
+	)
+	if req.Query == "" && (req.Pagination == nil || len(req.Pagination.Key) == 0) {
+		result, err = QueryTxsByEvents(s.clientCtx, req.Events, page, limit, orderBy)
+	} else {
+		query := req.Query
+		if query == "" {
+			query = strings.Join(req.Events, " AND ")
+		}
+
+		var cursor []byte
+		if req.Pagination != nil {
+			cursor = req.Pagination.Key
+		}
+
+		result, nextKey, err = QueryTxsByQuery(s.clientCtx, query, page, limit, cursor, orderBy)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +133,8 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		Txs:         txsList,
 		TxResponses: result.Txs,
 		Pagination: &pagination.PageResponse{
-			Total: result.TotalCount,
+			NextKey: nextKey,
+			Total:   result.TotalCount,
 		},
 	}, nil
 }
@@ -114,6 +162,10 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
+	if req.IncludeStateChanges {
+		return s.simulateStateChanges(txBytes)
+	}
+
 	gasInfo, result, err := s.simulate(txBytes)
 	if err != nil {
 		return nil, err
@@ -125,6 +177,31 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// simulateStateChanges simulates a transaction and returns its changes to the
+// KV stores, along with the balance changes decoded from the bank store.
+func (s txServer) simulateStateChanges(txBytes []byte) (*txtypes.SimulateResponse, error) {
+	if s.simulateWithStateChanges == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation with state changes is not supported by this node")
+	}
+
+	gasInfo, result, stateChanges, err := s.simulateWithStateChanges(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceChanges, err := banktypes.BalanceChangesFromStateChanges(stateChanges)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode the balance changes: %v", err)
+	}
+
+	return &txtypes.SimulateResponse{
+		GasInfo:        &gasInfo,
+		Result:         result,
+		StateChanges:   stateChanges,
+		BalanceChanges: balanceChanges,
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -166,6 +243,22 @@ func RegisterTxService(
 	)
 }
 
+// RegisterTxServiceWithStateChanges registers the tx service on the gRPC
+// router, with the support of the simulation of the state changes of the
+// transactions.
+func RegisterTxServiceWithStateChanges(
+	qrt gogogrpc.Server,
+	clientCtx client.Context,
+	simulateFn baseAppSimulateFn,
+	simulateWithStateChangesFn baseAppSimulateWithStateChangesFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) {
+	txtypes.RegisterServiceServer(
+		qrt,
+		NewTxServerWithStateChanges(clientCtx, simulateFn, simulateWithStateChangesFn, interfaceRegistry),
+	)
+}
+
 // RegisterGRPCGatewayRoutes mounts the tx service's GRPC-gateway routes on the
 // given Mux.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
@@ -3,6 +3,7 @@
 package tx_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtest "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -136,6 +138,47 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestSimulateTx_StateChanges() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes, IncludeStateChanges: true})
+	s.Require().NoError(err)
+	s.Require().True(res.GetGasInfo().GetGasUsed() > 0)
+
+	// the changes are sorted, and include the account sequence increment
+	stores := make(map[string]bool)
+	for i, change := range res.StateChanges {
+		stores[change.StoreKey] = true
+		if i > 0 {
+			prev := res.StateChanges[i-1]
+			s.Require().True(prev.StoreKey < change.StoreKey ||
+				(prev.StoreKey == change.StoreKey && bytes.Compare(prev.Key, change.Key) < 0))
+		}
+	}
+	s.Require().True(stores[authtypes.StoreKey])
+	s.Require().True(stores[banktypes.StoreKey])
+
+	// the sender sends the amount to itself, and only pays the fee
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	deltas := make(map[string]sdk.Int)
+	for _, change := range res.BalanceChanges {
+		s.Require().Equal(s.cfg.BondDenom, change.Denom)
+		s.Require().Equal(change.After.Sub(change.Before), change.Delta)
+		deltas[change.Address] = change.Delta
+	}
+	s.Require().Equal(sdk.NewInt(-10), deltas[val.Address.String()])
+	s.Require().Equal(sdk.NewInt(10), deltas[feeCollector.String()])
+
+	// the state isn't changed by the simulation
+	res, err = s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Empty(res.StateChanges)
+	s.Require().Empty(res.BalanceChanges)
+}
+
 func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -331,6 +374,136 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 	}
 }
 
+func (s IntegrationTestSuite) TestGetTxEvents_Query_GRPC() {
+	val := s.network.Validators[0]
+
+	// Create a second MsgSend tx, to be searched with the one of the setup,
+	// once the txs of the previous tests are committed.
+	s.Require().NoError(s.network.WaitForNextBlock())
+	out, err := bankcli.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		val.Address,
+		sdk.NewCoins(
+			sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)),
+		),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+		fmt.Sprintf("--%s=barfoo", flags.FlagNote),
+	)
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code)
+
+	// The txs are indexed asynchronously once committed.
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	orQuery := fmt.Sprintf("tx.hash='%s' OR tx.hash='%s'", s.txRes.TxHash, txRes.TxHash)
+
+	testCases := []struct {
+		name      string
+		req       *tx.GetTxsEventRequest
+		expErr    bool
+		expErrMsg string
+		expMemos  []string
+		expNext   bool
+	}{
+		{
+			"both events and query",
+			&tx.GetTxsEventRequest{Events: []string{bankMsgSendEventAction}, Query: bankMsgSendEventAction},
+			true, "either events or query must be set", nil, false,
+		},
+		{
+			"invalid query",
+			&tx.GetTxsEventRequest{Query: "tx.height >>= 1"},
+			true, "invalid query", nil, false,
+		},
+		{
+			"invalid pagination key",
+			&tx.GetTxsEventRequest{Query: orQuery, Pagination: &query.PageRequest{Key: []byte("foo")}},
+			true, "invalid pagination key length", nil, false,
+		},
+		{
+			"too many or queries",
+			&tx.GetTxsEventRequest{Query: strings.Repeat(bankMsgSendEventAction+" OR ", 10) + bankMsgSendEventAction},
+			true, "must not join more than 10 queries", nil, false,
+		},
+		{
+			"or query with large offset",
+			&tx.GetTxsEventRequest{Query: orQuery, Pagination: &query.PageRequest{Offset: 1000, Limit: 1}},
+			true, "must not exceed 1000", nil, false,
+		},
+		{
+			"height range",
+			&tx.GetTxsEventRequest{
+				Query: fmt.Sprintf("%s AND tx.height >= %d AND tx.height <= %d", bankMsgSendEventAction, s.txRes.Height, s.txRes.Height),
+			},
+			false, "", []string{"foobar"}, false,
+		},
+		{
+			"or query",
+			&tx.GetTxsEventRequest{Query: orQuery},
+			false, "", []string{"foobar", "barfoo"}, false,
+		},
+		{
+			"or query in descending order",
+			&tx.GetTxsEventRequest{Query: orQuery, OrderBy: tx.OrderBy_ORDER_BY_DESC},
+			false, "", []string{"barfoo", "foobar"}, false,
+		},
+		{
+			"or query with offset",
+			&tx.GetTxsEventRequest{Query: orQuery, Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			false, "", []string{"barfoo"}, false,
+		},
+		{
+			"or query with limit",
+			&tx.GetTxsEventRequest{Query: orQuery, Pagination: &query.PageRequest{Limit: 1}},
+			false, "", []string{"foobar"}, true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			grpcRes, err := s.queryClient.GetTxsEvent(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Len(grpcRes.Txs, len(tc.expMemos))
+			for i, memo := range tc.expMemos {
+				s.Require().Equal(memo, grpcRes.Txs[i].Body.Memo)
+			}
+			s.Require().Equal(tc.expNext, grpcRes.Pagination.NextKey != nil)
+		})
+	}
+
+	// Page through the transactions with the returned keys.
+	var (
+		memos []string
+		key   []byte
+	)
+	for {
+		grpcRes, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Query:      orQuery,
+			Pagination: &query.PageRequest{Key: key, Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(grpcRes.Txs, 1)
+		memos = append(memos, grpcRes.Txs[0].Body.Memo)
+
+		key = grpcRes.Pagination.NextKey
+		if key == nil {
+			break
+		}
+	}
+	s.Require().Equal([]string{"foobar", "barfoo"}, memos)
+}
+
 func (s IntegrationTestSuite) TestGetTx_GRPC() {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,190 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// maxBytesLen is the maximum length of the byte slices which are displayed in
+// full, longer ones are displayed as their hash.
+const maxBytesLen = 35
+
+// maxDecBitLen is the maximum bit length of the internal representation of a
+// Dec, larger values overflow.
+const maxDecBitLen = 256 + sdk.DecimalPrecisionBits
+
+// FormatInteger formats the decimal string of an integer with thousands
+// separators, e.g. 1'000'000.
+func FormatInteger(v string) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	var sb strings.Builder
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteRune('\'')
+		}
+		sb.WriteRune(c)
+	}
+
+	return sign + sb.String()
+}
+
+// FormatDecimal formats the decimal string of a number with thousands
+// separators in its integer part and without trailing zeros in its fractional
+// part, e.g. 1'000.5.
+func FormatDecimal(v string) string {
+	parts := strings.SplitN(v, ".", 2)
+	res := FormatInteger(parts[0])
+	if len(parts) == 2 {
+		if frac := strings.TrimRight(parts[1], "0"); frac != "" {
+			res += "." + frac
+		}
+	}
+
+	if res == "-0" {
+		return "0"
+	}
+	return res
+}
+
+// formatBytes formats bytes as upper-case hexadecimal, or as the hash of the
+// bytes if they are longer than maxBytesLen.
+func formatBytes(bz []byte) string {
+	if len(bz) > maxBytesLen {
+		return fmt.Sprintf("SHA-256=%X", tmhash.Sum(bz))
+	}
+	return strings.ToUpper(hex.EncodeToString(bz))
+}
+
+// formatTime formats a time in RFC 3339 format in UTC.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// formatBool formats a boolean.
+func formatBool(b bool) string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
+
+// FormatDecCoins formats coins in the display unit of their denom metadata,
+// sorted by display denom, e.g. "1.5 atom, 10 btc". The coins of the denoms
+// without metadata are formatted in their base unit.
+func (h *SignModeHandler) FormatDecCoins(ctx context.Context, coins sdk.DecCoins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	type displayCoin struct {
+		denom, text string
+	}
+
+	displayCoins := make([]displayCoin, len(coins))
+	for i, coin := range coins {
+		amount, denom, err := h.toDisplayUnit(ctx, coin)
+		if err != nil {
+			return "", err
+		}
+		displayCoins[i] = displayCoin{denom: denom, text: fmt.Sprintf("%s %s", FormatDecimal(amount.String()), denom)}
+	}
+
+	sort.SliceStable(displayCoins, func(i, j int) bool {
+		return displayCoins[i].denom < displayCoins[j].denom
+	})
+
+	texts := make([]string, len(displayCoins))
+	for i, coin := range displayCoins {
+		texts[i] = coin.text
+	}
+
+	return strings.Join(texts, ", "), nil
+}
+
+// FormatCoins formats coins in the display unit of their denom metadata, see
+// FormatDecCoins.
+func (h *SignModeHandler) FormatCoins(ctx context.Context, coins sdk.Coins) (string, error) {
+	decCoins := make(sdk.DecCoins, len(coins))
+	for i, coin := range coins {
+		decCoins[i] = sdk.DecCoin{Denom: coin.Denom, Amount: coin.Amount.ToDec()}
+	}
+
+	return h.FormatDecCoins(ctx, decCoins)
+}
+
+// toDisplayUnit converts a coin amount to the display unit of its denom.
+func (h *SignModeHandler) toDisplayUnit(ctx context.Context, coin sdk.DecCoin) (sdk.Dec, string, error) {
+	if h.coinMetadataQueryFn == nil {
+		return coin.Amount, coin.Denom, nil
+	}
+
+	metadata, err := h.coinMetadataQueryFn(ctx, coin.Denom)
+	if err != nil {
+		return sdk.Dec{}, "", err
+	}
+	if metadata == nil || metadata.Display == "" || metadata.Display == coin.Denom {
+		return coin.Amount, coin.Denom, nil
+	}
+
+	coinExp, found := denomExponent(metadata, coin.Denom)
+	if !found {
+		return coin.Amount, coin.Denom, nil
+	}
+	displayExp, found := denomExponent(metadata, metadata.Display)
+	if !found {
+		return coin.Amount, coin.Denom, nil
+	}
+
+	// Denom metadata is not bounded and may be set by untrusted accounts, so
+	// exponent differences a Dec cannot represent are shown in the coin's
+	// own denom rather than computed.
+	amount := coin.Amount
+	switch {
+	case displayExp > coinExp:
+		if displayExp-coinExp > sdk.Precision {
+			return coin.Amount, coin.Denom, nil
+		}
+		amount = amount.Quo(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(displayExp-coinExp))))
+	case displayExp < coinExp:
+		if coinExp-displayExp > sdk.Precision {
+			return coin.Amount, coin.Denom, nil
+		}
+		multiplier := sdk.NewIntWithDecimal(1, int(coinExp-displayExp))
+		if amount.BigInt().BitLen()+multiplier.BigInt().BitLen() > maxDecBitLen {
+			return coin.Amount, coin.Denom, nil
+		}
+		amount = amount.Mul(sdk.NewDecFromInt(multiplier))
+	}
+
+	return amount, metadata.Display, nil
+}
+
+// denomExponent returns the exponent of a denom unit of the metadata, looked
+// up by denom or alias.
+func denomExponent(metadata *banktypes.Metadata, denom string) (uint32, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom {
+			return unit.Exponent, true
+		}
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit.Exponent, true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,149 @@
+package textual_test
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFormatInteger(t *testing.T) {
+	testCases := []struct {
+		in  string
+		exp string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"12", "12"},
+		{"123", "123"},
+		{"1234", "1'234"},
+		{"123456", "123'456"},
+		{"1234567", "1'234'567"},
+		{"-1234567", "-1'234'567"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, textual.FormatInteger(tc.in), tc.in)
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	testCases := []struct {
+		in  string
+		exp string
+	}{
+		{"0.000000000000000000", "0"},
+		{"1.000000000000000000", "1"},
+		{"1234.500000000000000000", "1'234.5"},
+		{"0.000001000000000000", "0.000001"},
+		{"-1000000.250000000000000000", "-1'000'000.25"},
+		{"-0.000000000000000000", "0"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, textual.FormatDecimal(tc.in), tc.in)
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	h := textual.NewSignModeHandler(testCoinMetadataQueryFn)
+	ctx := context.Background()
+
+	testCases := []struct {
+		name  string
+		coins sdk.Coins
+		exp   string
+	}{
+		{"empty", sdk.Coins{}, "zero"},
+		{"display unit", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), "1.5 atom"},
+		{"fraction of display unit", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), "0.000001 atom"},
+		{"no metadata", sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), "1'000 stake"},
+		{
+			"sorted by display denom",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000000), sdk.NewInt64Coin("abc", 5), sdk.NewInt64Coin("stake", 1)),
+			"5 abc, 2 atom, 1 stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := h.FormatCoins(ctx, tc.coins)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestFormatCoinsExponents(t *testing.T) {
+	metadata := func(coinExp, displayExp uint32) *banktypes.Metadata {
+		return &banktypes.Metadata{
+			Base:    "base",
+			Display: "display",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "base", Exponent: coinExp, Aliases: []string{"alias"}},
+				{Denom: "display", Exponent: displayExp},
+			},
+		}
+	}
+	huge, ok := sdk.NewIntFromString("1" + strings.Repeat("0", 70))
+	require.True(t, ok)
+
+	testCases := []struct {
+		name     string
+		metadata *banktypes.Metadata
+		coin     sdk.Coin
+		exp      string
+	}{
+		{"equal exponents", metadata(6, 6), sdk.NewInt64Coin("alias", 1500000), "1'500'000 display"},
+		{"difference of precision", metadata(0, 18), sdk.NewInt64Coin("base", 1), "0.000000000000000001 display"},
+		{"display exponent above precision", metadata(0, 19), sdk.NewInt64Coin("base", 1000), "1'000 base"},
+		{"maximum display exponent", metadata(0, math.MaxUint32), sdk.NewInt64Coin("base", 7), "7 base"},
+		{"coin exponent above precision", metadata(19, 0), sdk.NewInt64Coin("base", 7), "7 base"},
+		{"maximum coin exponent", metadata(math.MaxUint32, 0), sdk.NewInt64Coin("base", 7), "7 base"},
+		{"coin exponent overflowing amount", metadata(18, 0), sdk.NewCoin("base", huge), "10" + strings.Repeat("'000", 23) + " base"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := textual.NewSignModeHandler(func(context.Context, string) (*banktypes.Metadata, error) {
+				return tc.metadata, nil
+			})
+
+			res, err := h.FormatCoins(context.Background(), sdk.NewCoins(tc.coin))
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestFormatCoinsWithoutMetadataQuery(t *testing.T) {
+	h := textual.NewSignModeHandler(nil)
+
+	res, err := h.FormatCoins(context.Background(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	require.NoError(t, err)
+	require.Equal(t, "1'500'000 uatom", res)
+}
+
+// testCoinMetadataQueryFn returns the metadata of the uatom denom, whose
+// display unit is atom.
+func testCoinMetadataQueryFn(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom != "uatom" {
+		return nil, nil
+	}
+
+	return &banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3},
+			{Denom: "atom", Exponent: 6},
+		},
+	}, nil
+}
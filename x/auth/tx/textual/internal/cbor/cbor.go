@@ -0,0 +1,210 @@
+// Package cbor implements the subset of the CBOR data format (RFC 8949) used
+// by SIGN_MODE_TEXTUAL. Values are always encoded in the deterministic form of
+// section 4.2.1 of the RFC: integers and lengths use their shortest encoding,
+// lengths are always definite and map entries are sorted by the bytewise
+// lexicographic order of their encoded keys.
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// major types
+const (
+	majorUint   byte = 0
+	majorText   byte = 3
+	majorArray  byte = 4
+	majorMap    byte = 5
+	majorSimple byte = 7
+)
+
+// simple values
+const (
+	simpleFalse byte = 20
+	simpleTrue  byte = 21
+)
+
+// Cbor is a value which can be encoded in CBOR.
+type Cbor interface {
+	// Encode writes the deterministic CBOR encoding of the value.
+	Encode(w io.Writer) error
+}
+
+// encodeFirstByte writes the initial byte of a data item of the given major
+// type, followed by the shortest encoding of the argument.
+func encodeFirstByte(w io.Writer, major byte, arg uint64) error {
+	var bz []byte
+	switch {
+	case arg < 24:
+		bz = []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		bz = []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		bz = make([]byte, 3)
+		bz[0] = major<<5 | 25
+		binary.BigEndian.PutUint16(bz[1:], uint16(arg))
+	case arg <= 0xffffffff:
+		bz = make([]byte, 5)
+		bz[0] = major<<5 | 26
+		binary.BigEndian.PutUint32(bz[1:], uint32(arg))
+	default:
+		bz = make([]byte, 9)
+		bz[0] = major<<5 | 27
+		binary.BigEndian.PutUint64(bz[1:], arg)
+	}
+
+	_, err := w.Write(bz)
+	return err
+}
+
+// Uint is an unsigned integer.
+type Uint uint64
+
+// NewUint returns a CBOR unsigned integer.
+func NewUint(n uint64) Uint {
+	return Uint(n)
+}
+
+// Encode implements Cbor.
+func (n Uint) Encode(w io.Writer) error {
+	return encodeFirstByte(w, majorUint, uint64(n))
+}
+
+// Text is an UTF-8 text string.
+type Text string
+
+// NewText returns a CBOR text string.
+func NewText(s string) Text {
+	return Text(s)
+}
+
+// Encode implements Cbor.
+func (s Text) Encode(w io.Writer) error {
+	if err := encodeFirstByte(w, majorText, uint64(len(s))); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, string(s))
+	return err
+}
+
+// Bool is a boolean simple value.
+type Bool bool
+
+// NewBool returns a CBOR boolean.
+func NewBool(b bool) Bool {
+	return Bool(b)
+}
+
+// Encode implements Cbor.
+func (b Bool) Encode(w io.Writer) error {
+	if b {
+		return encodeFirstByte(w, majorSimple, uint64(simpleTrue))
+	}
+	return encodeFirstByte(w, majorSimple, uint64(simpleFalse))
+}
+
+// Array is an array of data items.
+type Array struct {
+	elts []Cbor
+}
+
+// NewArray returns a CBOR array of the given data items.
+func NewArray(elts ...Cbor) Array {
+	return Array{elts: elts}
+}
+
+// Append returns the array with a data item appended.
+func (a Array) Append(c Cbor) Array {
+	a.elts = append(a.elts, c)
+	return a
+}
+
+// Encode implements Cbor.
+func (a Array) Encode(w io.Writer) error {
+	if err := encodeFirstByte(w, majorArray, uint64(len(a.elts))); err != nil {
+		return err
+	}
+
+	for _, elt := range a.elts {
+		if err := elt.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Entry is a key-value pair of a map.
+type Entry struct {
+	key Cbor
+	val Cbor
+}
+
+// NewEntry returns a map entry.
+func NewEntry(key, val Cbor) Entry {
+	return Entry{key: key, val: val}
+}
+
+// Map is a map of data items.
+type Map struct {
+	entries []Entry
+}
+
+// NewMap returns a CBOR map of the given entries.
+func NewMap(entries ...Entry) Map {
+	return Map{entries: entries}
+}
+
+// Add returns the map with an entry added.
+func (m Map) Add(key, val Cbor) Map {
+	m.entries = append(m.entries, NewEntry(key, val))
+	return m
+}
+
+// Encode implements Cbor. It returns an error if the map holds duplicate
+// keys.
+func (m Map) Encode(w io.Writer) error {
+	type encodedEntry struct {
+		key []byte
+		val Cbor
+	}
+
+	entries := make([]encodedEntry, len(m.entries))
+	for i, entry := range m.entries {
+		var buf bytes.Buffer
+		if err := entry.key.Encode(&buf); err != nil {
+			return err
+		}
+		entries[i] = encodedEntry{key: buf.Bytes(), val: entry.val}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	for i := 1; i < len(entries); i++ {
+		if bytes.Equal(entries[i-1].key, entries[i].key) {
+			return fmt.Errorf("duplicate map key %X", entries[i].key)
+		}
+	}
+
+	if err := encodeFirstByte(w, majorMap, uint64(len(entries))); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if _, err := w.Write(entry.key); err != nil {
+			return err
+		}
+		if err := entry.val.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package cbor_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/internal/cbor"
+)
+
+func TestEncode(t *testing.T) {
+	// expected encodings are taken from appendix A of RFC 8949
+	testCases := []struct {
+		name string
+		cb   cbor.Cbor
+		exp  string
+	}{
+		{"uint 0", cbor.NewUint(0), "00"},
+		{"uint 23", cbor.NewUint(23), "17"},
+		{"uint 24", cbor.NewUint(24), "1818"},
+		{"uint 1000", cbor.NewUint(1000), "1903e8"},
+		{"uint 1000000", cbor.NewUint(1000000), "1a000f4240"},
+		{"uint 1000000000000", cbor.NewUint(1000000000000), "1b000000e8d4a51000"},
+		{"max uint", cbor.NewUint(18446744073709551615), "1bffffffffffffffff"},
+		{"false", cbor.NewBool(false), "f4"},
+		{"true", cbor.NewBool(true), "f5"},
+		{"empty text", cbor.NewText(""), "60"},
+		{"text", cbor.NewText("IETF"), "6449455446"},
+		{"unicode text", cbor.NewText("ü"), "62c3bc"},
+		{"empty array", cbor.NewArray(), "80"},
+		{
+			"nested array",
+			cbor.NewArray(cbor.NewUint(1), cbor.NewArray(cbor.NewUint(2), cbor.NewUint(3))),
+			"8201820203",
+		},
+		{"empty map", cbor.NewMap(), "a0"},
+		{
+			"map",
+			cbor.NewMap(
+				cbor.NewEntry(cbor.NewUint(1), cbor.NewUint(2)),
+				cbor.NewEntry(cbor.NewUint(3), cbor.NewUint(4)),
+			),
+			"a201020304",
+		},
+		{
+			"map with sorted keys",
+			cbor.NewMap().
+				Add(cbor.NewText("b"), cbor.NewArray(cbor.NewUint(2), cbor.NewUint(3))).
+				Add(cbor.NewText("a"), cbor.NewUint(1)),
+			"a26161016162820203",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, tc.cb.Encode(&buf))
+			require.Equal(t, tc.exp, hex.EncodeToString(buf.Bytes()))
+		})
+	}
+}
+
+func TestEncodeDuplicateMapKeys(t *testing.T) {
+	m := cbor.NewMap().
+		Add(cbor.NewUint(1), cbor.NewUint(2)).
+		Add(cbor.NewUint(1), cbor.NewUint(3))
+
+	var buf bytes.Buffer
+	require.Error(t, m.Encode(&buf))
+}
@@ -0,0 +1,362 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	intType      = reflect.TypeOf(sdk.Int{})
+	decType      = reflect.TypeOf(sdk.Dec{})
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	anyType      = reflect.TypeOf(&codectypes.Any{})
+	coinType     = reflect.TypeOf(sdk.Coin{})
+	decCoinType  = reflect.TypeOf(sdk.DecCoin{})
+	bytesType    = reflect.TypeOf([]byte{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// messageField is a field of a message which is set.
+type messageField struct {
+	prop  *proto.Properties
+	value reflect.Value
+}
+
+// formatAny renders the message packed in an Any, whose first screen is the
+// type URL of the message followed by the indented rendering of the message.
+func (h *SignModeHandler) formatAny(ctx context.Context, any *codectypes.Any) ([]Screen, error) {
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't render Any of type %s: its value is not unpacked", any.TypeUrl)
+	}
+
+	var (
+		screens []Screen
+		err     error
+	)
+	if r, ok := h.renderers[proto.MessageName(msg)]; ok {
+		screens, err = r.Format(ctx, msg)
+	} else {
+		screens, err = h.formatFields(ctx, msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]Screen{{Text: any.TypeUrl}}, indent(screens, 1)...), nil
+}
+
+// formatMessage renders a message with its registered renderer, or as a
+// "<message name> object" screen followed by its indented fields.
+func (h *SignModeHandler) formatMessage(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	name := proto.MessageName(msg)
+	if r, ok := h.renderers[name]; ok {
+		return r.Format(ctx, msg)
+	}
+
+	fields, err := h.formatFields(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]Screen{{Text: fmt.Sprintf("%s object", name)}}, indent(fields, 1)...), nil
+}
+
+// formatFields renders the fields of a message which are set, in field number
+// order.
+func (h *SignModeHandler) formatFields(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can't render message of type %T", msg)
+	}
+	v = v.Elem()
+
+	props := proto.GetProperties(v.Type())
+	var fields []messageField
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		if strings.HasPrefix(structField.Name, "XXX_") {
+			continue
+		}
+
+		field := messageField{prop: props.Prop[i], value: v.Field(i)}
+		if _, ok := structField.Tag.Lookup("protobuf_oneof"); ok {
+			if field.value.IsNil() {
+				continue
+			}
+			oneof := oneofProperties(props, field.value.Elem().Type())
+			if oneof == nil {
+				return nil, fmt.Errorf("unknown oneof type %s of message %s", field.value.Elem().Type(), proto.MessageName(msg))
+			}
+			// a oneof field is rendered even if it holds a zero value
+			field = messageField{prop: oneof.Prop, value: field.value.Elem().Elem().Field(0)}
+		} else if field.value.IsZero() {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].prop.Tag < fields[j].prop.Tag
+	})
+
+	var screens []Screen
+	for _, field := range fields {
+		fieldScreens, err := h.formatField(ctx, fieldLabel(field.prop.OrigName), field.value)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// oneofProperties returns the properties of the oneof field whose wrapper has
+// the given type.
+func oneofProperties(props *proto.StructProperties, wrapperType reflect.Type) *proto.OneofProperties {
+	for _, oneof := range props.OneofTypes {
+		if oneof.Type == wrapperType {
+			return oneof
+		}
+	}
+	return nil
+}
+
+// fieldLabel returns the label of a field displayed to the user, e.g.
+// "From address" for from_address.
+func fieldLabel(name string) string {
+	label := strings.ReplaceAll(name, "_", " ")
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// formatField renders a field prefixed with its label. Coins are rendered on
+// a single screen, other repeated and map fields as a screen holding their
+// number of elements, followed by one or more screens per element and a
+// closing screen.
+func (h *SignModeHandler) formatField(ctx context.Context, label string, v reflect.Value) ([]Screen, error) {
+	switch {
+	case v.Kind() == reflect.Slice && v.Type().Elem() == coinType:
+		coins := make(sdk.Coins, v.Len())
+		for i := range coins {
+			coins[i] = v.Index(i).Interface().(sdk.Coin)
+		}
+		text, err := h.FormatCoins(ctx, coins)
+		if err != nil {
+			return nil, err
+		}
+		return []Screen{{Text: fmt.Sprintf("%s: %s", label, text)}}, nil
+
+	case v.Kind() == reflect.Slice && v.Type().Elem() == decCoinType:
+		coins := make(sdk.DecCoins, v.Len())
+		for i := range coins {
+			coins[i] = v.Index(i).Interface().(sdk.DecCoin)
+		}
+		text, err := h.FormatDecCoins(ctx, coins)
+		if err != nil {
+			return nil, err
+		}
+		return []Screen{{Text: fmt.Sprintf("%s: %s", label, text)}}, nil
+
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8:
+		n := v.Len()
+		screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", label, n, pluralize("element", n))}}
+		for i := 0; i < n; i++ {
+			elt, err := h.formatValue(ctx, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			elt[0].Text = fmt.Sprintf("%s (%d/%d): %s", label, i+1, n, elt[0].Text)
+			screens = append(screens, elt...)
+		}
+		return append(screens, Screen{Text: fmt.Sprintf("End of %s", label)}), nil
+
+	case v.Kind() == reflect.Map:
+		keys := make([]string, 0, v.Len())
+		values := make(map[string]reflect.Value, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := h.formatValue(ctx, iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key[0].Text)
+			values[key[0].Text] = iter.Value()
+		}
+		sort.Strings(keys)
+
+		n := len(keys)
+		screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", label, n, pluralize("entry", n))}}
+		for _, key := range keys {
+			val, err := h.formatValue(ctx, values[key])
+			if err != nil {
+				return nil, err
+			}
+			val[0].Text = fmt.Sprintf("%s (%s): %s", label, key, val[0].Text)
+			screens = append(screens, val...)
+		}
+		return append(screens, Screen{Text: fmt.Sprintf("End of %s", label)}), nil
+
+	default:
+		screens, err := h.formatValue(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		screens[0].Text = fmt.Sprintf("%s: %s", label, screens[0].Text)
+		return screens, nil
+	}
+}
+
+// formatValue renders a single value. The first screen of the result holds
+// the inline rendering of the value.
+func (h *SignModeHandler) formatValue(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	text := func(s string) ([]Screen, error) {
+		return []Screen{{Text: s}}, nil
+	}
+
+	switch v.Type() {
+	case intType:
+		return text(FormatInteger(v.Interface().(sdk.Int).String()))
+	case decType:
+		return text(FormatDecimal(v.Interface().(sdk.Dec).String()))
+	case timeType:
+		return text(formatTime(v.Interface().(time.Time)))
+	case durationType:
+		return text(v.Interface().(time.Duration).String())
+	case anyType:
+		if v.IsNil() {
+			return text("nil")
+		}
+		return h.formatAny(ctx, v.Interface().(*codectypes.Any))
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return text("nil")
+		}
+		if msg, ok := v.Interface().(proto.Message); ok {
+			return h.formatMessage(ctx, msg)
+		}
+		return h.formatValue(ctx, v.Elem())
+
+	case reflect.Struct:
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		if _, ok := ptr.Interface().(proto.Message); !ok {
+			return nil, fmt.Errorf("can't render value of type %s", v.Type())
+		}
+		return h.formatValue(ctx, ptr)
+
+	case reflect.String:
+		return text(v.String())
+
+	case reflect.Bool:
+		return text(formatBool(v.Bool()))
+
+	case reflect.Int32:
+		// enums are rendered with their name
+		if v.Type().Implements(stringerType) {
+			return text(v.Interface().(fmt.Stringer).String())
+		}
+		return text(FormatInteger(strconv.FormatInt(v.Int(), 10)))
+
+	case reflect.Int, reflect.Int64:
+		return text(FormatInteger(strconv.FormatInt(v.Int(), 10)))
+
+	case reflect.Uint32, reflect.Uint64:
+		return text(FormatInteger(strconv.FormatUint(v.Uint(), 10)))
+
+	case reflect.Float32, reflect.Float64:
+		return text(strconv.FormatFloat(v.Float(), 'f', -1, 64))
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		// custom byte types, e.g. addresses, are rendered with their String
+		// method
+		if v.Type() != bytesType && v.Type().Implements(stringerType) {
+			return text(v.Interface().(fmt.Stringer).String())
+		}
+		return text(formatBytes(v.Bytes()))
+	}
+
+	return nil, fmt.Errorf("can't render value of type %s", v.Type())
+}
+
+// formatCoin renders a cosmos.base.v1beta1.Coin.
+func (h *SignModeHandler) formatCoin(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	coin, ok := msg.(*sdk.Coin)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &sdk.Coin{}, msg)
+	}
+
+	text, err := h.FormatCoins(ctx, sdk.Coins{*coin})
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: text}}, nil
+}
+
+// formatDecCoin renders a cosmos.base.v1beta1.DecCoin.
+func (h *SignModeHandler) formatDecCoin(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	coin, ok := msg.(*sdk.DecCoin)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &sdk.DecCoin{}, msg)
+	}
+
+	text, err := h.FormatDecCoins(ctx, sdk.DecCoins{*coin})
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: text}}, nil
+}
+
+// formatTimestamp renders a google.protobuf.Timestamp.
+func formatTimestamp(_ context.Context, msg proto.Message) ([]Screen, error) {
+	ts, ok := msg.(*gogotypes.Timestamp)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &gogotypes.Timestamp{}, msg)
+	}
+
+	t, err := gogotypes.TimestampFromProto(ts)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatTime(t)}}, nil
+}
+
+// formatDuration renders a google.protobuf.Duration.
+func formatDuration(_ context.Context, msg proto.Message) ([]Screen, error) {
+	d, ok := msg.(*gogotypes.Duration)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &gogotypes.Duration{}, msg)
+	}
+
+	duration, err := gogotypes.DurationFromProto(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: duration.String()}}, nil
+}
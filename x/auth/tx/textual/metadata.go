@@ -0,0 +1,42 @@
+package textual
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewQueryServerCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the
+// bank query service on chain, e.g. the bank keeper. The context passed to the
+// function must wrap an sdk.Context.
+func NewQueryServerCoinMetadataQueryFn(queryServer banktypes.QueryServer) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := queryServer.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		return metadataFromResponse(res, err)
+	}
+}
+
+// NewQueryClientCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the
+// bank query service of a node, used to sign transactions off chain.
+func NewQueryClientCoinMetadataQueryFn(queryClient banktypes.QueryClient) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := queryClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		return metadataFromResponse(res, err)
+	}
+}
+
+// metadataFromResponse returns the metadata of a DenomMetadata response, or
+// nil if the denom has no metadata.
+func metadataFromResponse(res *banktypes.QueryDenomMetadataResponse, err error) (*banktypes.Metadata, error) {
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Metadata, nil
+}
@@ -0,0 +1,78 @@
+package textual
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/internal/cbor"
+)
+
+// CBOR keys of the screen fields.
+const (
+	textKey   = 1
+	indentKey = 2
+	expertKey = 3
+)
+
+// Screen is a single line of the human-readable rendering of a transaction,
+// as displayed by a signing device.
+type Screen struct {
+	// Text is the text to display.
+	Text string
+
+	// Indent is the indentation level of the screen, used to show the nesting
+	// of the rendered values.
+	Indent int
+
+	// Expert is set on the screens which only need to be displayed in the
+	// expert mode of the signing device.
+	Expert bool
+}
+
+// Cbor returns the CBOR map of the screen, which omits the fields with a
+// default value.
+func (s Screen) Cbor() cbor.Cbor {
+	m := cbor.NewMap()
+	if s.Text != "" {
+		m = m.Add(cbor.NewUint(textKey), cbor.NewText(s.Text))
+	}
+	if s.Indent > 0 {
+		m = m.Add(cbor.NewUint(indentKey), cbor.NewUint(uint64(s.Indent)))
+	}
+	if s.Expert {
+		m = m.Add(cbor.NewUint(expertKey), cbor.NewBool(s.Expert))
+	}
+
+	return m
+}
+
+// EncodeScreens returns the CBOR encoding of the screens, which is an array of
+// the screen maps.
+func EncodeScreens(screens []Screen) ([]byte, error) {
+	arr := cbor.NewArray()
+	for _, s := range screens {
+		arr = arr.Append(s.Cbor())
+	}
+
+	var buf bytes.Buffer
+	if err := arr.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// indent returns the screens with their indentation increased by n.
+func indent(screens []Screen, n int) []Screen {
+	for i := range screens {
+		screens[i].Indent += n
+	}
+	return screens
+}
+
+// expert returns the screens marked as expert screens.
+func expert(screens []Screen) []Screen {
+	for i := range screens {
+		screens[i].Expert = true
+	}
+	return screens
+}
@@ -0,0 +1,205 @@
+// Package textual implements SIGN_MODE_TEXTUAL, which renders transactions
+// into a list of human-readable screens which can be displayed by signing
+// devices, e.g. hardware wallets, and signs their CBOR encoding.
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, or nil if the
+// denom has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// ValueRenderer renders a protobuf message into screens. The first screen is
+// the inline rendering of the message, which follows the name of the field
+// holding it, and the indentation of the other screens is relative to it.
+type ValueRenderer interface {
+	Format(ctx context.Context, msg proto.Message) ([]Screen, error)
+}
+
+// ValueRendererFunc is a function implementing ValueRenderer.
+type ValueRendererFunc func(ctx context.Context, msg proto.Message) ([]Screen, error)
+
+// Format implements ValueRenderer.
+func (f ValueRendererFunc) Format(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	return f(ctx, msg)
+}
+
+// TxData is the data of a transaction rendered by SIGN_MODE_TEXTUAL.
+type TxData struct {
+	Body          *tx.TxBody
+	AuthInfo      *tx.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// SignModeHandler renders transactions for SIGN_MODE_TEXTUAL. The messages
+// are rendered field by field, unless a ValueRenderer is registered for their
+// type.
+type SignModeHandler struct {
+	coinMetadataQueryFn CoinMetadataQueryFn
+	renderers           map[string]ValueRenderer
+}
+
+// NewSignModeHandler returns a SignModeHandler which formats coins in the
+// display unit of the metadata returned by coinMetadataQueryFn. If
+// coinMetadataQueryFn is nil, coins are formatted in their base unit.
+func NewSignModeHandler(coinMetadataQueryFn CoinMetadataQueryFn) *SignModeHandler {
+	h := &SignModeHandler{
+		coinMetadataQueryFn: coinMetadataQueryFn,
+		renderers:           make(map[string]ValueRenderer),
+	}
+
+	h.RegisterRenderer("cosmos.base.v1beta1.Coin", ValueRendererFunc(h.formatCoin))
+	h.RegisterRenderer("cosmos.base.v1beta1.DecCoin", ValueRendererFunc(h.formatDecCoin))
+	h.RegisterRenderer("google.protobuf.Timestamp", ValueRendererFunc(formatTimestamp))
+	h.RegisterRenderer("google.protobuf.Duration", ValueRendererFunc(formatDuration))
+
+	return h
+}
+
+// RegisterRenderer registers the renderer of the messages with the given
+// protobuf full name, replacing any previously registered one.
+func (h *SignModeHandler) RegisterRenderer(messageName string, r ValueRenderer) {
+	h.renderers[messageName] = r
+}
+
+// GetSignBytes returns the CBOR encoding of the screens of the transaction.
+func (h *SignModeHandler) GetSignBytes(ctx context.Context, data signing.SignerData, txData TxData) ([]byte, error) {
+	screens, err := h.GetScreens(ctx, data, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeScreens(screens)
+}
+
+// GetScreens renders the transaction into the screens displayed to the signer.
+func (h *SignModeHandler) GetScreens(ctx context.Context, data signing.SignerData, txData TxData) ([]Screen, error) {
+	body, authInfo := txData.Body, txData.AuthInfo
+	if body == nil || authInfo == nil {
+		return nil, fmt.Errorf("transaction body and auth info must be set")
+	}
+
+	screens := []Screen{
+		{Text: fmt.Sprintf("Chain id: %s", data.ChainID)},
+		{Text: fmt.Sprintf("Account number: %d", data.AccountNumber)},
+		{Text: fmt.Sprintf("Sequence: %d", data.Sequence)},
+		{Text: fmt.Sprintf("Address: %s", data.Address)},
+	}
+
+	if data.SignerIndex < len(authInfo.SignerInfos) {
+		if pk := authInfo.SignerInfos[data.SignerIndex].GetPublicKey(); pk != nil {
+			pkScreens, err := h.formatField(ctx, "Public key", reflect.ValueOf(pk))
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, expert(pkScreens)...)
+		}
+	}
+
+	n := len(body.Messages)
+	screens = append(screens, Screen{Text: fmt.Sprintf("This transaction has %d %s", n, pluralize("Message", n))})
+	for i, msg := range body.Messages {
+		msgScreens, err := h.formatAny(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		msgScreens[0].Text = fmt.Sprintf("Message (%d/%d): %s", i+1, n, msgScreens[0].Text)
+		screens = append(screens, msgScreens...)
+	}
+	screens = append(screens, Screen{Text: "End of Message"})
+
+	if body.Memo != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Memo: %s", body.Memo)})
+	}
+
+	if fee := authInfo.Fee; fee != nil {
+		if !fee.Amount.IsZero() {
+			fees, err := h.FormatCoins(ctx, fee.Amount)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fees: %s", fees)})
+		}
+		if fee.Payer != "" {
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fee payer: %s", fee.Payer), Expert: true})
+		}
+		if fee.Granter != "" {
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fee granter: %s", fee.Granter), Expert: true})
+		}
+		screens = append(screens, Screen{Text: fmt.Sprintf("Gas limit: %s", FormatInteger(fmt.Sprint(fee.GasLimit))), Expert: true})
+	}
+
+	if tip := authInfo.Tip; tip != nil {
+		tips, err := h.FormatCoins(ctx, tip.Amount)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens,
+			Screen{Text: fmt.Sprintf("Tip: %s", tips)},
+			Screen{Text: fmt.Sprintf("Tipper: %s", tip.Tipper)},
+		)
+	}
+
+	if body.TimeoutHeight > 0 {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Timeout height: %d", body.TimeoutHeight), Expert: true})
+	}
+
+	if len(body.ExtensionOptions) > 0 {
+		extScreens, err := h.formatField(ctx, "Extension options", reflect.ValueOf(body.ExtensionOptions))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expert(extScreens)...)
+	}
+
+	if len(body.NonCriticalExtensionOptions) > 0 {
+		extScreens, err := h.formatField(ctx, "Non critical extension options", reflect.ValueOf(body.NonCriticalExtensionOptions))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expert(extScreens)...)
+	}
+
+	screens = append(screens, Screen{
+		Text:   fmt.Sprintf("Hash of raw bytes: %X", hashRawBytes(txData.BodyBytes, txData.AuthInfoBytes)),
+		Expert: true,
+	})
+
+	return screens, nil
+}
+
+// hashRawBytes returns the hash of the encoded body and auth info, which makes
+// the signature cover the raw transaction bytes and not only their rendering.
+// The length of the body is prepended to prevent moving bytes between the two.
+func hashRawBytes(bodyBz, authInfoBz []byte) []byte {
+	var lenBz [8]byte
+	binary.BigEndian.PutUint64(lenBz[:], uint64(len(bodyBz)))
+
+	h := sha256.New()
+	h.Write(lenBz[:])
+	h.Write(bodyBz)
+	h.Write(authInfoBz)
+
+	return h.Sum(nil)
+}
+
+// pluralize returns the word followed by an "s" if n is not 1.
+func pluralize(word string, n int) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
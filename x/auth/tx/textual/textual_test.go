@@ -0,0 +1,164 @@
+package textual_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGetScreens(t *testing.T) {
+	_, pubKey, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	msgSend := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	msgMultiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))},
+		[]banktypes.Output{banktypes.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))},
+	)
+
+	txData := newTxData(t, pubKey, msgSend, msgMultiSend)
+	txData.Body.Memo = "a memo"
+	txData.Body.TimeoutHeight = 10000
+
+	signerData := signing.SignerData{
+		Address:       addr1.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 3,
+		Sequence:      7,
+	}
+
+	h := textual.NewSignModeHandler(testCoinMetadataQueryFn)
+	screens, err := h.GetScreens(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	exp := []textual.Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Account number: 3"},
+		{Text: "Sequence: 7"},
+		{Text: fmt.Sprintf("Address: %s", addr1)},
+		{Text: "Public key: /cosmos.crypto.secp256k1.PubKey", Expert: true},
+		{Text: fmt.Sprintf("Key: %X", pubKey.Bytes()), Indent: 1, Expert: true},
+		{Text: "This transaction has 2 Messages"},
+		{Text: "Message (1/2): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: fmt.Sprintf("From address: %s", addr1), Indent: 1},
+		{Text: fmt.Sprintf("To address: %s", addr2), Indent: 1},
+		{Text: "Amount: 1.5 atom", Indent: 1},
+		{Text: "Message (2/2): /cosmos.bank.v1beta1.MsgMultiSend"},
+		{Text: "Inputs: 1 element", Indent: 1},
+		{Text: "Inputs (1/1): cosmos.bank.v1beta1.Input object", Indent: 1},
+		{Text: fmt.Sprintf("Address: %s", addr1), Indent: 2},
+		{Text: "Coins: 10 stake", Indent: 2},
+		{Text: "End of Inputs", Indent: 1},
+		{Text: "Outputs: 1 element", Indent: 1},
+		{Text: "Outputs (1/1): cosmos.bank.v1beta1.Output object", Indent: 1},
+		{Text: fmt.Sprintf("Address: %s", addr2), Indent: 2},
+		{Text: "Coins: 10 stake", Indent: 2},
+		{Text: "End of Outputs", Indent: 1},
+		{Text: "End of Message"},
+		{Text: "Memo: a memo"},
+		{Text: "Fees: 0.002 atom"},
+		{Text: "Gas limit: 100'000", Expert: true},
+		{Text: "Timeout height: 10000", Expert: true},
+	}
+	require.Len(t, screens, len(exp)+1)
+	require.Equal(t, exp, screens[:len(exp)])
+
+	hashScreen := screens[len(exp)]
+	require.True(t, hashScreen.Expert)
+	require.Regexp(t, "^Hash of raw bytes: [0-9A-F]{64}$", hashScreen.Text)
+
+	// the sign bytes are the CBOR encoding of the screens and change with
+	// the raw bytes of the transaction
+	signBytes, err := h.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	encoded, err := textual.EncodeScreens(screens)
+	require.NoError(t, err)
+	require.Equal(t, encoded, signBytes)
+
+	txData.BodyBytes = append(txData.BodyBytes, 0)
+	otherSignBytes, err := h.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+}
+
+func TestRegisterRenderer(t *testing.T) {
+	_, pubKey, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	msgSend := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	txData := newTxData(t, pubKey, msgSend)
+
+	h := textual.NewSignModeHandler(testCoinMetadataQueryFn)
+	h.RegisterRenderer(proto.MessageName(msgSend), textual.ValueRendererFunc(
+		func(ctx context.Context, msg proto.Message) ([]textual.Screen, error) {
+			msgSend := msg.(*banktypes.MsgSend)
+			amount, err := h.FormatCoins(ctx, msgSend.Amount)
+			if err != nil {
+				return nil, err
+			}
+			return []textual.Screen{{Text: fmt.Sprintf("Send %s to %s", amount, msgSend.ToAddress)}}, nil
+		},
+	))
+
+	screens, err := h.GetScreens(context.Background(), signing.SignerData{Address: addr1.String()}, txData)
+	require.NoError(t, err)
+	require.Contains(t, screens, textual.Screen{Text: "Message (1/1): /cosmos.bank.v1beta1.MsgSend"})
+	require.Contains(t, screens, textual.Screen{Text: fmt.Sprintf("Send 1.5 atom to %s", addr2), Indent: 1})
+}
+
+func TestGetScreensNotUnpackedMessage(t *testing.T) {
+	_, pubKey, _ := testdata.KeyTestPubAddr()
+	txData := newTxData(t, pubKey)
+	txData.Body.Messages = []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}}
+
+	h := textual.NewSignModeHandler(testCoinMetadataQueryFn)
+	_, err := h.GetScreens(context.Background(), signing.SignerData{}, txData)
+	require.Error(t, err)
+}
+
+// newTxData returns the data of a transaction signed by pubKey with the given
+// messages.
+func newTxData(t *testing.T, pubKey cryptotypes.PubKey, msgs ...sdk.Msg) textual.TxData {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	body := &tx.TxBody{Messages: anys}
+	authInfo := &tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{PublicKey: pkAny}},
+		Fee: &tx.Fee{
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)),
+			GasLimit: 100000,
+		},
+	}
+
+	bodyBz, err := proto.Marshal(body)
+	require.NoError(t, err)
+	authInfoBz, err := proto.Marshal(authInfo)
+	require.NoError(t, err)
+
+	return textual.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+}
@@ -0,0 +1,72 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+)
+
+func TestTextualHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	require.Panics(t, func() {
+		NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	})
+
+	txConfig := NewTxConfigWithTextual(
+		marshaler,
+		[]signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		textual.NewSignModeHandler(nil),
+	)
+	txBuilder := txConfig.NewTxBuilder()
+
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+	sig := signingtypes.SignatureV2{PubKey: pubkey, Data: sigData, Sequence: 2}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+
+	handler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, handler.DefaultMode())
+
+	signBytes, err := signing.GetSignBytesWithContext(
+		context.Background(), handler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx(),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, signBytes)
+
+	_, err = signModeTextualHandler{t: textual.NewSignModeHandler(nil)}.GetSignBytes(
+		signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx(),
+	)
+	require.Error(t, err)
+
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, signing.VerifySignature(pubkey, signingData, sigData, handler, txBuilder.GetTx()))
+
+	// the signature doesn't verify once the memo is changed
+	txBuilder.SetMemo("othermemo")
+	require.Error(t, signing.VerifySignature(pubkey, signingData, sigData, handler, txBuilder.GetTx()))
+}
@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -17,12 +18,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -114,19 +116,184 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
+	var totalCoins sdk.Coins
+
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	madeNewAcc := false
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		}
+
+		pva, ok := acc.(*types.PeriodicVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "account %s must be a periodic vesting account to merge a grant", msg.ToAddress)
+		}
+		if !from.Equals(pva.GetFunder()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s can only accept grants from account %s", msg.ToAddress, pva.FunderAddress)
+		}
+
+		sk := s.StakingKeeper
+		bondDenom := sk.BondDenom(ctx)
+		bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, to)))
+		unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorUnbonding(ctx, to)))
+
+		pva.AddGrant(ctx.BlockTime(), bonded, unbonding, msg.StartTime, msg.VestingPeriods)
+		ak.SetAccount(ctx, pva)
+	} else {
+		baseAccount := ak.NewAccountWithAddress(ctx, to)
+
+		acc := types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+		acc.FunderAddress = msg.FromAddress
+
+		ak.SetAccount(ctx, acc)
+		madeNewAcc = true
+	}
+
+	defer func() {
+		if madeNewAcc {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreatePermanentLockedAccount(goCtx context.Context, msg *types.MsgCreatePermanentLockedAccount) (*types.MsgCreatePermanentLockedAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
 	if acc := ak.GetAccount(ctx, to); acc != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
-	var totalCoins sdk.Coins
+	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
 
-	for _, period := range msg.VestingPeriods {
-		totalCoins = totalCoins.Add(period.Amount...)
+	acc := types.NewPermanentLockedAccount(baseAccount.(*authtypes.BaseAccount), msg.Amount.Sort())
+
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_permanent_locked_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreatePermanentLockedAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	lockupPeriods := types.Periods(msg.LockupPeriods)
+	vestingPeriods := types.Periods(msg.VestingPeriods)
+
+	// an empty schedule releases all coins at the start time
+	totalCoins := lockupPeriods.TotalAmount()
+	if len(lockupPeriods) == 0 {
+		totalCoins = vestingPeriods.TotalAmount()
+		lockupPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
 	}
 
 	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
 
-	acc := types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	acc := types.NewClawbackVestingAccount(baseAccount.(*authtypes.BaseAccount), from, totalCoins, msg.StartTime, lockupPeriods, vestingPeriods)
 
 	ak.SetAccount(ctx, acc)
 
@@ -136,7 +303,7 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
@@ -155,6 +322,149 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	)
-	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "account %s is not subject to clawback", msg.Address)
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by original funder %s", va.FunderAddress)
+	}
+
+	if err := s.clawback(ctx, va, dest); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback removes all future vesting events from the account and transfers
+// the unvested coins to dest. Unvested coins are taken from the bank balance
+// first, then from unbonding delegations and finally from delegations, which
+// are transferred to dest without being unbonded.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) error {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	// compute the clawback based on the account state only, and update the account
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return nil
+	}
+
+	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+
+	// compute the clawback based on the bank balance and delegations, and
+	// update the delegation bookkeeping of the account
+	encumbered := va.GetVestingCoins(ctx.BlockTime())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := bk.GetAllBalances(ctx, addr)
+	toClawBack = va.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+
+	// write the account now so that the bank module sees the unvested coins
+	// as unlocked
+	ak.SetAccount(ctx, va)
+
+	// now that future vesting events are removed, the remaining balance of the
+	// account can be freely transferred
+	spendable := bk.SpendableCoins(ctx, addr)
+	toXfer := toClawBack.Min(spendable)
+	if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return err
+	}
+
+	// staking is the only way unvested coins can be missing from the bank
+	// balance, so transfer unbonding delegations and then delegations
+	want := toClawBack.Sub(toXfer).AmountOf(bondDenom)
+	if !want.IsPositive() {
+		return nil
+	}
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		want = want.Sub(sk.TransferUnbonding(ctx, addr, dest, valAddr, want))
+		if !want.IsPositive() {
+			return nil
+		}
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// validator has no tokens
+			continue
+		}
+
+		transferredShares, err := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+		if err != nil {
+			return err
+		}
+
+		// to be conservative in what we're clawing back, round transferred shares up
+		want = want.Sub(validator.TokensFromSharesRoundUp(transferredShares).RoundInt())
+		if !want.IsPositive() {
+			return nil
+		}
+	}
+
+	// if we've transferred everything and still haven't transferred the
+	// desired clawback amount, then the account must have lost some unvested
+	// tokens through slashing or the tokens are backing a pending redelegation
+	return nil
 }
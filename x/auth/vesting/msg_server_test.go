@@ -0,0 +1,272 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	addrs     []sdk.AccAddress
+	bondDenom string
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+	s.msgServer = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper)
+	s.addrs = simapp.AddTestAddrs(s.app, s.ctx, 3, sdk.NewInt(1000000))
+	s.bondDenom = s.app.StakingKeeper.BondDenom(s.ctx)
+}
+
+func (s *MsgServerTestSuite) coins(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amt))
+}
+
+func (s *MsgServerTestSuite) createClawbackAccount(funder sdk.AccAddress) sdk.AccAddress {
+	addr := sdk.AccAddress([]byte("clawback_vesting_acc"))
+	periods := []types.Period{
+		{Length: 100, Amount: s.coins(1000)},
+		{Length: 100, Amount: s.coins(1000)},
+	}
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, 1000, nil, periods)
+	s.Require().NoError(msg.ValidateBasic())
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	return addr
+}
+
+func (s *MsgServerTestSuite) TestCreateClawbackVestingAccount() {
+	funder := s.addrs[0]
+	addr := s.createClawbackAccount(funder)
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr)
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(funder.String(), va.FunderAddress)
+	s.Require().Equal(s.coins(2000), va.OriginalVesting)
+	s.Require().Equal(int64(1200), va.EndTime)
+	s.Require().NoError(va.Validate())
+	s.Require().Equal(s.coins(2000), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	// account already exists
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, 1000, nil, []types.Period{{Length: 100, Amount: s.coins(1)}})
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+}
+
+func (s *MsgServerTestSuite) TestClawback() {
+	funder, dest := s.addrs[0], s.addrs[1]
+	addr := s.createClawbackAccount(funder)
+
+	// only the funder can claw back
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(dest, addr, nil))
+	s.Require().Error(err)
+
+	// regular accounts are not subject to clawback
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, s.addrs[2], nil))
+	s.Require().Error(err)
+
+	// the first period has vested
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1150, 0))
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.coins(1000), va.OriginalVesting)
+	s.Require().Equal(s.coins(1000), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().Equal(s.coins(1000), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+	s.Require().Equal(s.coins(1001000), s.app.BankKeeper.GetAllBalances(s.ctx, dest))
+}
+
+func (s *MsgServerTestSuite) TestClawbackDelegated() {
+	funder, dest := s.addrs[0], s.addrs[1]
+	addr := s.createClawbackAccount(funder)
+
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(1500), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.coins(1500), va.DelegatedVesting)
+
+	// the first period has vested, 1000 tokens are unvested of which only 500
+	// remain in the bank balance
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1150, 0))
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+
+	va = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, addr).IsZero())
+	s.Require().Equal(s.coins(1000), va.DelegatedFree.Add(va.DelegatedVesting...))
+	s.Require().Equal(s.coins(1000500), s.app.BankKeeper.GetAllBalances(s.ctx, dest))
+	s.Require().Equal(sdk.NewInt(1000), s.app.StakingKeeper.GetDelegatorBonded(s.ctx, addr))
+	s.Require().Equal(sdk.NewInt(500), s.app.StakingKeeper.GetDelegatorBonded(s.ctx, dest))
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountMerge() {
+	funder, other := s.addrs[0], s.addrs[1]
+	addr := sdk.AccAddress([]byte("periodic_vesting_acc"))
+	periods := []types.Period{
+		{Length: 100, Amount: s.coins(1000)},
+		{Length: 100, Amount: s.coins(1000)},
+	}
+
+	// merging into a non-existent account creates it
+	msg := types.NewMsgCreatePeriodicVestingAccount(funder, addr, 1000, periods, true)
+	s.Require().NoError(msg.ValidateBasic())
+	_, err := s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	va, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(funder.String(), va.FunderAddress)
+
+	// grants can't be added without merge
+	grant := []types.Period{{Length: 150, Amount: s.coins(500)}}
+	msg = types.NewMsgCreatePeriodicVestingAccount(funder, addr, 1100, grant, false)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+
+	// only the funder may add grants
+	msg = types.NewMsgCreatePeriodicVestingAccount(other, addr, 1100, grant, true)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+
+	// grants can only be merged into periodic vesting accounts
+	msg = types.NewMsgCreatePeriodicVestingAccount(funder, other, 1100, grant, true)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+
+	msg = types.NewMsgCreatePeriodicVestingAccount(funder, addr, 1100, grant, true)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	va = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().NoError(va.Validate())
+	s.Require().Equal(int64(1000), va.StartTime)
+	s.Require().Equal(int64(1250), va.EndTime)
+	s.Require().Equal(s.coins(2500), va.OriginalVesting)
+	s.Require().Equal(types.Periods{
+		{Length: 100, Amount: s.coins(1000)},
+		{Length: 100, Amount: s.coins(1000)},
+		{Length: 50, Amount: s.coins(500)},
+	}, va.GetVestingPeriods())
+	s.Require().Equal(s.coins(2500), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1200, 0))
+	s.Require().Equal(s.coins(2000), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountMergeDelegated() {
+	funder := s.addrs[0]
+	addr := sdk.AccAddress([]byte("periodic_vesting_acc"))
+	periods := []types.Period{{Length: 100, Amount: s.coins(1000)}}
+
+	msg := types.NewMsgCreatePeriodicVestingAccount(funder, addr, 1000, periods, false)
+	_, err := s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	// the first grant has vested and is fully delegated
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1100, 0))
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	_, err = s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().Equal(s.coins(1000), va.DelegatedFree)
+	s.Require().True(va.DelegatedVesting.IsZero())
+
+	grant := []types.Period{{Length: 100, Amount: s.coins(600)}}
+	msg = types.NewMsgCreatePeriodicVestingAccount(funder, addr, 1100, grant, true)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	// the delegation now covers the unvested grant, so the spendable balance
+	// and the free delegation together equal the vested coins
+	va = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().Equal(s.coins(600), va.DelegatedVesting)
+	s.Require().Equal(s.coins(400), va.DelegatedFree)
+	s.Require().Equal(s.coins(600), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	spendable := s.app.BankKeeper.SpendableCoins(s.ctx, addr)
+	s.Require().Equal(s.coins(600), spendable)
+	s.Require().Equal(va.GetVestedCoins(s.ctx.BlockTime()), spendable.Add(va.DelegatedFree...))
+
+	// undelegating releases the free delegation first, the rest stays locked
+	// until the grant vests
+	va.TrackUndelegation(s.coins(1000))
+	s.app.AccountKeeper.SetAccount(s.ctx, va)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, funder, addr, s.coins(1000)))
+	s.Require().Equal(s.coins(1000), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1200, 0))
+	s.Require().Equal(s.coins(1600), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+}
+
+func (s *MsgServerTestSuite) TestCreatePermanentLockedAccount() {
+	funder, other := s.addrs[0], s.addrs[1]
+	addr := sdk.AccAddress([]byte("permanent_locked_acc"))
+
+	msg := types.NewMsgCreatePermanentLockedAccount(funder, addr, s.coins(1000))
+	s.Require().NoError(msg.ValidateBasic())
+	_, err := s.msgServer.CreatePermanentLockedAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr)
+	va, ok := acc.(*types.PermanentLockedAccount)
+	s.Require().True(ok)
+	s.Require().Equal(s.coins(1000), va.OriginalVesting)
+	s.Require().Equal(s.coins(1000), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	// account already exists
+	_, err = s.msgServer.CreatePermanentLockedAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+
+	// locked coins can never be transferred
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1000, 0).AddDate(100, 0, 0))
+	s.Require().Error(s.app.BankKeeper.SendCoins(s.ctx, addr, other, s.coins(1)))
+
+	// locked coins can be delegated and undelegated
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	_, err = s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(600), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+
+	va = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PermanentLockedAccount)
+	s.Require().Equal(s.coins(600), va.DelegatedVesting)
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, addr, validator.GetOperator())
+	s.Require().True(found)
+	completionTime, err := s.app.StakingKeeper.Undelegate(s.ctx, addr, validator.GetOperator(), delegation.Shares)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockTime(completionTime)
+	_, err = s.app.StakingKeeper.CompleteUnbonding(s.ctx, addr, validator.GetOperator())
+	s.Require().NoError(err)
+
+	va = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PermanentLockedAccount)
+	s.Require().True(va.DelegatedVesting.IsZero())
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	// coins received afterwards, e.g. staking rewards, remain transferable
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, other, addr, s.coins(50)))
+	s.Require().Equal(s.coins(50), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, addr, other, s.coins(50)))
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
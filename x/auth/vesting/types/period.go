@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
@@ -26,3 +28,130 @@ func (vp Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// TotalLength returns the total length of all the periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of coins for all the periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+	return total
+}
+
+// ReadSchedule returns the amount of coins released by the schedule of periods
+// at readTime. The periods are relative to startTime, and all of totalCoins is
+// considered released once endTime has been reached.
+func ReadSchedule(startTime, endTime int64, periods Periods, totalCoins sdk.Coins, readTime int64) sdk.Coins {
+	if readTime <= startTime {
+		return sdk.NewCoins()
+	}
+	if readTime >= endTime {
+		return totalCoins
+	}
+
+	coins := sdk.NewCoins()
+	time := startTime
+	for _, period := range periods {
+		if readTime < time+period.Length {
+			// we're reading before the next event
+			break
+		}
+		coins = coins.Add(period.Amount...)
+		time += period.Length
+	}
+
+	return coins
+}
+
+// CapPeriods returns a copy of the periods truncated such that their
+// cumulative amount never exceeds capAmount. Periods which no longer release
+// any coins have their length folded into the next releasing period, and
+// trailing empty periods are dropped.
+func CapPeriods(periods Periods, capAmount sdk.Coins) Periods {
+	var (
+		capped    Periods
+		released  = sdk.NewCoins()
+		carryTime int64
+	)
+
+	for _, period := range periods {
+		carryTime += period.Length
+		total := released.Add(period.Amount...).Min(capAmount)
+		amount := total.Sub(released)
+		if amount.IsZero() {
+			continue
+		}
+
+		capped = append(capped, Period{Length: carryTime, Amount: amount})
+		released = total
+		carryTime = 0
+	}
+
+	return capped
+}
+
+// DisjunctPeriods merges two vesting schedules, each given by a start time and
+// periods relative to it, into a single schedule which releases the union of
+// both. The merged periods are relative to the earlier of the two start
+// times, and amounts released at the same time are combined into one period.
+func DisjunctPeriods(startP, startQ int64, periodsP, periodsQ Periods) (startTime, endTime int64, merged Periods) {
+	startTime = startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+
+	var (
+		timeP, timeQ = startP, startQ
+		iP, iQ       = 0, 0
+		lastTime     = startTime
+	)
+
+	emit := func(eventTime int64, amount sdk.Coins) {
+		merged = append(merged, Period{Length: eventTime - lastTime, Amount: amount})
+		lastTime = eventTime
+	}
+
+	for iP < len(periodsP) && iQ < len(periodsQ) {
+		nextP := timeP + periodsP[iP].Length
+		nextQ := timeQ + periodsQ[iQ].Length
+
+		switch {
+		case nextP < nextQ:
+			emit(nextP, periodsP[iP].Amount)
+			timeP = nextP
+			iP++
+
+		case nextQ < nextP:
+			emit(nextQ, periodsQ[iQ].Amount)
+			timeQ = nextQ
+			iQ++
+
+		default:
+			emit(nextP, periodsP[iP].Amount.Add(periodsQ[iQ].Amount...))
+			timeP, timeQ = nextP, nextQ
+			iP++
+			iQ++
+		}
+	}
+
+	for ; iP < len(periodsP); iP++ {
+		timeP += periodsP[iP].Length
+		emit(timeP, periodsP[iP].Amount)
+	}
+
+	for ; iQ < len(periodsQ); iQ++ {
+		timeQ += periodsQ[iQ].Length
+		emit(timeQ, periodsQ[iQ].Amount)
+	}
+
+	return startTime, lastTime, merged
+}
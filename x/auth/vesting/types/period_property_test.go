@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// genCoins generates a (possibly empty) set of coins in the stake and fee
+// denominations.
+var genCoins = rapid.Custom(func(t *rapid.T) sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewInt64Coin(stakeDenom, rapid.Int64Range(0, 1000).Draw(t, "stake").(int64)),
+		sdk.NewInt64Coin(feeDenom, rapid.Int64Range(0, 1000).Draw(t, "fee").(int64)),
+	)
+})
+
+// genPeriods generates a vesting schedule which is valid for
+// MsgCreatePeriodicVestingAccount.
+var genPeriods = rapid.Custom(func(t *rapid.T) types.Periods {
+	n := rapid.IntRange(1, 8).Draw(t, "n").(int)
+	periods := make(types.Periods, n)
+	for i := range periods {
+		periods[i] = types.Period{
+			Length: rapid.Int64Range(1, 100).Draw(t, "length").(int64),
+			Amount: genCoins.Draw(t, "amount").(sdk.Coins),
+		}
+	}
+	return periods
+})
+
+// genGrant generates a periodic vesting account for the given address.
+func genGrant(t *rapid.T, addr sdk.AccAddress, label string) *types.PeriodicVestingAccount {
+	startTime := rapid.Int64Range(1000, 1200).Draw(t, label+"StartTime").(int64)
+	periods := genPeriods.Draw(t, label+"Periods").(types.Periods)
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	return types.NewPeriodicVestingAccount(bacc, periods.TotalAmount(), startTime, periods)
+}
+
+func TestDisjunctPeriodsProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		startP := rapid.Int64Range(0, 200).Draw(t, "startP").(int64)
+		startQ := rapid.Int64Range(0, 200).Draw(t, "startQ").(int64)
+		p := genPeriods.Draw(t, "p").(types.Periods)
+		q := genPeriods.Draw(t, "q").(types.Periods)
+
+		startTime, endTime, merged := types.DisjunctPeriods(startP, startQ, p, q)
+
+		// the merged schedule spans both schedules and releases both amounts
+		endP, endQ := startP+p.TotalLength(), startQ+q.TotalLength()
+		require.Equal(t, startTime, minInt64(startP, startQ))
+		require.Equal(t, endTime, maxInt64(endP, endQ))
+		require.Equal(t, endTime, startTime+merged.TotalLength())
+		require.True(t, merged.TotalAmount().IsEqual(p.TotalAmount().Add(q.TotalAmount()...)))
+
+		// at any time, the merged schedule releases what both schedules release
+		readTime := rapid.Int64Range(0, 1200).Draw(t, "readTime").(int64)
+		got := types.ReadSchedule(startTime, endTime, merged, merged.TotalAmount(), readTime)
+		want := types.ReadSchedule(startP, endP, p, p.TotalAmount(), readTime).
+			Add(types.ReadSchedule(startQ, endQ, q, q.TotalAmount(), readTime)...)
+		require.True(t, got.IsEqual(want), "got %s, want %s at %d", got, want, readTime)
+	})
+}
+
+func TestPeriodicVestingAccountAddGrantProperties(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	rapid.Check(t, func(t *rapid.T) {
+		pva := genGrant(t, addr, "account")
+		grant := genGrant(t, addr, "grant")
+
+		original := *pva
+		originalBva := *pva.BaseVestingAccount
+		original.BaseVestingAccount = &originalBva
+
+		pva.AddGrant(time.Unix(990, 0), nil, nil, grant.StartTime, grant.VestingPeriods)
+		require.NoError(t, pva.Validate())
+		require.True(t, pva.OriginalVesting.IsEqual(original.OriginalVesting.Add(grant.OriginalVesting...)))
+
+		var prevVested sdk.Coins
+		for readTime := int64(990); readTime <= pva.EndTime+10; readTime++ {
+			blockTime := time.Unix(readTime, 0)
+			vested := pva.GetVestedCoins(blockTime)
+
+			// vesting is the sum of both grants
+			want := original.GetVestedCoins(blockTime).Add(grant.GetVestedCoins(blockTime)...)
+			require.True(t, vested.IsEqual(want), "got %s, want %s at %d", vested, want, readTime)
+
+			// vested coins never decrease and never exceed the original vesting
+			require.True(t, prevVested.IsAllLTE(vested))
+			require.True(t, vested.IsAllLTE(pva.OriginalVesting))
+			require.True(t, vested.Add(pva.GetVestingCoins(blockTime)...).IsEqual(pva.OriginalVesting))
+			prevVested = vested
+		}
+	})
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestReadSchedule(t *testing.T) {
+	periods := types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))},
+		{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 5))},
+	}
+	total := periods.TotalAmount()
+	require.Equal(t, int64(30), periods.TotalLength())
+
+	for _, tc := range []struct {
+		time     int64
+		expected sdk.Coins
+	}{
+		{0, sdk.NewCoins()},
+		{100, sdk.NewCoins()},
+		{109, sdk.NewCoins()},
+		{110, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		{129, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		{130, total},
+		{200, total},
+	} {
+		require.Equal(t, tc.expected, types.ReadSchedule(100, 130, periods, total, tc.time), "time %d", tc.time)
+	}
+}
+
+func TestCapPeriods(t *testing.T) {
+	stake := func(x int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, x)) }
+	periods := types.Periods{
+		{Length: 10, Amount: stake(10)},
+		{Length: 10, Amount: stake(10)},
+		{Length: 10, Amount: stake(10)},
+	}
+
+	require.Equal(t, periods, types.CapPeriods(periods, stake(30)))
+	require.Equal(t, types.Periods{{Length: 10, Amount: stake(10)}, {Length: 10, Amount: stake(5)}}, types.CapPeriods(periods, stake(15)))
+	require.Empty(t, types.CapPeriods(periods, sdk.NewCoins()))
+
+	// empty periods are folded into the next releasing period
+	periods = types.Periods{
+		{Length: 10, Amount: sdk.NewCoins()},
+		{Length: 10, Amount: stake(10)},
+	}
+	require.Equal(t, types.Periods{{Length: 20, Amount: stake(10)}}, types.CapPeriods(periods, stake(10)))
+}
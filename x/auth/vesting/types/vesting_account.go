@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -174,6 +176,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -411,6 +415,49 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// GetFunder returns the address which funded the periodic vesting account, or
+// nil if it is not known.
+func (pva PeriodicVestingAccount) GetFunder() sdk.AccAddress {
+	if pva.FunderAddress == "" {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(pva.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// AddGrant merges a new grant, given by its start time and vesting periods,
+// into the vesting schedule of the account. The start and end times of the
+// account are moved to cover both schedules and the granted coins are added
+// to the original vesting amount. The delegation bookkeeping is rebased on
+// the bonded and unbonding coins of the account, so that delegated coins
+// count towards the new vesting amount first. The caller is responsible for
+// transferring the granted coins to the account.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, bonded, unbonding sdk.Coins, grantStartTime int64, grantVestingPeriods Periods) {
+	grantCoins := grantVestingPeriods.TotalAmount()
+
+	// slashed coins are still tracked as delegated, but only up to the
+	// amount that is unvested before the grant
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := pva.DelegatedVesting.Add(pva.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated))
+	newDelegated := delegated.Add(pva.GetVestingCoins(blockTime).Min(slashed)...)
+
+	startTime, endTime, periods := DisjunctPeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantVestingPeriods)
+
+	pva.StartTime = startTime
+	pva.EndTime = endTime
+	pva.VestingPeriods = periods
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantCoins...)
+
+	pva.DelegatedVesting = newDelegated.Min(pva.GetVestingCoins(blockTime))
+	pva.DelegatedFree = newDelegated.Sub(pva.DelegatedVesting)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -428,6 +475,11 @@ func (pva PeriodicVestingAccount) Validate() error {
 	if !originalVesting.IsEqual(pva.OriginalVesting) {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
+	if pva.FunderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(pva.FunderAddress); err != nil {
+			return fmt.Errorf("invalid funder address: %w", err)
+		}
+	}
 
 	return pva.BaseVestingAccount.Validate()
 }
@@ -455,6 +507,7 @@ func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
 		EndTime:          pva.EndTime,
 		StartTime:        pva.StartTime,
 		VestingPeriods:   pva.VestingPeriods,
+		FunderAddress:    pva.FunderAddress,
 	}
 	return marshalYaml(out)
 }
@@ -593,6 +646,222 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	// copy the schedules to avoid aliasing the caller's slices
+	lp := make(Periods, len(lockupPeriods))
+	copy(lp, lockupPeriods)
+	vp := make(Periods, len(vestingPeriods))
+	copy(vp, vestingPeriods)
+
+	endTime := startTime + lp.TotalLength()
+	if vestingEnd := startTime + vp.TotalLength(); vestingEnd > endTime {
+		endTime = vestingEnd
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lp,
+		VestingPeriods:     vp,
+	}
+}
+
+// GetUnlockedOnly returns the coins released by the lockup schedule at
+// blockTime, irrespective of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.EndTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins released by the vesting schedule at
+// blockTime, irrespective of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedCoins returns the total number of vested coins that are no longer
+// subject to lockup. Coins must be both vested and unlocked to be considered
+// vested by the VestingAccount interface. If no coins are vested, an empty
+// set is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return va.GetUnlockedOnly(blockTime).Min(va.GetVestedOnly(blockTime))
+}
+
+// GetVestingCoins returns the total number of vesting coins, i.e. the coins
+// which are still unvested or locked.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address of the account which is allowed to claw back
+// unvested coins.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(va.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetLockupPeriods returns the unlocking schedule of the clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting schedule of the clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// ComputeClawback removes all future vesting events from the account and
+// returns the total sum of these events. The lockup schedule is capped to the
+// remaining original vesting amount so that both schedules keep the same
+// total, although future unlocking events of already vested coins are kept.
+// The delegation bookkeeping is left untouched and must be adjusted through
+// UpdateDelegation. If the amount returned is non-zero, the caller must move
+// those coins out of the account.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	totalVested := sdk.NewCoins()
+	totalUnvested := sdk.NewCoins()
+
+	var (
+		vestedPeriods Periods
+		vestTime      = va.StartTime
+		lastVestTime  = va.StartTime
+	)
+	for _, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime <= clawbackTime {
+			totalVested = totalVested.Add(period.Amount...)
+			vestedPeriods = append(vestedPeriods, period)
+			lastVestTime = vestTime
+		} else {
+			totalUnvested = totalUnvested.Add(period.Amount...)
+		}
+	}
+
+	lockupPeriods := CapPeriods(va.LockupPeriods, totalVested)
+	endTime := va.StartTime + lockupPeriods.TotalLength()
+	if lastVestTime > endTime {
+		endTime = lastVestTime
+	}
+
+	va.OriginalVesting = totalVested
+	va.EndTime = endTime
+	va.LockupPeriods = lockupPeriods
+	va.VestingPeriods = vestedPeriods
+
+	return totalUnvested
+}
+
+// UpdateDelegation adjusts the delegation bookkeeping of the account after
+// ComputeClawback has removed future vesting events, given the coins which
+// are still encumbered (i.e. vesting) and the current bonded, unbonding and
+// unbonded (bank balance) coins of the account. It returns the amount which
+// can actually be clawed back, which might be less than toClawBack if the
+// account has been slashed.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated))
+	total := delegated.Add(unbonded...)
+	toClawBack = toClawBack.Min(total) // might have been slashed
+	newDelegated := delegated.Min(total.Sub(toClawBack)).Add(slashed...)
+	va.DelegatedVesting = encumbered.Min(newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if va.StartTime+Periods(va.LockupPeriods).TotalLength() > va.EndTime {
+		return errors.New("lockup schedule extends beyond account end time")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+
+	if va.StartTime+Periods(va.VestingPeriods).TotalLength() > va.EndTime {
+		return errors.New("vesting schedule extends beyond account end time")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
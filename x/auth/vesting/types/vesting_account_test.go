@@ -676,6 +676,142 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(16 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.True(t, va.GetVestedCoins(now).IsZero())
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require vested coins to still be locked before the lockup ends
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(12*time.Hour)))
+	require.True(t, va.GetVestedCoins(now.Add(12*time.Hour)).IsZero())
+
+	// require vested coins to be released once the lockup ends
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(16*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(16*time.Hour)))
+
+	// require 75% of coins vested after period 2
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, va.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.True(t, va.GetVestingCoins(now.Add(24*time.Hour)).IsZero())
+}
+
+func TestSpendableCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+
+	// require that all original coins are locked at the beginning of the vesting schedule
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require that all coins are spendable after the maturation of the vesting schedule
+	require.True(t, va.LockedCoins(now.Add(24*time.Hour)).IsZero())
+
+	// require that only the unvested coins are locked in the middle of the schedule
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// delegating unvested coins releases them from the lock
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, va.LockedCoins(now.Add(12*time.Hour)))
+}
+
+func TestComputeClawback(t *testing.T) {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	lockupPeriods := types.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins()}, // empty initial
+		{Length: int64(1), Amount: sdk.NewCoins(fee(1000), stake(100))},
+	}
+	vestingPeriods := types.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},
+	}
+
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, 0, lockupPeriods, vestingPeriods)
+	require.NoError(t, va.Validate())
+
+	// nothing vested yet, so everything is clawed back
+	va0 := *va
+	amt := va0.ComputeClawback(0)
+	require.Equal(t, origCoins, amt)
+	require.True(t, va0.OriginalVesting.IsZero())
+	require.Empty(t, va0.LockupPeriods)
+	require.Empty(t, va0.VestingPeriods)
+
+	// the first two vesting events are kept, the lockup is capped accordingly
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, 0, lockupPeriods, vestingPeriods)
+	amt = va.ComputeClawback(int64(10 * 3600))
+	require.Equal(t, sdk.NewCoins(fee(600), stake(50)), amt)
+	require.Equal(t, sdk.NewCoins(fee(400), stake(50)), va.OriginalVesting)
+	require.Equal(t, types.Periods{{Length: int64(12*3600 + 1), Amount: sdk.NewCoins(fee(400), stake(50))}}, types.Periods(va.LockupPeriods))
+	require.Equal(t, types.Periods(vestingPeriods[:2]), types.Periods(va.VestingPeriods))
+	require.Equal(t, int64(12*3600+1), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// the vesting event on the clawback time is kept
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, 0, lockupPeriods, vestingPeriods)
+	amt = va.ComputeClawback(int64(15 * 3600))
+	require.Equal(t, sdk.NewCoins(fee(400)), amt)
+	require.NoError(t, va.Validate())
+
+	// nothing to claw back once fully vested
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, 0, lockupPeriods, vestingPeriods)
+	amt = va.ComputeClawback(int64(24 * 3600))
+	require.True(t, amt.IsZero())
+	require.Equal(t, origCoins, va.OriginalVesting)
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	stake := func(x int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, x)) }
+
+	bacc, _ := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{{Length: 100, Amount: stake(100)}}
+	va := types.NewClawbackVestingAccount(bacc, funder, stake(100), 0, periods, periods)
+
+	// all coins delegated while unvested
+	va.TrackDelegation(time.Unix(0, 0), stake(100), stake(60))
+	require.Equal(t, stake(60), va.DelegatedVesting)
+
+	toClawBack := va.ComputeClawback(50)
+	require.Equal(t, stake(100), toClawBack)
+
+	// the delegation lost 10 tokens to slashing
+	toClawBack = va.UpdateDelegation(sdk.NewCoins(), toClawBack, stake(50), sdk.NewCoins(), stake(40))
+	require.Equal(t, stake(90), toClawBack)
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.Equal(t, stake(10), va.DelegatedFree)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -741,6 +877,29 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
+			true,
+		},
+		{
+			"invalid clawback vesting funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -828,6 +987,27 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{types.Period{Length: int64(100), Amount: coins}}
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), periods, periods)
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
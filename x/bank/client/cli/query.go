@@ -31,8 +31,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetSpendableBalancesCmd(),
+		GetLockedBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
 	)
 
 	return cmd
@@ -101,6 +104,116 @@ Example:
 	return cmd
 }
 
+// GetSpendableBalancesCmd defines the cobra command to query the spendable
+// balances of an account.
+func GetSpendableBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Short: "Query for account spendable balances by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spendable balance of an account or of a specific denomination,
+i.e. the balance which isn't locked by vesting.
+
+Example:
+  $ %s query %s spendable-balances [address]
+  $ %s query %s spendable-balances [address] --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			if denom == "" {
+				params := types.NewQuerySpendableBalancesRequest(addr, pageReq)
+				res, err := queryClient.SpendableBalances(ctx, params)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			params := types.NewQuerySpendableBalanceByDenomRequest(addr, denom)
+			res, err := queryClient.SpendableBalanceByDenom(ctx, params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Balance)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "spendable balances")
+
+	return cmd
+}
+
+// GetLockedBalancesCmd defines the cobra command to query the coins locked by
+// vesting for an account.
+func GetLockedBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-balances [address]",
+		Short: "Query for the coins locked by vesting for an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins locked by vesting for an account, along with the breakdown
+of its vesting and delegated vesting coins. All the amounts are empty for accounts
+which aren't vesting accounts.
+
+Example:
+  $ %s query %s locked-balances [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LockedBalances(cmd.Context(), types.NewQueryLockedBalancesRequest(addr))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
@@ -212,3 +325,53 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdQuerySendEnabled defines the cobra command to query the send enabled
+// entries of denominations.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query for send enabled entries",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for send enabled entries that have been specifically set.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments. Denoms without a specific
+entry use the default_send_enabled param.
+
+Example:
+  $ %s query %s send-enabled
+  $ %s query %s send-enabled [denom1] [denom2]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
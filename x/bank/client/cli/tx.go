@@ -1,13 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -58,3 +65,77 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// setSendEnabledProposalJSON is the content of the file of a set send-enabled
+// proposal.
+type setSendEnabledProposalJSON struct {
+	Title         string               `json:"title"`
+	Description   string               `json:"description"`
+	SendEnabled   []*types.SendEnabled `json:"send_enabled"`
+	UseDefaultFor []string             `json:"use_default_for"`
+	Deposit       string               `json:"deposit"`
+}
+
+// GetCmdSubmitSetSendEnabledProposal implements the command to submit a
+// set-send-enabled proposal.
+func GetCmdSubmitSetSendEnabledProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-send-enabled [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal setting the send-enabled entries of denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting the send-enabled entries of denoms along with an
+initial deposit. The denoms of use_default_for have their entries deleted, so that
+they use the default_send_enabled param. The proposal details must be supplied via
+a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-send-enabled <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Disable foo transfers",
+  "description": "Disable the transfers of foo, and reset bar to the default",
+  "send_enabled": [{"denom": "foo", "enabled": false}],
+  "use_default_for": ["bar"],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal setSendEnabledProposalJSON
+			if err := json.Unmarshal(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetSendEnabledProposal(proposal.Title, proposal.Description, proposal.SendEnabled, proposal.UseDefaultFor)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
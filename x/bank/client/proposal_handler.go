@@ -0,0 +1,11 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// ProposalHandler is the set send-enabled proposal handler.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetSendEnabledProposal)
+)
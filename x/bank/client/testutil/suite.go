@@ -75,6 +75,11 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.SendEnabled = []types.SendEnabled{
+		{Denom: "uatom", Enabled: true},
+		{Denom: "wei", Enabled: false},
+	}
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -163,6 +168,119 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetSpendableBalancesCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{"no address provided", []string{}, true, nil, nil},
+		{
+			"spendable account balance",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QuerySpendableBalancesResponse{},
+			&types.QuerySpendableBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"spendable account balance of a specific denom",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=%s", cli.FlagDenom, s.cfg.BondDenom),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&sdk.Coin{},
+			NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+		},
+		{
+			"spendable account balance of a bogus denom",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=foobar", cli.FlagDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			&sdk.Coin{},
+			NewCoin("foobar", sdk.ZeroInt()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetSpendableBalancesCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetLockedBalancesCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"no address provided", []string{}, true},
+		{"invalid address", []string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, true},
+		{
+			"non vesting account",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetLockedBalancesCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryLockedBalancesResponse
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().True(res.Locked.IsZero())
+				s.Require().True(res.OriginalVesting.IsZero())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	val := s.network.Validators[0]
 
@@ -235,6 +353,60 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *types.QuerySendEnabledResponse
+	}{
+		{
+			name: "all send enabled entries",
+			args: []string{
+				fmt.Sprintf("--%s", flags.FlagCountTotal),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("uatom", true),
+					types.NewSendEnabled("wei", false),
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name: "specific denoms",
+			args: []string{
+				"wei",
+				"foobar",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("wei", false),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var res types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Equal(tc.expected, &res)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	val := s.network.Validators[0]
 
@@ -33,10 +33,12 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 			NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, expectedBalances[i].Coins))
 	}
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
+	app.BankKeeper.SetSendEnabled(ctx, "testcoin1", false)
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
 	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
+	suite.Require().Equal([]types.SendEnabled{{Denom: "testcoin1", Enabled: false}}, exportGenesis.SendEnabled)
 	suite.Require().Equal(types.DefaultParams().DefaultSendEnabled, exportGenesis.Params.DefaultSendEnabled)
 	suite.Require().Equal(expTotalSupply, exportGenesis.Supply)
 	suite.Require().Subset(exportGenesis.Balances, expectedBalances)
@@ -62,12 +64,21 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	m := types.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom}
 	g := types.DefaultGenesisState()
 	g.DenomMetadata = []types.Metadata{m}
+	g.Params.SendEnabled = []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}
+	g.SendEnabled = []types.SendEnabled{{Denom: "barcoin", Enabled: false}}
 	bk := suite.app.BankKeeper
 	bk.InitGenesis(suite.ctx, g)
 
 	m2, found := bk.GetDenomMetaData(suite.ctx, m.Base)
 	suite.Require().True(found)
 	suite.Require().Equal(m, m2)
+
+	// send enabled entries of both the params and the genesis state are stored
+	suite.Require().Empty(bk.GetParams(suite.ctx).SendEnabled)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: false},
+		{Denom: "foocoin", Enabled: false},
+	}, bk.GetAllSendEnabledEntries(suite.ctx))
 }
 
 func (suite *IntegrationTestSuite) TestTotalSupply() {
@@ -92,17 +103,17 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 	}{
 		{
 			"calculation NOT matching genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			nil, true, "genesis supply is incorrect, expected 1wrongcoin, got 21barcoin,11foocoin",
 		},
 		{
 			"calculation matches genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 		{
 			"calculation is correct, empty genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 	}
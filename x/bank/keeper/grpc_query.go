@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -75,6 +76,107 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// SpendableBalances implements the Query/SpendableBalances gRPC method
+func (k BaseKeeper) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	balances := sdk.NewCoins()
+	locked := k.LockedCoins(sdkCtx, addr)
+	accountStore := k.getAccountStore(sdkCtx, addr)
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+		balances = append(balances, spendableAmount(sdk.NewCoin(string(key), amount), locked))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// SpendableBalanceByDenom implements the Query/SpendableBalanceByDenom gRPC method
+func (k BaseKeeper) SpendableBalanceByDenom(ctx context.Context, req *types.QuerySpendableBalanceByDenomRequest) (*types.QuerySpendableBalanceByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	spendable := k.SpendableCoin(sdkCtx, addr, req.Denom)
+
+	return &types.QuerySpendableBalanceByDenomResponse{Balance: &spendable}, nil
+}
+
+// LockedBalances implements the Query/LockedBalances gRPC method
+func (k BaseKeeper) LockedBalances(ctx context.Context, req *types.QueryLockedBalancesRequest) (*types.QueryLockedBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res := &types.QueryLockedBalancesResponse{
+		Locked:           sdk.NewCoins(),
+		OriginalVesting:  sdk.NewCoins(),
+		Vesting:          sdk.NewCoins(),
+		Vested:           sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		DelegatedFree:    sdk.NewCoins(),
+	}
+
+	acc := k.ak.GetAccount(sdkCtx, addr)
+	if vacc, ok := acc.(vestexported.VestingAccount); ok {
+		blockTime := sdkCtx.BlockTime()
+		res.Locked = vacc.LockedCoins(blockTime)
+		res.OriginalVesting = vacc.GetOriginalVesting()
+		res.Vesting = vacc.GetVestingCoins(blockTime)
+		res.Vested = vacc.GetVestedCoins(blockTime)
+		res.DelegatedVesting = vacc.GetDelegatedVesting()
+		res.DelegatedFree = vacc.GetDelegatedFree()
+	}
+
+	return res, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -210,3 +312,35 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements the Query/SendEnabled gRPC method
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if se, ok := k.GetSendEnabledEntry(ctx, denom); ok {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(se.Denom, se.Enabled))
+			}
+		}
+	} else {
+		store := ctx.KVStore(k.storeKey)
+		sendEnabledStore := prefix.NewStore(store, types.SendEnabledPrefix)
+
+		pageRes, err := query.Paginate(sendEnabledStore, req.Pagination, func(key []byte, value []byte) error {
+			resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(string(key), isTrueB(value)))
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp.Pagination = pageRes
+	}
+
+	return resp, nil
+}
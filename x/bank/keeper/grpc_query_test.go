@@ -3,11 +3,17 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -86,6 +92,180 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestSpendableBalances() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	pageReq := &query.PageRequest{
+		Key:        nil,
+		Limit:      2,
+		CountTotal: false,
+	}
+	req := types.NewQuerySpendableBalancesRequest(addr, pageReq)
+
+	res, err := queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.True(res.Balances.IsZero())
+
+	fooCoins := newFooCoin(50)
+	barCoins := newBarCoin(30)
+
+	origCoins := sdk.NewCoins(fooCoins, barCoins)
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc = vesting.NewContinuousVestingAccount(
+		acc.(*authtypes.BaseAccount),
+		sdk.NewCoins(fooCoins),
+		now.Unix(),
+		now.Add(time.Hour).Unix(),
+	)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acc.GetAddress(), origCoins))
+
+	// move time forward for some tokens to vest
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Equal(2, res.Balances.Len())
+	suite.Nil(res.Pagination.NextKey)
+	suite.EqualValues(30, res.Balances[0].Amount.Int64())
+	suite.EqualValues(25, res.Balances[1].Amount.Int64())
+
+	// paginate through the balances
+	req = types.NewQuerySpendableBalancesRequest(addr, &query.PageRequest{Limit: 1})
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Equal(1, res.Balances.Len())
+	suite.NotNil(res.Pagination.NextKey)
+
+	req = types.NewQuerySpendableBalancesRequest(addr, &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1})
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Equal(1, res.Balances.Len())
+	suite.EqualValues(25, res.Balances[0].Amount.Int64())
+	suite.Nil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestSpendableBalanceByDenom() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SpendableBalanceByDenom(gocontext.Background(), &types.QuerySpendableBalanceByDenomRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), &types.QuerySpendableBalanceByDenomRequest{Address: addr.String()})
+	suite.Require().Error(err)
+
+	req := types.NewQuerySpendableBalanceByDenomRequest(addr, fooDenom)
+	res, err := queryClient.SpendableBalanceByDenom(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.True(res.Balance.IsZero())
+
+	origCoins := sdk.NewCoins(newFooCoin(100), newBarCoin(30))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc = vesting.NewContinuousVestingAccount(
+		acc.(*authtypes.BaseAccount),
+		sdk.NewCoins(newFooCoin(100)),
+		now.Unix(),
+		now.Add(time.Hour).Unix(),
+	)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acc.GetAddress(), origCoins))
+
+	// nothing has vested yet
+	res, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.True(res.Balance.IsZero())
+
+	res, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(addr, barDenom))
+	suite.Require().NoError(err)
+	suite.True(res.Balance.IsEqual(newBarCoin(30)))
+
+	// move time forward for half of the tokens to vest
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	res, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.True(res.Balance.IsEqual(newFooCoin(50)))
+}
+
+func (suite *IntegrationTestSuite) TestLockedBalances() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, vestingAddr := testdata.KeyTestPubAddr()
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now.Add(30 * time.Minute)})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.LockedBalances(gocontext.Background(), &types.QueryLockedBalancesRequest{})
+	suite.Require().Error(err)
+
+	// regular accounts have no locked coins
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(newFooCoin(100))))
+
+	res, err := queryClient.LockedBalances(gocontext.Background(), types.NewQueryLockedBalancesRequest(addr))
+	suite.Require().NoError(err)
+	suite.True(res.Locked.IsZero())
+	suite.True(res.OriginalVesting.IsZero())
+	suite.True(res.DelegatedVesting.IsZero())
+
+	// half of the vesting coins have vested, and 20 coins are delegated
+	origVesting := sdk.NewCoins(newFooCoin(100))
+	vacc := vesting.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vestingAddr),
+		origVesting,
+		now.Unix(),
+		now.Add(time.Hour).Unix(),
+	)
+	vacc.TrackDelegation(ctx.BlockTime(), origVesting, sdk.NewCoins(newFooCoin(20)))
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, vestingAddr, sdk.NewCoins(newFooCoin(80))))
+
+	res, err = queryClient.LockedBalances(gocontext.Background(), types.NewQueryLockedBalancesRequest(vestingAddr))
+	suite.Require().NoError(err)
+	suite.Equal(origVesting, res.OriginalVesting)
+	suite.Equal(sdk.NewCoins(newFooCoin(50)), res.Vesting)
+	suite.Equal(sdk.NewCoins(newFooCoin(50)), res.Vested)
+	suite.Equal(sdk.NewCoins(newFooCoin(20)), res.DelegatedVesting)
+	suite.True(res.DelegatedFree.IsZero())
+	suite.Equal(sdk.NewCoins(newFooCoin(30)), res.Locked)
+
+	spendable, err := queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(vestingAddr, fooDenom))
+	suite.Require().NoError(err)
+	suite.True(spendable.Balance.IsEqual(newFooCoin(50)))
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
@@ -400,3 +580,26 @@ func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 
 	suite.Require().True(true)
 }
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	app.BankKeeper.SetSendEnabled(ctx, "cat", true)
+	app.BankKeeper.SetSendEnabled(ctx, "dog", false)
+
+	// denoms without a specific entry are omitted
+	res, err := suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Denoms: []string{"dog", "cow", "cat"}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled("dog", false), types.NewSendEnabled("cat", true)}, res.SendEnabled)
+	suite.Require().Nil(res.Pagination)
+
+	// all entries are paginated when no denoms are given
+	res, err = suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled("cat", true)}, res.SendEnabled)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled("dog", false)}, res.SendEnabled)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -87,7 +89,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -527,6 +529,119 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	// entries provided through the params are moved to the store
+	params := types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled(fooDenom, false)})
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+
+	entry, found := app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: fooDenom, Enabled: false}, entry)
+
+	// denoms without an entry use the default
+	_, found = app.BankKeeper.GetSendEnabledEntry(ctx, barDenom)
+	suite.Require().False(found)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, barDenom))
+
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, true)
+	app.BankKeeper.SetParams(ctx, types.NewParams(false, types.SendEnabledParams{}))
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, barDenom))
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, sdk.DefaultBondDenom))
+
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: barDenom, Enabled: true},
+		{Denom: fooDenom, Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	app.BankKeeper.DeleteSendEnabled(ctx, fooDenom, "unknown")
+	suite.Require().Equal([]types.SendEnabled{{Denom: barDenom, Enabled: true}}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.Require().Equal(authority, app.BankKeeper.GetAuthority())
+
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, false)
+
+	// only the authority may set send enabled entries
+	msg := types.NewMsgSetSendEnabled(sdk.AccAddress([]byte("addr1_______________")).String(), []*types.SendEnabled{types.NewSendEnabled(fooDenom, false)}, nil)
+	_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+
+	msg = types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{types.NewSendEnabled(fooDenom, false)}, []string{barDenom})
+	_, err = msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{{Denom: fooDenom, Enabled: false}}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	defer app.BankKeeper.ClearSendRestriction()
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	sanctioned := sdk.AccAddress([]byte("sanctioned__________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+
+	var calls []string
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "veto")
+		if toAddr.Equals(sanctioned) {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("sanctioned")
+		}
+		return toAddr, nil
+	})
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect")
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+
+	// restrictions run in order and may redirect the transfer
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal([]string{"redirect", "veto"}, calls)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// or veto it
+	cacheCtx, _ := ctx.CacheContext()
+	err := app.BankKeeper.SendCoins(cacheCtx, addr1, sanctioned, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "sanctioned")
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// restrictions can't be applied to transfers with multiple senders
+	inputs = []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []types.Output{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrMultipleSenders)
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, sanctioned, sdk.NewCoins(newFooCoin(10))))
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -778,6 +893,35 @@ func (suite *IntegrationTestSuite) TestPeriodicVestingAccountSend() {
 	suite.Require().Equal(origCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
 }
 
+func (suite *IntegrationTestSuite) TestPermanentLockedAccountSend() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	bacc := authtypes.NewBaseAccountWithAddress(addr1)
+	placc := vesting.NewPermanentLockedAccount(bacc, origCoins)
+
+	app.AccountKeeper.SetAccount(ctx, placc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, origCoins))
+
+	// require that no coins be sendable, no matter how much time has passed
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendCoins))
+	ctx = ctx.WithBlockTime(now.AddDate(100, 0, 0))
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendCoins))
+
+	// receive some coins and require that only those are spendable
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sendCoins))
+	suite.Require().Equal(sendCoins, app.BankKeeper.SpendableCoins(ctx, addr1))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendCoins))
+	suite.Require().Equal(origCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
 func (suite *IntegrationTestSuite) TestVestingAccountReceive() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1054,7 +1198,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
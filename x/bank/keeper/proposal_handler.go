@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetSendEnabledProposal is a handler for executing a passed set
+// send-enabled proposal
+func HandleSetSendEnabledProposal(ctx sdk.Context, k Keeper, p *types.SetSendEnabledProposal) error {
+	if len(p.SendEnabled) > 0 {
+		k.SetAllSendEnabled(ctx, p.SendEnabled)
+	}
+	if len(p.UseDefaultFor) > 0 {
+		k.DeleteSendEnabled(ctx, p.UseDefaultFor...)
+	}
+
+	return nil
+}
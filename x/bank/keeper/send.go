@@ -26,7 +26,21 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	GetAuthority() string
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,22 +57,54 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address capable of executing a MsgSetSendEnabled message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	// sendRestriction is shared by every copy of the keeper, so that
+	// restrictions registered after app wiring still apply.
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool, authority string,
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
+// GetAuthority returns the x/bank module's authority.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
+// AppendSendRestriction adds the provided SendRestrictionFn to run after
+// previously registered restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before
+// previously registered restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -66,8 +112,16 @@ func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 }
 
 // SetParams sets the total set of bank parameters.
+//
+// Note: params.SendEnabled is deprecated but it should be here regardless.
+// Any entries provided in it are moved to the send enabled store, and the
+// params list is always stored empty.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	if len(params.SendEnabled) > 0 {
+		k.SetAllSendEnabled(ctx, params.SendEnabled)
+	}
+	p := types.NewParams(params.DefaultSendEnabled, types.SendEnabledParams{})
+	k.paramSpace.SetParamSet(ctx, &p)
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
@@ -80,6 +134,18 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	// send restrictions are given a single sender, they can't be applied to
+	// transfers with multiple inputs
+	var fromAddr sdk.AccAddress
+	if len(inputs) == 1 {
+		var err error
+		if fromAddr, err = sdk.AccAddressFromBech32(inputs[0].Address); err != nil {
+			return err
+		}
+	} else if k.sendRestriction.isSet() {
+		return types.ErrMultipleSenders
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -104,6 +170,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+
+		outAddress, err = k.sendRestriction.apply(ctx, fromAddr, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
 		err = k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
@@ -112,7 +184,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -132,13 +204,19 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The registered send restrictions may veto the transfer or redirect it to
+// another account. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
 
+	toAddr, err = k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, toAddr, amt)
 	if err != nil {
 		return err
@@ -313,7 +391,89 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
+// Denoms without a specific entry use the DefaultSendEnabled param.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	if sendEnabled, found := k.getSendEnabled(ctx, denom); found {
+		return sendEnabled
+	}
+	return k.GetParams(ctx).DefaultSendEnabled
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	sendEnabled, found := k.getSendEnabled(ctx, denom)
+	if !found {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: sendEnabled}, true
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateSendEnabledKey(denom), toBoolB(value))
+}
+
+// SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	for _, se := range sendEnableds {
+		store.Set(types.CreateSendEnabledKey(se.Denom), toBoolB(se.Enabled))
+	}
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms.
+// If a denom is provided that doesn't have a SendEnabled entry, it is ignored.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateSendEnabledKey(denom))
+	}
+}
+
+// IterateSendEnabledEntries iterates over all the SendEnabled entries.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool)) {
+	seStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+
+	iterator := seStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		if cb(denom, isTrueB(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denominations not returned use the default value (set in Params).
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var rv []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		rv = append(rv, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+
+	return rv
+}
+
+// getSendEnabled returns whether send is enabled and whether that flag was set
+// for a denom.
+func (k BaseSendKeeper) getSendEnabled(ctx sdk.Context, denom string) (bool, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CreateSendEnabledKey(denom))
+	if bz == nil {
+		return false, false
+	}
+
+	return isTrueB(bz), true
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -321,3 +481,58 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// sendRestriction is a struct that houses a SendRestrictionFn.
+// It exists so that the SendRestrictionFn can be updated in the SendKeeper
+// without needing to have a pointer receiver on the keeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// isSet returns true if a send restriction is registered.
+func (r *sendRestriction) isSet() bool {
+	return r != nil && r.fn != nil
+}
+
+// apply applies the send restriction if there is one. If not, it's a no-op.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !r.isSet() {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
+
+// isTrueB returns true if the provided byte slice has exactly one byte, and it is equal to 0x01.
+func isTrueB(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == 0x01
+}
+
+// toBoolB converts a bool into a byte slice, 0x01 for true or 0x00 for false.
+func toBoolB(value bool) []byte {
+	if value {
+		return []byte{0x01}
+	}
+	return []byte{0x00}
+}
@@ -27,6 +27,7 @@ type ViewKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -187,6 +188,26 @@ func (k BaseViewKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk
 	return spendable
 }
 
+// SpendableCoin returns the balance of specific denomination of spendable coins
+// for an account by address. If the account has no spendable coin, a zero Coin
+// is returned.
+func (k BaseViewKeeper) SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	balance := k.GetBalance(ctx, addr, denom)
+	locked := k.LockedCoins(ctx, addr)
+	return spendableAmount(balance, locked)
+}
+
+// spendableAmount returns the part of a balance which isn't locked, or a zero
+// Coin if the locked amount exceeds the balance.
+func spendableAmount(balance sdk.Coin, locked sdk.Coins) sdk.Coin {
+	spendable := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+	if spendable.IsNegative() {
+		return sdk.NewCoin(balance.Denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(balance.Denom, spendable)
+}
+
 // spendableCoins returns the coins the given address can spend alongside the total amount of coins it holds.
 // It exists for gas efficiency, in order to avoid to have to get balance multiple times.
 func (k BaseViewKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) (spendable, total sdk.Coins) {
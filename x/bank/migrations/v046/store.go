@@ -0,0 +1,30 @@
+package v046
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Moving the SendEnabled entries from the params to their own store prefix.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) error {
+	var sendEnabled []*types.SendEnabled
+	paramSpace.GetIfExists(ctx, types.KeySendEnabled, &sendEnabled)
+
+	store := ctx.KVStore(storeKey)
+	for _, se := range sendEnabled {
+		bz := []byte{0x00}
+		if se.Enabled {
+			bz = []byte{0x01}
+		}
+		store.Set(types.CreateSendEnabledKey(se.Denom), bz)
+	}
+
+	paramSpace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{})
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey(types.StoreKey)
+	transientKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(bankKey, transientKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, transientKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	params := types.NewParams(true, types.SendEnabledParams{
+		types.NewSendEnabled("foo", false),
+		types.NewSendEnabled("bar", true),
+	})
+	paramSpace.SetParamSet(ctx, &params)
+
+	require.NoError(t, v046.MigrateStore(ctx, bankKey, paramSpace))
+
+	store := ctx.KVStore(bankKey)
+	require.Equal(t, []byte{0x00}, store.Get(types.CreateSendEnabledKey("foo")))
+	require.Equal(t, []byte{0x01}, store.Get(types.CreateSendEnabledKey("bar")))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Empty(t, migrated.SendEnabled)
+	require.True(t, migrated.DefaultSendEnabled)
+}
@@ -0,0 +1,33 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestSetSendEnabledProposalHandler(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := bank.NewSetSendEnabledProposalHandler(app.BankKeeper)
+
+	app.BankKeeper.SetAllSendEnabled(ctx, []*types.SendEnabled{types.NewSendEnabled("bar", false)})
+
+	tp := types.NewSetSendEnabledProposal("Test", "description", []*types.SendEnabled{types.NewSendEnabled("foo", false)}, []string{"bar"})
+	require.NoError(t, hdlr(ctx, tp))
+
+	require.False(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("foo", 1)))
+	_, found := app.BankKeeper.GetSendEnabledEntry(ctx, "bar")
+	require.False(t, found)
+	require.True(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("bar", 1)))
+
+	// other proposals are rejected
+	require.Error(t, hdlr(ctx, distrtypes.NewCommunityPoolSpendProposal("Test", "description", sdk.AccAddress("recipient"), nil)))
+}
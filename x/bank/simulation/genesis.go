@@ -18,21 +18,19 @@ func RandomGenesisDefaultSendParam(r *rand.Rand) bool {
 	return r.Int63n(101) <= 90
 }
 
-// RandomGenesisSendParams randomized Parameters for the bank module
-func RandomGenesisSendParams(r *rand.Rand) types.SendEnabledParams {
-	params := types.DefaultParams()
+// RandomGenesisSendEnabled randomized send enabled entries for the bank module
+func RandomGenesisSendEnabled(r *rand.Rand) []types.SendEnabled {
+	var sendEnabled []types.SendEnabled
 	// 90% chance of transfers being DefaultSendEnabled=true or P(a) = 0.9 for success
 	// 50% of the time add an additional denom specific record (P(b) = 0.475 = 0.5 * 0.95)
 	if r.Int63n(101) <= 50 {
 		// set send enabled 95% of the time
 		bondEnabled := r.Int63n(101) <= 95
-		params = params.SetSendEnabledParam(
-			sdk.DefaultBondDenom,
-			bondEnabled)
+		sendEnabled = append(sendEnabled, types.SendEnabled{Denom: sdk.DefaultBondDenom, Enabled: bondEnabled})
 	}
 
 	// overall probability of enabled for bond denom is 94.75% (P(a)+P(b) - P(a)*P(b))
-	return params.SendEnabled
+	return sendEnabled
 }
 
 // RandomGenesisBalances returns a slice of account balances. Each account has
@@ -52,10 +50,10 @@ func RandomGenesisBalances(simState *module.SimulationState) []types.Balance {
 
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
-	var sendEnabledParams types.SendEnabledParams
+	var sendEnabled []types.SendEnabled
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, string(types.KeySendEnabled), &sendEnabledParams, simState.Rand,
-		func(r *rand.Rand) { sendEnabledParams = RandomGenesisSendParams(r) },
+		simState.Cdc, string(types.KeySendEnabled), &sendEnabled, simState.Rand,
+		func(r *rand.Rand) { sendEnabled = RandomGenesisSendEnabled(r) },
 	)
 
 	var defaultSendEnabledParam bool
@@ -69,12 +67,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply))
 
 	bankGenesis := types.GenesisState{
-		Params: types.Params{
-			SendEnabled:        sendEnabledParams,
-			DefaultSendEnabled: defaultSendEnabledParam,
-		},
-		Balances: RandomGenesisBalances(simState),
-		Supply:   supply,
+		Params:      types.NewParams(defaultSendEnabledParam, types.SendEnabledParams{}),
+		Balances:    RandomGenesisBalances(simState),
+		Supply:      supply,
+		SendEnabled: sendEnabled,
 	}
 
 	paramsBytes, err := json.MarshalIndent(&bankGenesis.Params, "", " ")
@@ -28,7 +28,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
-	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// Deprecated: Use of SendEnabled in params is deprecated.
+	// For genesis, use the newly added send_enabled field in the genesis object.
+	// Storage, lookup, and manipulation of this information is now in the keeper.
+	//
+	// As of cosmos-sdk 0.46, only the send_enabled field of the genesis state and
+	// MsgSetSendEnabled should be used to set per-denom send-enabled values.
+	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
 }
 
@@ -64,6 +70,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetSendEnabled() []*SendEnabled {
 	if m != nil {
 		return m.SendEnabled
@@ -131,6 +138,50 @@ func (m *SendEnabled) GetEnabled() bool {
 	return false
 }
 
+// SetSendEnabledProposal is a governance proposal which sets the send-enabled
+// entries of denoms, as MsgSetSendEnabled does for the module authority.
+type SetSendEnabledProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// send_enabled is the list of entries to add or update.
+	SendEnabled []*SendEnabled `protobuf:"bytes,3,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// use_default_for is a list of denoms whose SendEnabled entries are deleted,
+	// so that they use the params.default_send_enabled value.
+	UseDefaultFor []string `protobuf:"bytes,4,rep,name=use_default_for,json=useDefaultFor,proto3" json:"use_default_for,omitempty"`
+}
+
+func (m *SetSendEnabledProposal) Reset()      { *m = SetSendEnabledProposal{} }
+func (*SetSendEnabledProposal) ProtoMessage() {}
+func (*SetSendEnabledProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{2}
+}
+func (m *SetSendEnabledProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSendEnabledProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSendEnabledProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetSendEnabledProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSendEnabledProposal.Merge(m, src)
+}
+func (m *SetSendEnabledProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetSendEnabledProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSendEnabledProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSendEnabledProposal proto.InternalMessageInfo
+
 // Input models transaction input.
 type Input struct {
 	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -141,7 +192,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{2}
+	return fileDescriptor_dd052eee12edf988, []int{3}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +231,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{3}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +273,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +321,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +406,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,6 +494,7 @@ func (m *Metadata) GetURIHash() string {
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
+	proto.RegisterType((*SetSendEnabledProposal)(nil), "cosmos.bank.v1beta1.SetSendEnabledProposal")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
@@ -453,47 +505,51 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x32, 0xed, 0x61, 0x13, 0x72, 0x90, 0x28,
-	0x34, 0x49, 0xab, 0xa7, 0x20, 0x88, 0xad, 0xa2, 0x11, 0x44, 0xd9, 0x52, 0x04, 0x2f, 0x61, 0x92,
-	0x19, 0x93, 0xa1, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x69, 0x7e, 0x80, 0x20, 0x9e, 0x3c, 0x7a, 0xec,
-	0x51, 0x3d, 0x17, 0xfc, 0x0b, 0xc5, 0x53, 0xf1, 0xe4, 0xa9, 0x4a, 0x7a, 0xf1, 0x67, 0xc8, 0xcc,
-	0xec, 0xa6, 0x29, 0x54, 0xf1, 0xe0, 0xc5, 0xd3, 0xbe, 0xef, 0x7d, 0xef, 0x7d, 0xef, 0xbd, 0x99,
-	0x37, 0x0b, 0xfd, 0x91, 0x54, 0x91, 0x54, 0x9d, 0x21, 0x11, 0x7b, 0x9d, 0xfd, 0x8d, 0x21, 0xd3,
-	0x64, 0xc3, 0x82, 0x76, 0x9c, 0x48, 0x2d, 0xd1, 0x35, 0xc7, 0xb7, 0xad, 0x2b, 0xe3, 0xd7, 0x6a,
-	0x63, 0x39, 0x96, 0x96, 0xef, 0x18, 0xcb, 0x85, 0xae, 0xad, 0xba, 0xd0, 0x81, 0x23, 0xb2, 0x3c,
-	0x47, 0x9d, 0x57, 0x51, 0x6c, 0x5e, 0x65, 0x24, 0xb9, 0x70, 0x7c, 0xf3, 0x35, 0x80, 0xe5, 0xe7,
-	0x24, 0x21, 0x91, 0x42, 0xdb, 0xf0, 0x8a, 0x62, 0x82, 0x0e, 0x98, 0x20, 0xc3, 0x90, 0x51, 0x0c,
-	0x1a, 0xc5, 0x56, 0x75, 0xb3, 0xd1, 0xbe, 0xa4, 0x8f, 0xf6, 0x0e, 0x13, 0xf4, 0xa1, 0x8b, 0x0b,
-	0xaa, 0xea, 0x1c, 0xa0, 0x2e, 0xac, 0x51, 0xf6, 0x8a, 0xa4, 0xa1, 0x1e, 0x5c, 0x10, 0x5b, 0x6a,
-	0x80, 0x96, 0x17, 0xa0, 0x8c, 0x5b, 0x48, 0xef, 0x2d, 0xbf, 0x3f, 0xac, 0x17, 0x9a, 0x8f, 0x60,
-	0x75, 0xc1, 0x89, 0x6a, 0xb0, 0x44, 0x99, 0x90, 0x11, 0x06, 0x0d, 0xd0, 0x5a, 0x09, 0x1c, 0x40,
-	0x18, 0x56, 0x2e, 0xea, 0xe5, 0xb0, 0xe7, 0x19, 0x91, 0x9f, 0x87, 0x75, 0xd0, 0xfc, 0x00, 0x60,
-	0xa9, 0x2f, 0xe2, 0x54, 0xa3, 0x4d, 0x58, 0x21, 0x94, 0x26, 0x4c, 0x29, 0xa7, 0xb2, 0x85, 0xbf,
-	0x1e, 0xad, 0xd7, 0xb2, 0x69, 0xee, 0x3b, 0x66, 0x47, 0x27, 0x5c, 0x8c, 0x83, 0x3c, 0x10, 0x11,
-	0x58, 0x32, 0x87, 0xa3, 0xf0, 0x92, 0x1d, 0x7e, 0xf5, 0x7c, 0x78, 0xc5, 0xe6, 0xc3, 0x6f, 0x4b,
-	0x2e, 0xb6, 0xba, 0xc7, 0xa7, 0xf5, 0xc2, 0xa7, 0xef, 0xf5, 0xd6, 0x98, 0xeb, 0x49, 0x3a, 0x6c,
-	0x8f, 0x64, 0x94, 0x9d, 0x7c, 0xf6, 0x59, 0x57, 0x74, 0xaf, 0xa3, 0xa7, 0x31, 0x53, 0x36, 0x41,
-	0x05, 0x4e, 0xb9, 0xe7, 0xbd, 0x71, 0xad, 0x16, 0x9a, 0x1f, 0x01, 0x2c, 0x3f, 0x4b, 0xf5, 0x7f,
-	0xd1, 0xeb, 0x67, 0x00, 0xcb, 0x3b, 0x69, 0x1c, 0x87, 0x53, 0x53, 0x57, 0x4b, 0x4d, 0xc2, 0x6c,
-	0x41, 0xfe, 0x6d, 0x5d, 0xab, 0xdc, 0x7b, 0x92, 0xd5, 0x05, 0x5f, 0x8e, 0xd6, 0xef, 0xde, 0xfa,
-	0x63, 0xf6, 0x81, 0x7b, 0x40, 0x11, 0x1f, 0x27, 0x44, 0x73, 0x29, 0x54, 0x67, 0xbf, 0x7b, 0xa7,
-	0xdb, 0x76, 0xbd, 0xf6, 0x31, 0x68, 0xbe, 0x80, 0x2b, 0x0f, 0xcc, 0xf6, 0xec, 0x0a, 0xae, 0x7f,
-	0xb3, 0x57, 0x6b, 0xd0, 0x63, 0x07, 0xb1, 0x14, 0x4c, 0x68, 0xbb, 0x58, 0x57, 0x83, 0x39, 0x36,
-	0x3b, 0x47, 0x42, 0x4e, 0x14, 0x53, 0xb8, 0xd8, 0x28, 0xb6, 0x56, 0x82, 0x1c, 0x36, 0xdf, 0x2e,
-	0x41, 0xef, 0x29, 0xd3, 0x84, 0x12, 0x4d, 0x50, 0x03, 0x56, 0x29, 0x53, 0xa3, 0x84, 0xc7, 0xa6,
-	0x89, 0x4c, 0x7e, 0xd1, 0x85, 0xee, 0x99, 0x08, 0x21, 0xa3, 0x41, 0x2a, 0xb8, 0xce, 0x2f, 0xcd,
-	0xbf, 0xf4, 0x75, 0xcd, 0xfb, 0x0d, 0x20, 0xcd, 0x4d, 0x85, 0x10, 0x5c, 0x36, 0x47, 0x8c, 0x8b,
-	0x56, 0xdb, 0xda, 0xa6, 0x3b, 0xca, 0x55, 0x1c, 0x92, 0x29, 0x5e, 0xb6, 0xee, 0x1c, 0x9a, 0x68,
-	0x41, 0x22, 0x86, 0x4b, 0x2e, 0xda, 0xd8, 0xe8, 0x3a, 0x2c, 0xab, 0x69, 0x34, 0x94, 0x21, 0x2e,
-	0x5b, 0x6f, 0x86, 0xd0, 0x2a, 0x2c, 0xa6, 0x09, 0xc7, 0x15, 0xbb, 0x79, 0x95, 0xd9, 0x69, 0xbd,
-	0xb8, 0x1b, 0xf4, 0x03, 0xe3, 0x43, 0x37, 0xa0, 0x97, 0x26, 0x7c, 0x30, 0x21, 0x6a, 0x82, 0x3d,
-	0xcb, 0x57, 0x67, 0xa7, 0xf5, 0xca, 0x6e, 0xd0, 0x7f, 0x4c, 0xd4, 0x24, 0xa8, 0xa4, 0x09, 0x37,
-	0xc6, 0xd6, 0xf6, 0xf1, 0xcc, 0x07, 0x27, 0x33, 0x1f, 0xfc, 0x98, 0xf9, 0xe0, 0xdd, 0x99, 0x5f,
-	0x38, 0x39, 0xf3, 0x0b, 0xdf, 0xce, 0xfc, 0xc2, 0xcb, 0x9b, 0x7f, 0x73, 0x7d, 0x76, 0x07, 0x86,
-	0x65, 0xfb, 0x4f, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x80, 0x89, 0x32, 0x1b, 0x05,
-	0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6b, 0x1b, 0x49,
+	0x14, 0xd7, 0xe8, 0xbf, 0x46, 0x36, 0x07, 0x7b, 0xc2, 0x8c, 0x5d, 0xac, 0x84, 0x0a, 0xa3, 0x3b,
+	0xb0, 0x24, 0xfb, 0xae, 0x12, 0x07, 0xc7, 0xc9, 0xbe, 0xf3, 0x29, 0x10, 0x62, 0x56, 0x98, 0x40,
+	0x1a, 0x31, 0xd2, 0x8e, 0xa5, 0xc1, 0xbb, 0x33, 0xcb, 0xce, 0xac, 0xb1, 0xbe, 0x81, 0x93, 0x2a,
+	0x65, 0x4a, 0x97, 0x49, 0x6a, 0x43, 0xbe, 0x82, 0x49, 0x1a, 0x93, 0x2a, 0x95, 0x13, 0xe4, 0x26,
+	0x1f, 0x23, 0xcc, 0xcc, 0xae, 0x2c, 0x3b, 0x4e, 0x70, 0x93, 0x22, 0xd5, 0xbe, 0xf7, 0x7e, 0x6f,
+	0x7e, 0xef, 0xb7, 0x6f, 0xde, 0x1b, 0x68, 0x8f, 0xb8, 0xf0, 0xb9, 0x68, 0x0d, 0x31, 0x3b, 0x6c,
+	0x1d, 0x6d, 0x0e, 0x89, 0xc4, 0x9b, 0xda, 0x69, 0x06, 0x21, 0x97, 0xdc, 0xfa, 0xd5, 0xe0, 0x4d,
+	0x1d, 0x8a, 0xf1, 0xb5, 0xca, 0x98, 0x8f, 0xb9, 0xc6, 0x5b, 0xca, 0x32, 0xa9, 0x6b, 0xab, 0x26,
+	0x75, 0x60, 0x80, 0xf8, 0x9c, 0x81, 0xae, 0xab, 0x08, 0x32, 0xaf, 0x32, 0xe2, 0x94, 0x19, 0xbc,
+	0xfe, 0x14, 0xc0, 0xfc, 0x1e, 0x0e, 0xb1, 0x2f, 0xac, 0x5d, 0xb8, 0x24, 0x08, 0x73, 0x07, 0x84,
+	0xe1, 0xa1, 0x47, 0x5c, 0x04, 0x6a, 0x99, 0x46, 0x79, 0xab, 0xd6, 0xbc, 0x43, 0x47, 0xb3, 0x4f,
+	0x98, 0xfb, 0xaf, 0xc9, 0xeb, 0xa6, 0x11, 0x70, 0xca, 0xe2, 0x3a, 0x60, 0xb5, 0x61, 0xc5, 0x25,
+	0x07, 0x38, 0xf2, 0xe4, 0xe0, 0x06, 0x61, 0xba, 0x06, 0x1a, 0x45, 0xc7, 0x8a, 0xb1, 0x05, 0x8a,
+	0x4e, 0xf6, 0xc5, 0x69, 0x35, 0x55, 0xdf, 0x85, 0xe5, 0x85, 0xa0, 0x55, 0x81, 0x39, 0x97, 0x30,
+	0xee, 0x23, 0x50, 0x03, 0x8d, 0x92, 0x63, 0x1c, 0x0b, 0xc1, 0xc2, 0x4d, 0xbe, 0xc4, 0xed, 0x14,
+	0x15, 0xc9, 0xe7, 0xd3, 0x2a, 0xa8, 0xbf, 0x03, 0x70, 0xa5, 0x4f, 0x16, 0x2b, 0xec, 0x85, 0x3c,
+	0xe0, 0x02, 0x7b, 0x8a, 0x54, 0x52, 0xe9, 0x91, 0x84, 0x54, 0x3b, 0x56, 0x0d, 0x96, 0x5d, 0x22,
+	0x46, 0x21, 0x0d, 0x24, 0xe5, 0x4c, 0x13, 0x97, 0x9c, 0xc5, 0x90, 0xb5, 0x7d, 0xab, 0x39, 0x99,
+	0xfb, 0x35, 0xe7, 0x66, 0x63, 0xd6, 0xe1, 0x2f, 0x91, 0x20, 0x83, 0xa4, 0x39, 0x07, 0x3c, 0x44,
+	0xd9, 0x5a, 0xa6, 0x51, 0x72, 0x96, 0x23, 0x41, 0x76, 0x4c, 0xf4, 0x3f, 0x1e, 0x76, 0x96, 0x4e,
+	0x4e, 0xab, 0xa9, 0xf8, 0x6f, 0x52, 0xf5, 0x97, 0x00, 0xe6, 0x7a, 0x2c, 0x88, 0xa4, 0xb5, 0x05,
+	0x0b, 0xd8, 0x75, 0x43, 0x22, 0x84, 0x91, 0xdf, 0x45, 0xef, 0xcf, 0x36, 0x2a, 0xb1, 0x84, 0x7f,
+	0x0c, 0xd2, 0x97, 0x21, 0x65, 0x63, 0x27, 0x49, 0xb4, 0x30, 0xcc, 0xa9, 0xeb, 0x16, 0x28, 0xad,
+	0x15, 0xaf, 0x5e, 0x2b, 0x16, 0x64, 0xae, 0x78, 0x9b, 0x53, 0xd6, 0x6d, 0x9f, 0x5f, 0x56, 0x53,
+	0xaf, 0x3f, 0x56, 0x1b, 0x63, 0x2a, 0x27, 0xd1, 0xb0, 0x39, 0xe2, 0x7e, 0x3c, 0x4b, 0xf1, 0x67,
+	0x43, 0xb8, 0x87, 0x2d, 0x39, 0x0d, 0x88, 0xd0, 0x07, 0x84, 0x63, 0x98, 0x3b, 0xc5, 0x93, 0x44,
+	0xea, 0x2b, 0x00, 0xf3, 0x8f, 0x22, 0xf9, 0x53, 0x68, 0x7d, 0x03, 0x60, 0xbe, 0x1f, 0x05, 0x81,
+	0x37, 0x55, 0x75, 0x25, 0x97, 0xd8, 0x43, 0xe0, 0x07, 0xd4, 0xd5, 0xcc, 0x9d, 0x07, 0x71, 0x5d,
+	0xf0, 0xf6, 0x6c, 0xe3, 0xaf, 0xdf, 0xbf, 0x7b, 0xfa, 0xd8, 0x3c, 0x09, 0x3e, 0x1d, 0x87, 0x58,
+	0xcd, 0x9e, 0x68, 0x1d, 0xb5, 0xff, 0x6c, 0x37, 0x8d, 0xd6, 0x1e, 0x02, 0xf5, 0xc7, 0xb0, 0xb4,
+	0xa3, 0x76, 0x61, 0x9f, 0x51, 0xf9, 0x8d, 0x2d, 0x59, 0x83, 0x45, 0x72, 0x1c, 0x70, 0x46, 0x98,
+	0xd4, 0xd3, 0xbc, 0xec, 0xcc, 0x7d, 0xb5, 0x41, 0xd8, 0xa3, 0x58, 0x10, 0xa1, 0xa7, 0xb8, 0xe4,
+	0x24, 0x6e, 0xfd, 0x59, 0x1a, 0x16, 0x1f, 0x12, 0x89, 0x5d, 0x2c, 0xf1, 0xed, 0x9d, 0x00, 0x5f,
+	0xef, 0xc4, 0xdf, 0x2a, 0x83, 0x71, 0x7f, 0x10, 0x31, 0x2a, 0x93, 0x4b, 0xb3, 0xef, 0x5c, 0x89,
+	0xb9, 0x5e, 0x07, 0xba, 0x89, 0x29, 0x2c, 0x0b, 0x66, 0x55, 0x8b, 0x51, 0x46, 0x73, 0x6b, 0x5b,
+	0xa9, 0x73, 0xa9, 0x08, 0x3c, 0x3c, 0x45, 0x59, 0x1d, 0x4e, 0x5c, 0x95, 0xcd, 0xb0, 0x4f, 0x50,
+	0xce, 0x64, 0x2b, 0xdb, 0x5a, 0x81, 0x79, 0x31, 0xf5, 0x87, 0xdc, 0x43, 0x79, 0x1d, 0x8d, 0x3d,
+	0x6b, 0x15, 0x66, 0xa2, 0x90, 0xa2, 0x82, 0x9e, 0xbc, 0xc2, 0xec, 0xb2, 0x9a, 0xd9, 0x77, 0x7a,
+	0x8e, 0x8a, 0x59, 0xeb, 0xb0, 0x18, 0x85, 0x74, 0x30, 0xc1, 0x62, 0x82, 0x8a, 0x1a, 0x2f, 0xcf,
+	0x2e, 0xab, 0x85, 0x7d, 0xa7, 0xf7, 0x3f, 0x16, 0x13, 0xa7, 0x10, 0x85, 0x54, 0x19, 0xdd, 0xed,
+	0xf3, 0x99, 0x0d, 0x2e, 0x66, 0x36, 0xf8, 0x34, 0xb3, 0xc1, 0xf3, 0x2b, 0x3b, 0x75, 0x71, 0x65,
+	0xa7, 0x3e, 0x5c, 0xd9, 0xa9, 0x27, 0xbf, 0xdd, 0xe7, 0xfa, 0xf4, 0x0c, 0x0c, 0xf3, 0xfa, 0x95,
+	0xfd, 0xe3, 0xcb, 0x00, 0x00, 0x9b, 0x86, 0xd1, 0xed, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -639,6 +695,66 @@ func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetSendEnabledProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSendEnabledProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSendEnabledProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -939,6 +1055,35 @@ func (m *SendEnabled) Size() (n int) {
 	return n
 }
 
+func (m *SetSendEnabledProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Input) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1269,6 +1414,186 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetSendEnabledProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSendEnabledProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSendEnabledProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
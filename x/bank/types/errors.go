@@ -12,4 +12,6 @@ var (
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
+	ErrDuplicateEntry        = sdkerrors.Register(ModuleName, 8, "duplicate entry")
+	ErrMultipleSenders       = sdkerrors.Register(ModuleName, 9, "multiple senders not allowed")
 )
@@ -55,6 +55,39 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dup send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"uatom", true},
+					{"uatom", false},
+				},
+			},
+			true,
+		},
+		{
+			"send enabled defined in params too",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{
+						{"uatom", true},
+					},
+				},
+				SendEnabled: []SendEnabled{
+					{"uatom", false},
+				},
+			},
+			true,
+		},
+		{
+			"invalid send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"", true},
+				},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
@@ -26,6 +26,9 @@ var (
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
 
+	// SendEnabledPrefix is the prefix for the per-denom SendEnabled flags.
+	SendEnabledPrefix = []byte{0x04}
+
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
@@ -67,3 +70,11 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendEnabled flag for a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
@@ -7,8 +7,9 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multisend"
+	TypeMsgSetSendEnabled = "set_send_enabled"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -101,6 +102,72 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled Construct a message to set one or more SendEnabled entries.
+//nolint:interfacer
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetSendEnabled.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgSetSendEnabled.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateSendEnabledUpdate(msg.SendEnabled, msg.UseDefaultFor)
+}
+
+// validateSendEnabledUpdate validates the send-enabled entries to set and the
+// denoms whose entries are deleted.
+func validateSendEnabledUpdate(sendEnabled []*SendEnabled, useDefaultFor []string) error {
+	seen := map[string]bool{}
+	for _, se := range sendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("nil send enabled entry")
+		}
+		if _, alreadySeen := seen[se.Denom]; alreadySeen {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+
+		seen[se.Denom] = true
+
+		if err := se.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid SendEnabled denom %q: %s", se.Denom, err)
+		}
+	}
+
+	for _, denom := range useDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
+		}
+	}
+
+	return nil
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
@@ -252,3 +252,37 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority")).String()
+
+	cases := []struct {
+		name   string
+		msg    *MsgSetSendEnabled
+		expErr bool
+	}{
+		{"valid", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foo", false)}, []string{"bar"}), false},
+		{"empty", NewMsgSetSendEnabled(authority, nil, nil), false},
+		{"invalid authority", NewMsgSetSendEnabled("", []*SendEnabled{NewSendEnabled("foo", false)}, nil), true},
+		{"duplicate denom", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foo", false), NewSendEnabled("foo", true)}, nil), true},
+		{"invalid denom", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("f", false)}, nil), true},
+		{"invalid use default denom", NewMsgSetSendEnabled(authority, nil, []string{"f"}), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSetSendEnabledGetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority"))
+	msg := NewMsgSetSendEnabled(authority.String(), nil, nil)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "set_send_enabled")
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+}
@@ -135,12 +135,17 @@ func (se SendEnabled) String() string {
 	return string(out)
 }
 
+// Validate gets any errors with this SendEnabled entry.
+func (se SendEnabled) Validate() error {
+	return sdk.ValidateDenom(se.Denom)
+}
+
 func validateSendEnabled(i interface{}) error {
 	param, ok := i.(SendEnabled)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	return sdk.ValidateDenom(param.Denom)
+	return param.Validate()
 }
 
 func validateIsBool(i interface{}) error {
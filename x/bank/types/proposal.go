@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetSendEnabled defines the type for a SetSendEnabledProposal
+	ProposalTypeSetSendEnabled = "SetSendEnabled"
+)
+
+// Assert SetSendEnabledProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetSendEnabledProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetSendEnabled)
+	govtypes.RegisterProposalTypeCodec(&SetSendEnabledProposal{}, "cosmos-sdk/SetSendEnabledProposal")
+}
+
+// NewSetSendEnabledProposal creates a new proposal setting send-enabled
+// entries.
+func NewSetSendEnabledProposal(title, description string, sendEnabled []*SendEnabled, useDefaultFor []string) *SetSendEnabledProposal {
+	return &SetSendEnabledProposal{
+		Title:         title,
+		Description:   description,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// GetTitle returns the title of a set send-enabled proposal.
+func (p *SetSendEnabledProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set send-enabled proposal.
+func (p *SetSendEnabledProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set send-enabled proposal.
+func (p *SetSendEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set send-enabled proposal.
+func (p *SetSendEnabledProposal) ProposalType() string { return ProposalTypeSetSendEnabled }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetSendEnabledProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validateSendEnabledUpdate(p.SendEnabled, p.UseDefaultFor)
+}
+
+// String implements the Stringer interface.
+func (p SetSendEnabledProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Send Enabled Proposal:
+  Title:           %s
+  Description:     %s
+  Send Enabled:
+`, p.Title, p.Description))
+	for _, se := range p.SendEnabled {
+		b.WriteString(fmt.Sprintf("    %s\n", se))
+	}
+	b.WriteString(fmt.Sprintf("  Use Default For: %s\n", strings.Join(p.UseDefaultFor, ", ")))
+
+	return b.String()
+}
@@ -25,6 +25,27 @@ func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *Qu
 	return &QueryAllBalancesRequest{Address: addr.String(), Pagination: req}
 }
 
+// NewQuerySpendableBalancesRequest creates a new instance of
+// QuerySpendableBalancesRequest.
+//nolint:interfacer
+func NewQuerySpendableBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *QuerySpendableBalancesRequest {
+	return &QuerySpendableBalancesRequest{Address: addr.String(), Pagination: req}
+}
+
+// NewQuerySpendableBalanceByDenomRequest creates a new instance of
+// QuerySpendableBalanceByDenomRequest.
+//nolint:interfacer
+func NewQuerySpendableBalanceByDenomRequest(addr sdk.AccAddress, denom string) *QuerySpendableBalanceByDenomRequest {
+	return &QuerySpendableBalanceByDenomRequest{Address: addr.String(), Denom: denom}
+}
+
+// NewQueryLockedBalancesRequest creates a new instance of
+// QueryLockedBalancesRequest.
+//nolint:interfacer
+func NewQueryLockedBalancesRequest(addr sdk.AccAddress) *QueryLockedBalancesRequest {
+	return &QueryLockedBalancesRequest{Address: addr.String()}
+}
+
 // QueryTotalSupplyParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// BalanceChangesFromStateChanges decodes the changes of the account balances
+// from the changes of a transaction to the KV stores, as returned by the
+// simulation of the transaction. The balance changes are sorted by address and
+// denom.
+func BalanceChangesFromStateChanges(changes []*tx.StateChange) ([]*tx.BalanceChange, error) {
+	var (
+		balanceChanges []*tx.BalanceChange
+		addrs          []sdk.AccAddress
+	)
+
+	for _, change := range changes {
+		if change.StoreKey != StoreKey || !bytes.HasPrefix(change.Key, BalancesPrefix) {
+			continue
+		}
+
+		addr, denom, err := AddressAndDenomFromBalancesStore(change.Key[len(BalancesPrefix):])
+		if err != nil {
+			return nil, err
+		}
+
+		before, err := unmarshalBalance(change.PreviousValue)
+		if err != nil {
+			return nil, err
+		}
+		after, err := unmarshalBalance(change.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, addr)
+		balanceChanges = append(balanceChanges, &tx.BalanceChange{
+			Address: addr.String(),
+			Denom:   denom,
+			Before:  before,
+			After:   after,
+			Delta:   after.Sub(before),
+		})
+	}
+
+	sort.Sort(balanceChangesByAddress{balanceChanges, addrs})
+
+	return balanceChanges, nil
+}
+
+// unmarshalBalance decodes the amount of a balance, which is zero if the
+// balance doesn't exist.
+func unmarshalBalance(bz []byte) (sdk.Int, error) {
+	amount := sdk.ZeroInt()
+	if len(bz) == 0 {
+		return amount, nil
+	}
+
+	if err := amount.Unmarshal(bz); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return amount, nil
+}
+
+// balanceChangesByAddress sorts balance changes by address bytes and denom.
+type balanceChangesByAddress struct {
+	changes []*tx.BalanceChange
+	addrs   []sdk.AccAddress
+}
+
+func (b balanceChangesByAddress) Len() int { return len(b.changes) }
+func (b balanceChangesByAddress) Less(i, j int) bool {
+	if c := bytes.Compare(b.addrs[i], b.addrs[j]); c != 0 {
+		return c < 0
+	}
+	return b.changes[i].Denom < b.changes[j].Denom
+}
+func (b balanceChangesByAddress) Swap(i, j int) {
+	b.changes[i], b.changes[j] = b.changes[j], b.changes[i]
+	b.addrs[i], b.addrs[j] = b.addrs[j], b.addrs[i]
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBalanceChangesFromStateChanges(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	balanceKey := func(addr sdk.AccAddress, denom string) []byte {
+		return append(types.CreateAccountBalancesPrefix(addr), denom...)
+	}
+	amount := func(i int64) []byte {
+		bz, err := sdk.NewInt(i).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	changes := []*tx.StateChange{
+		{StoreKey: "acc", Key: []byte{0x01}, Value: []byte{0x01}},
+		{StoreKey: types.StoreKey, Key: balanceKey(addr2, "stake"), Value: amount(5)},
+		{StoreKey: types.StoreKey, Key: balanceKey(addr1, "stake"), PreviousValue: amount(10), Value: amount(7)},
+		{StoreKey: types.StoreKey, Key: balanceKey(addr1, "atom"), PreviousValue: amount(3), Delete: true},
+		{StoreKey: types.StoreKey, Key: types.CreateDenomAddressPrefix("stake"), Value: []byte{0}},
+	}
+
+	balanceChanges, err := types.BalanceChangesFromStateChanges(changes)
+	require.NoError(t, err)
+	require.Equal(t, []*tx.BalanceChange{
+		{Address: addr1.String(), Denom: "atom", Before: sdk.NewInt(3), After: sdk.ZeroInt(), Delta: sdk.NewInt(-3)},
+		{Address: addr1.String(), Denom: "stake", Before: sdk.NewInt(10), After: sdk.NewInt(7), Delta: sdk.NewInt(-3)},
+		{Address: addr2.String(), Denom: "stake", Before: sdk.ZeroInt(), After: sdk.NewInt(5), Delta: sdk.NewInt(5)},
+	}, balanceChanges)
+
+	_, err = types.BalanceChangesFromStateChanges([]*tx.StateChange{
+		{StoreKey: types.StoreKey, Key: balanceKey(addr1, "stake"), Value: []byte("invalid")},
+	})
+	require.Error(t, err)
+}
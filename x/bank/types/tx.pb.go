@@ -198,45 +198,142 @@ func (m *MsgMultiSendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMultiSendResponse proto.InternalMessageInfo
 
+// MsgSetSendEnabled is the Msg/SetSendEnabled request type.
+//
+// Only entries to add/update/delete need to be included.
+// Existing SendEnabled entries that are not included in this
+// message are left unchanged.
+type MsgSetSendEnabled struct {
+	// authority is the address allowed to set the send-enabled entries. It must
+	// be able to sign transactions, e.g. an x/group policy account, as governance
+	// sets the entries with a SetSendEnabledProposal.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// send_enabled is the list of entries to add or update.
+	SendEnabled []*SendEnabled `protobuf:"bytes,2,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// use_default_for is a list of denoms that should use the params.default_send_enabled value.
+	// Denoms listed here will have their SendEnabled entries deleted.
+	// If a denom is included that doesn't have a SendEnabled entry,
+	// it will be ignored.
+	UseDefaultFor []string `protobuf:"bytes,3,rep,name=use_default_for,json=useDefaultFor,proto3" json:"use_default_for,omitempty"`
+}
+
+func (m *MsgSetSendEnabled) Reset()         { *m = MsgSetSendEnabled{} }
+func (m *MsgSetSendEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendEnabled) ProtoMessage()    {}
+func (*MsgSetSendEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{4}
+}
+func (m *MsgSetSendEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendEnabled.Merge(m, src)
+}
+func (m *MsgSetSendEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendEnabled proto.InternalMessageInfo
+
+// MsgSetSendEnabledResponse defines the Msg/SetSendEnabled response type.
+type MsgSetSendEnabledResponse struct {
+}
+
+func (m *MsgSetSendEnabledResponse) Reset()         { *m = MsgSetSendEnabledResponse{} }
+func (m *MsgSetSendEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendEnabledResponse) ProtoMessage()    {}
+func (*MsgSetSendEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{5}
+}
+func (m *MsgSetSendEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetSendEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
 	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.v1beta1.MsgMultiSend")
 	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
+	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
+	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcb, 0xae, 0xd2, 0x40,
-	0x1c, 0xc6, 0x3b, 0x07, 0xc2, 0x91, 0x39, 0x27, 0x31, 0x56, 0x62, 0x38, 0xf5, 0xa4, 0x45, 0xe2,
-	0x02, 0x16, 0x4c, 0x05, 0x17, 0x1a, 0x58, 0x59, 0x56, 0x9a, 0x34, 0x26, 0x65, 0xa5, 0x1b, 0xd2,
-	0xcb, 0x58, 0x1b, 0xec, 0x4c, 0xd3, 0x99, 0x1a, 0x7c, 0x03, 0x13, 0x37, 0x3e, 0x02, 0x6b, 0xe3,
-	0xd2, 0x87, 0x60, 0x49, 0x5c, 0xb9, 0x52, 0x03, 0x1b, 0x57, 0x3e, 0x83, 0xe9, 0x74, 0x5a, 0x49,
-	0x44, 0x58, 0xf5, 0xf2, 0x7d, 0xbf, 0x6f, 0xfe, 0x97, 0x81, 0xd7, 0x3e, 0x65, 0x31, 0x65, 0xa6,
-	0xe7, 0x92, 0x85, 0xf9, 0x76, 0xe8, 0x61, 0xee, 0x0e, 0x4d, 0xbe, 0x44, 0x49, 0x4a, 0x39, 0x55,
-	0x6f, 0x17, 0x2a, 0xca, 0x55, 0x24, 0x55, 0xad, 0x15, 0xd2, 0x90, 0x0a, 0xdd, 0xcc, 0xdf, 0x0a,
-	0xab, 0xa6, 0x57, 0x41, 0x0c, 0x57, 0x41, 0x3e, 0x8d, 0xc8, 0x3f, 0xfa, 0xde, 0x41, 0x22, 0xb7,
-	0xd0, 0xaf, 0x0a, 0x7d, 0x5e, 0x04, 0xcb, 0x73, 0xc5, 0x47, 0xf7, 0x37, 0x80, 0xe7, 0x36, 0x0b,
-	0x67, 0x98, 0x04, 0xea, 0x04, 0x5e, 0xbe, 0x4a, 0x69, 0x3c, 0x77, 0x83, 0x20, 0xc5, 0x8c, 0xb5,
-	0x41, 0x07, 0xf4, 0x9a, 0x56, 0xfb, 0xeb, 0x97, 0x41, 0x4b, 0x32, 0x4f, 0x0a, 0x65, 0xc6, 0xd3,
-	0x88, 0x84, 0xce, 0x45, 0xee, 0x96, 0xbf, 0xd4, 0x47, 0x10, 0x72, 0x5a, 0xa1, 0x67, 0x27, 0xd0,
-	0x26, 0xa7, 0x25, 0xe8, 0xc3, 0x86, 0x1b, 0xd3, 0x8c, 0xf0, 0x76, 0xad, 0x53, 0xeb, 0x5d, 0x8c,
-	0xae, 0x50, 0x35, 0x18, 0x86, 0xcb, 0xc1, 0xa0, 0x29, 0x8d, 0x88, 0xf5, 0x60, 0xfd, 0xdd, 0x50,
-	0x3e, 0xfd, 0x30, 0x7a, 0x61, 0xc4, 0x5f, 0x67, 0x1e, 0xf2, 0x69, 0x2c, 0xbb, 0x91, 0x8f, 0x01,
-	0x0b, 0x16, 0x26, 0x7f, 0x97, 0x60, 0x26, 0x00, 0xe6, 0xc8, 0xe8, 0xf1, 0x8d, 0xf7, 0x2b, 0x43,
-	0xf9, 0xb5, 0x32, 0x94, 0xee, 0x2d, 0x78, 0x53, 0xf6, 0xeb, 0x60, 0x96, 0x50, 0xc2, 0x70, 0xf7,
-	0x03, 0x80, 0x97, 0x36, 0x0b, 0xed, 0xec, 0x0d, 0x8f, 0xc4, 0x20, 0x1e, 0xc3, 0x46, 0x44, 0x92,
-	0x8c, 0xe7, 0x23, 0xc8, 0x4b, 0xd2, 0xd0, 0x81, 0x5d, 0xa1, 0xa7, 0xb9, 0xc5, 0xaa, 0xe7, 0x35,
-	0x39, 0xd2, 0xaf, 0x4e, 0xe0, 0x39, 0xcd, 0xb8, 0x40, 0xcf, 0x04, 0x7a, 0xf7, 0x20, 0xfa, 0x5c,
-	0x78, 0x24, 0x5b, 0x12, 0xe3, 0xba, 0x28, 0xf0, 0x0e, 0x6c, 0xed, 0x17, 0x53, 0x56, 0x39, 0xfa,
-	0x0c, 0x60, 0xcd, 0x66, 0xa1, 0xfa, 0x0c, 0xd6, 0x45, 0x91, 0xd7, 0x07, 0x93, 0x65, 0x6f, 0xda,
-	0xfd, 0x63, 0x6a, 0x99, 0xa9, 0xbe, 0x80, 0xcd, 0xbf, 0x5d, 0xdf, 0xfb, 0x1f, 0x52, 0x59, 0xb4,
-	0xfe, 0x49, 0x4b, 0x19, 0x6d, 0x4d, 0xd7, 0x5b, 0x1d, 0x6c, 0xb6, 0x3a, 0xf8, 0xb9, 0xd5, 0xc1,
-	0xc7, 0x9d, 0xae, 0x6c, 0x76, 0xba, 0xf2, 0x6d, 0xa7, 0x2b, 0x2f, 0xfb, 0x47, 0xb7, 0xb7, 0x2c,
-	0x6e, 0xb1, 0x58, 0xa2, 0xd7, 0x10, 0x97, 0xf4, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8,
-	0x6d, 0x23, 0xe3, 0x4a, 0x03, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x93, 0x28, 0x25, 0x2f, 0x81, 0xaa, 0x26, 0x42, 0x89, 0x5b, 0x39, 0x21, 0x42, 0x55,
+	0x3a, 0xd4, 0xa1, 0x45, 0x02, 0xd4, 0x4e, 0x24, 0x80, 0x04, 0x52, 0x84, 0xe4, 0x4e, 0xb0, 0x58,
+	0x76, 0x7c, 0x71, 0xac, 0x26, 0x77, 0x91, 0xef, 0x0e, 0xb5, 0xdf, 0x00, 0x89, 0x85, 0x89, 0xb9,
+	0x33, 0x33, 0x9f, 0x80, 0xa9, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x59, 0x98, 0xf8, 0x0c, 0xc8, 0xe7,
+	0xb3, 0x63, 0xd1, 0xb4, 0xe9, 0x64, 0xfb, 0x7e, 0x7f, 0xde, 0xef, 0xbd, 0xa7, 0x33, 0x6c, 0x0d,
+	0x08, 0x9d, 0x10, 0xda, 0x71, 0x1d, 0x7c, 0xdc, 0x79, 0xbf, 0xe7, 0x22, 0xe6, 0xec, 0x75, 0xd8,
+	0x89, 0x39, 0x0d, 0x09, 0x23, 0xda, 0xdd, 0x18, 0x35, 0x23, 0xd4, 0x94, 0xa8, 0x5e, 0xf5, 0x89,
+	0x4f, 0x04, 0xde, 0x89, 0xde, 0x62, 0xaa, 0x6e, 0xa4, 0x46, 0x14, 0xa5, 0x46, 0x03, 0x12, 0xe0,
+	0x4b, 0x78, 0xa6, 0x90, 0xf0, 0x8d, 0xf1, 0x7a, 0x8c, 0xdb, 0xb1, 0xb1, 0xac, 0x2b, 0x3e, 0x5a,
+	0x7f, 0x55, 0x58, 0xeb, 0x53, 0xff, 0x08, 0x61, 0x4f, 0x3b, 0x84, 0xca, 0x30, 0x24, 0x13, 0xdb,
+	0xf1, 0xbc, 0x10, 0x51, 0x5a, 0x53, 0x9b, 0x6a, 0xbb, 0xd4, 0xad, 0x7d, 0xff, 0xba, 0x5b, 0x95,
+	0x9a, 0x67, 0x31, 0x72, 0xc4, 0xc2, 0x00, 0xfb, 0x56, 0x39, 0x62, 0xcb, 0x23, 0xed, 0x09, 0x00,
+	0x23, 0xa9, 0x34, 0xb7, 0x42, 0x5a, 0x62, 0x24, 0x11, 0x0e, 0xa0, 0xe8, 0x4c, 0x08, 0xc7, 0xac,
+	0x96, 0x6f, 0xe6, 0xdb, 0xe5, 0xfd, 0xba, 0x99, 0x0e, 0x86, 0xa2, 0x64, 0x30, 0x66, 0x8f, 0x04,
+	0xb8, 0xfb, 0xf0, 0xfc, 0x67, 0x43, 0xf9, 0xf2, 0xab, 0xd1, 0xf6, 0x03, 0x36, 0xe2, 0xae, 0x39,
+	0x20, 0x13, 0xd9, 0x8d, 0x7c, 0xec, 0x52, 0xef, 0xb8, 0xc3, 0x4e, 0xa7, 0x88, 0x0a, 0x01, 0xb5,
+	0xa4, 0xf5, 0xc1, 0xad, 0x0f, 0x67, 0x0d, 0xe5, 0xcf, 0x59, 0x43, 0x69, 0x6d, 0xc0, 0xba, 0xec,
+	0xd7, 0x42, 0x74, 0x4a, 0x30, 0x45, 0xad, 0x8f, 0x2a, 0x54, 0xfa, 0xd4, 0xef, 0xf3, 0x31, 0x0b,
+	0xc4, 0x20, 0x9e, 0x42, 0x31, 0xc0, 0x53, 0xce, 0xa2, 0x11, 0x44, 0x91, 0x74, 0x73, 0xc9, 0xae,
+	0xcc, 0x57, 0x11, 0xa5, 0x5b, 0x88, 0x32, 0x59, 0x92, 0xaf, 0x1d, 0xc2, 0x1a, 0xe1, 0x4c, 0x48,
+	0x73, 0x42, 0xba, 0xb9, 0x54, 0xfa, 0x86, 0xb3, 0x85, 0x36, 0x51, 0x1c, 0x14, 0x44, 0xc0, 0x7b,
+	0x50, 0xcd, 0x86, 0x49, 0x53, 0x7e, 0x53, 0x61, 0x43, 0x24, 0x67, 0xd1, 0xf1, 0x0b, 0xec, 0xb8,
+	0x63, 0xe4, 0x69, 0x8f, 0xa1, 0xe4, 0x70, 0x36, 0x22, 0x61, 0xc0, 0x4e, 0x57, 0x2e, 0x6c, 0x41,
+	0xd5, 0x7a, 0x50, 0xa1, 0x08, 0x7b, 0x36, 0x8a, 0x7d, 0x64, 0xda, 0xe6, 0xd2, 0xb4, 0x99, 0x7a,
+	0x56, 0x99, 0x66, 0x8a, 0x6f, 0xc3, 0x3a, 0xa7, 0xc8, 0xf6, 0xd0, 0xd0, 0xe1, 0x63, 0x66, 0x0f,
+	0x49, 0x28, 0x76, 0x58, 0xb2, 0x6e, 0x73, 0x8a, 0x9e, 0xc7, 0xa7, 0x2f, 0x49, 0x98, 0x99, 0xfe,
+	0x26, 0xd4, 0x2f, 0xf5, 0x90, 0x74, 0xb8, 0xff, 0x39, 0x07, 0xf9, 0x3e, 0xf5, 0xb5, 0xd7, 0x50,
+	0x10, 0x6b, 0xd8, 0x5a, 0x9a, 0x46, 0x6e, 0x4f, 0x7f, 0x70, 0x1d, 0x9a, 0x78, 0x6a, 0x6f, 0xa1,
+	0xb4, 0xd8, 0xeb, 0xfd, 0xab, 0x24, 0x29, 0x45, 0xdf, 0x59, 0x49, 0x49, 0xad, 0x47, 0x70, 0xe7,
+	0xbf, 0x65, 0x6c, 0x5f, 0x1d, 0x29, 0xcb, 0xd3, 0xcd, 0x9b, 0xf1, 0x92, 0x4a, 0xdd, 0xde, 0xf9,
+	0xcc, 0x50, 0x2f, 0x66, 0x86, 0xfa, 0x7b, 0x66, 0xa8, 0x9f, 0xe6, 0x86, 0x72, 0x31, 0x37, 0x94,
+	0x1f, 0x73, 0x43, 0x79, 0xb7, 0x73, 0xed, 0x4d, 0x38, 0x89, 0xff, 0x08, 0xe2, 0x42, 0xb8, 0x45,
+	0x71, 0xe1, 0x1f, 0xfd, 0x1b, 0x00, 0x72, 0x05, 0x8d, 0x15, 0x96, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -255,6 +352,11 @@ type MsgClient interface {
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
 	// MultiSend defines a method for sending coins from some accounts to other accounts.
 	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+	// SetSendEnabled is a governance operation for setting the SendEnabled flag
+	// on any number of Denoms. Only the entries to add or update should be
+	// included. Entries that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -283,12 +385,26 @@ func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error) {
+	out := new(MsgSetSendEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSendEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
 	// MultiSend defines a method for sending coins from some accounts to other accounts.
 	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// SetSendEnabled is a governance operation for setting the SendEnabled flag
+	// on any number of Denoms. Only the entries to add or update should be
+	// included. Entries that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -301,6 +417,9 @@ func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSend
 func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
 }
+func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -342,6 +461,24 @@ func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSendEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendEnabled(ctx, req.(*MsgSetSendEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -354,6 +491,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MultiSend",
 			Handler:    _Msg_MultiSend_Handler,
 		},
+		{
+			MethodName: "SetSendEnabled",
+			Handler:    _Msg_SetSendEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -507,6 +648,82 @@ func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -580,6 +797,40 @@ func (m *MsgMultiSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSendEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -952,6 +1203,204 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
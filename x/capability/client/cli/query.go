@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the cli query commands for the capability module.
+func GetQueryCmd() *cobra.Command {
+	capabilityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	capabilityQueryCmd.AddCommand(
+		GetCmdQueryCapability(),
+		GetCmdQueryCapabilitiesByModule(),
+		GetCmdQueryOwners(),
+		GetCmdCheckConsistency(),
+	)
+
+	return capabilityQueryCmd
+}
+
+// GetCmdQueryCapability implements a command to return the owners of a
+// capability by its index.
+func GetCmdQueryCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capability [index]",
+		Short: "Query the owners of a capability by its index",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s capability 1`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("index %s not a valid uint, please input a valid index", args[0])
+			}
+
+			res, err := queryClient.Capability(cmd.Context(), &types.QueryCapabilityRequest{Index: index})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCapabilitiesByModule implements a command to return the
+// capabilities owned by a module.
+func GetCmdQueryCapabilitiesByModule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities-by-module [module]",
+		Short: "Query the capabilities owned by a module",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s capabilities-by-module transfer`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CapabilitiesByModule(cmd.Context(), &types.QueryCapabilitiesByModuleRequest{
+				Module:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "capabilities-by-module")
+
+	return cmd
+}
+
+// GetCmdQueryOwners implements a command to return the owners of the
+// capability a module owns under a given name.
+func GetCmdQueryOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owners [module] [name]",
+		Short: "Query the owners of the capability a module owns under a given name",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s owners transfer ports/transfer`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Owners(cmd.Context(), &types.QueryOwnersRequest{Module: args[0], Name: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCheckConsistency implements a command to check that the in-memory
+// store of the node matches the persistent capability index.
+func GetCmdCheckConsistency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-consistency",
+		Short: "Check that the in-memory store matches the persistent capability index",
+		Long: strings.TrimSpace(`Check that the forward and reverse mappings of the node's in-memory store
+match the persisted capability owners, and report the orphaned capabilities
+which have no owners left. The check is only meaningful at the latest height.
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Consistency(cmd.Context(), &types.QueryConsistencyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Capability returns the owners of a capability by its index.
+func (k Keeper) Capability(c context.Context, req *types.QueryCapabilityRequest) (*types.QueryCapabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owners, found := k.GetOwners(ctx, req.Index)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "capability %d not found", req.Index)
+	}
+
+	return &types.QueryCapabilityResponse{Owners: owners}, nil
+}
+
+// CapabilitiesByModule returns the capabilities owned by a module, with the
+// names the module owns them under.
+func (k Keeper) CapabilitiesByModule(c context.Context, req *types.QueryCapabilitiesByModuleRequest) (*types.QueryCapabilitiesByModuleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.ModuleCapability
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var owners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &owners); err != nil {
+			return false, err
+		}
+
+		index := types.IndexFromKey(key)
+		matched := false
+		for _, owner := range owners.Owners {
+			if owner.Module != req.Module {
+				continue
+			}
+
+			matched = true
+			if accumulate {
+				capabilities = append(capabilities, types.ModuleCapability{Index: index, Name: owner.Name})
+			}
+		}
+
+		return matched, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCapabilitiesByModuleResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
+
+// Owners returns the index and the owners of the capability a module owns
+// under a given name.
+func (k Keeper) Owners(c context.Context, req *types.QueryOwnersRequest) (*types.QueryOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "capability name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	index, owners, found := k.GetCapabilityByOwner(ctx, types.NewOwner(req.Module, req.Name))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "capability %s not found", types.NewOwner(req.Module, req.Name).Key())
+	}
+
+	return &types.QueryOwnersResponse{Index: index, Owners: owners}, nil
+}
+
+// Consistency checks the in-memory store against the persistent capability
+// index.
+func (k Keeper) Consistency(c context.Context, req *types.QueryConsistencyRequest) (*types.QueryConsistencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inconsistencies, orphans := k.CheckMemStoreConsistency(ctx)
+
+	return &types.QueryConsistencyResponse{Inconsistencies: inconsistencies, OrphanedIndexes: orphans}, nil
+}
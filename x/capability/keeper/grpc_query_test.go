@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	bankSK := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	stakingSK := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap1, err := bankSK.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	cap2, err := bankSK.NewCapability(suite.ctx, "ports/transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(stakingSK.ClaimCapability(suite.ctx, cap1, "bond"))
+
+	// Capability
+	_, err = queryClient.Capability(ctx, &types.QueryCapabilityRequest{Index: cap2.GetIndex() + 1})
+	suite.Require().Error(err)
+
+	capRes, err := queryClient.Capability(ctx, &types.QueryCapabilityRequest{Index: cap1.GetIndex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Owner{
+		types.NewOwner(banktypes.ModuleName, "transfer"),
+		types.NewOwner(stakingtypes.ModuleName, "bond"),
+	}, capRes.Owners.Owners)
+
+	// CapabilitiesByModule
+	_, err = queryClient.CapabilitiesByModule(ctx, &types.QueryCapabilitiesByModuleRequest{})
+	suite.Require().Error(err)
+
+	modRes, err := queryClient.CapabilitiesByModule(ctx, &types.QueryCapabilitiesByModuleRequest{Module: banktypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Index: cap1.GetIndex(), Name: "transfer"},
+		{Index: cap2.GetIndex(), Name: "ports/transfer"},
+	}, modRes.Capabilities)
+
+	modRes, err = queryClient.CapabilitiesByModule(ctx, &types.QueryCapabilitiesByModuleRequest{
+		Module:     banktypes.ModuleName,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(modRes.Capabilities, 1)
+	suite.Require().Equal(uint64(2), modRes.Pagination.Total)
+
+	modRes, err = queryClient.CapabilitiesByModule(ctx, &types.QueryCapabilitiesByModuleRequest{Module: stakingtypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{{Index: cap1.GetIndex(), Name: "bond"}}, modRes.Capabilities)
+
+	// Owners
+	_, err = queryClient.Owners(ctx, &types.QueryOwnersRequest{Module: banktypes.ModuleName})
+	suite.Require().Error(err)
+	_, err = queryClient.Owners(ctx, &types.QueryOwnersRequest{Module: stakingtypes.ModuleName, Name: "transfer"})
+	suite.Require().Error(err)
+
+	ownersRes, err := queryClient.Owners(ctx, &types.QueryOwnersRequest{Module: stakingtypes.ModuleName, Name: "bond"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(cap1.GetIndex(), ownersRes.Index)
+	suite.Require().Equal(capRes.Owners, ownersRes.Owners)
+}
+
+func (suite *KeeperTestSuite) TestCheckMemStoreConsistency() {
+	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+
+	cap1, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	cap2, err := sk.NewCapability(suite.ctx, "ports/transfer")
+	suite.Require().NoError(err)
+
+	inconsistencies, orphans := suite.keeper.CheckMemStoreConsistency(suite.ctx)
+	suite.Require().Empty(inconsistencies)
+	suite.Require().Empty(orphans)
+
+	// an owner set emptied outside of the scoped keeper leaves an orphan and
+	// stale mappings
+	suite.keeper.SetOwners(suite.ctx, cap2.GetIndex(), types.CapabilityOwners{})
+	// a persisted owner without mappings in the memory store
+	suite.keeper.SetOwners(suite.ctx, cap1.GetIndex(), types.CapabilityOwners{Owners: []types.Owner{
+		types.NewOwner(banktypes.ModuleName, "transfer"),
+		types.NewOwner(stakingtypes.ModuleName, "bond"),
+	}})
+
+	inconsistencies, orphans = suite.keeper.CheckMemStoreConsistency(suite.ctx)
+	suite.Require().Equal([]uint64{cap2.GetIndex()}, orphans)
+	suite.Require().Equal([]string{
+		"forward mapping for bank/ports/transfer has no persisted owner",
+		fmt.Sprintf("missing reverse mapping for staking/bond owning index %d", cap1.GetIndex()),
+		"reverse mapping for bank/ports/transfer has no persisted owner",
+	}, inconsistencies)
+}
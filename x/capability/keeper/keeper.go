@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -193,6 +194,134 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
+// IterateCapabilities iterates over the capability owners in the persistent
+// store by index and performs a callback function. The iteration stops when
+// the callback returns true.
+func (k Keeper) IterateCapabilities(ctx sdk.Context, cb func(index uint64, owners types.CapabilityOwners) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var owners types.CapabilityOwners
+		k.cdc.MustUnmarshal(iterator.Value(), &owners)
+
+		if cb(types.IndexFromKey(iterator.Key()), owners) {
+			break
+		}
+	}
+}
+
+// GetCapabilityByOwner returns the index and the owners of the capability a
+// module owns under a given name. The lookup uses the persistent store only.
+func (k Keeper) GetCapabilityByOwner(ctx sdk.Context, owner types.Owner) (uint64, types.CapabilityOwners, bool) {
+	var (
+		index  uint64
+		owners types.CapabilityOwners
+		found  bool
+	)
+
+	k.IterateCapabilities(ctx, func(i uint64, capOwners types.CapabilityOwners) bool {
+		if _, ok := capOwners.Get(owner); ok {
+			index, owners, found = i, capOwners, true
+		}
+		return found
+	})
+
+	return index, owners, found
+}
+
+// CheckMemStoreConsistency verifies that the forward and reverse mappings of
+// the in-memory store match the capability owners of the persistent store. It
+// returns a description of each inconsistency found, and the indexes of the
+// persisted capabilities which have no owners left.
+//
+// NOTE: the in-memory store is not versioned, so the check is only meaningful
+// against the latest persistent state.
+func (k Keeper) CheckMemStoreConsistency(ctx sdk.Context) (inconsistencies []string, orphans []uint64) {
+	memStore := ctx.KVStore(k.memKey)
+
+	if memStore.Get(types.KeyMemInitialized) == nil {
+		inconsistencies = append(inconsistencies, "memory store is not initialized")
+	}
+
+	// every persisted owner must have a reverse mapping to its index
+	ownerIndexes := make(map[string]uint64)
+	k.IterateCapabilities(ctx, func(index uint64, owners types.CapabilityOwners) bool {
+		if len(owners.Owners) == 0 {
+			orphans = append(orphans, index)
+		}
+
+		for _, owner := range owners.Owners {
+			ownerIndexes[owner.Key()] = index
+
+			bz := memStore.Get(types.RevCapabilityKey(owner.Module, owner.Name))
+			switch {
+			case bz == nil:
+				inconsistencies = append(inconsistencies, fmt.Sprintf("missing reverse mapping for %s owning index %d", owner.Key(), index))
+			case sdk.BigEndianToUint64(bz) != index:
+				inconsistencies = append(inconsistencies, fmt.Sprintf("reverse mapping for %s points to index %d instead of %d", owner.Key(), sdk.BigEndianToUint64(bz), index))
+			}
+		}
+		return false
+	})
+
+	// every mapping of the memory store must belong to a persisted owner
+	iterator := memStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fwdMappings := make(map[string]int)
+	for ; iterator.Valid(); iterator.Next() {
+		key := string(iterator.Key())
+		if key == string(types.KeyMemInitialized) {
+			continue
+		}
+
+		module, kind, name, ok := splitMemStoreKey(key)
+		switch {
+		case !ok:
+			inconsistencies = append(inconsistencies, fmt.Sprintf("unknown memory store key %q", key))
+		case kind == "rev":
+			owner := types.NewOwner(module, name)
+			if _, ok := ownerIndexes[owner.Key()]; !ok {
+				inconsistencies = append(inconsistencies, fmt.Sprintf("reverse mapping for %s has no persisted owner", owner.Key()))
+			}
+		case kind == "fwd":
+			owner := types.NewOwner(module, string(iterator.Value()))
+			if _, ok := ownerIndexes[owner.Key()]; !ok {
+				inconsistencies = append(inconsistencies, fmt.Sprintf("forward mapping for %s has no persisted owner", owner.Key()))
+			}
+			fwdMappings[owner.Key()]++
+		}
+	}
+
+	for key, count := range fwdMappings {
+		if count > 1 {
+			inconsistencies = append(inconsistencies, fmt.Sprintf("%d forward mappings for owner %s", count, key))
+		}
+	}
+	sort.Strings(inconsistencies)
+
+	return inconsistencies, orphans
+}
+
+// splitMemStoreKey splits a forward or reverse mapping key of the memory store
+// into the module name, the mapping kind and the remainder of the key, which is
+// the capability name of a reverse mapping.
+func splitMemStoreKey(key string) (module, kind, rest string, ok bool) {
+	i := strings.Index(key, "/")
+	if i <= 0 || len(key) < i+5 {
+		return "", "", "", false
+	}
+
+	kind = key[i+1 : i+4]
+	if key[i+4] != '/' || (kind != "rev" && kind != "fwd") {
+		return "", "", "", false
+	}
+
+	return key[:i], kind, key[i+5:], true
+}
+
 // InitializeCapability takes in an index and an owners array. It creates the capability in memory
 // and sets the fwd and reverse keys for each owner in the memstore.
 // It is used during initialization from genesis.
@@ -9,13 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// check all registered invariants
+// check the registered invariants which are due at the current height
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
-		// skip running the invariant check
-		return
-	}
-	k.AssertInvariants(ctx)
+	k.CheckInvariants(ctx)
 }
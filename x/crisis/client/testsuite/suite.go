@@ -5,12 +5,14 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 type IntegrationTestSuite struct {
@@ -105,3 +107,24 @@ func (s *IntegrationTestSuite) TestNewMsgVerifyInvariantTxCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryInvariants() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryInvariants(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res types.QueryInvariantsResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().NotEmpty(res.Invariants)
+
+	var found bool
+	for _, invariant := range res.Invariants {
+		if invariant.ModuleName == "bank" && invariant.Route == "total-supply" {
+			found = true
+			s.Require().False(invariant.Broken)
+		}
+	}
+	s.Require().True(found)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import "sync"
+
+// checkResult is the result of the last check of an invariant.
+type checkResult struct {
+	height  int64
+	broken  bool
+	message string
+}
+
+// checkResults holds the result of the last check of each invariant route,
+// keyed by full route. Like the check schedule, invariant checks are local to
+// a node, so their results are kept in memory rather than in state.
+type checkResults struct {
+	mtx     sync.RWMutex
+	results map[string]checkResult
+}
+
+func newCheckResults() *checkResults {
+	return &checkResults{results: make(map[string]checkResult)}
+}
+
+func (c *checkResults) set(fullRoute string, res checkResult) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.results[fullRoute] = res
+}
+
+func (c *checkResults) get(fullRoute string) (checkResult, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	res, ok := c.results[fullRoute]
+	return res, ok
+}
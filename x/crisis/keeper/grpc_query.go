@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+// It holds a pointer to the keeper so that invariants registered after the query service see the same routes.
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Invariants implements the Query/Invariants gRPC method
+func (k Querier) Invariants(_ context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	invariants := make([]types.RegisteredInvariant, 0, len(k.routes))
+	for _, ir := range k.Routes() {
+		invariant := types.RegisteredInvariant{
+			ModuleName:  ir.ModuleName,
+			Route:       ir.Route,
+			CheckPeriod: uint64(k.CheckPeriod(ir)),
+		}
+
+		if res, ok := k.lastChecks.get(ir.FullRoute()); ok {
+			invariant.LastCheckHeight = res.height
+			invariant.Broken = res.broken
+			invariant.Message = res.message
+		}
+
+		invariants = append(invariants, invariant)
+	}
+
+	return &types.QueryInvariantsResponse{Invariants: invariants}, nil
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestGRPCQueryInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "broken", func(sdk.Context) (string, bool) { return "broken invariant", true })
+	app.CrisisKeeper.SetCheckPeriod("testModule", "broken", 1)
+	app.CrisisKeeper.SetCheckMode(types.CheckModeAlert)
+
+	ctx := app.NewContext(true, tmproto.Header{Height: 7})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: &app.CrisisKeeper})
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.Invariants(gocontext.Background(), &types.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Invariants, len(app.CrisisKeeper.Routes()))
+
+	last := res.Invariants[len(res.Invariants)-1]
+	require.Equal(t, types.RegisteredInvariant{ModuleName: "testModule", Route: "broken", CheckPeriod: 1}, last)
+	require.Equal(t, uint64(app.CrisisKeeper.InvCheckPeriod()), res.Invariants[0].CheckPeriod)
+
+	app.CrisisKeeper.CheckInvariants(ctx)
+
+	res, err = queryClient.Invariants(gocontext.Background(), &types.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	last = res.Invariants[len(res.Invariants)-1]
+	require.Equal(t, types.RegisteredInvariant{
+		ModuleName:      "testModule",
+		Route:           "broken",
+		CheckPeriod:     1,
+		LastCheckHeight: 7,
+		Broken:          true,
+		Message:         "broken invariant",
+	}, last)
+}
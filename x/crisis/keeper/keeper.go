@@ -17,6 +17,12 @@ type Keeper struct {
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
+	// checkPeriods overrides invCheckPeriod for individual invariant routes,
+	// keyed by their full route
+	checkPeriods map[string]uint
+	checkMode    types.CheckMode
+	lastChecks   *checkResults
+
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
@@ -37,6 +43,9 @@ func NewKeeper(
 		routes:           make([]types.InvarRoute, 0),
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
+		checkPeriods:     make(map[string]uint),
+		checkMode:        types.CheckModeHalt,
+		lastChecks:       newCheckResults(),
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
 	}
@@ -67,23 +76,89 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
+// SetCheckPeriod overrides the number of blocks between two checks of the
+// invariant registered under the given module name and route at the end of a
+// block. A zero period disables the end of block checks of the invariant.
+func (k *Keeper) SetCheckPeriod(moduleName, route string, period uint) {
+	k.checkPeriods[types.NewInvarRoute(moduleName, route, nil).FullRoute()] = period
+}
+
+// CheckPeriod returns the number of blocks between two checks of the given
+// invariant route at the end of a block.
+func (k Keeper) CheckPeriod(ir types.InvarRoute) uint {
+	if period, ok := k.checkPeriods[ir.FullRoute()]; ok {
+		return period
+	}
+
+	return k.invCheckPeriod
+}
+
+// SetCheckMode sets how the keeper reacts to an invariant found broken by
+// CheckInvariants.
+func (k *Keeper) SetCheckMode(mode types.CheckMode) {
+	k.checkMode = mode
+}
+
+// CheckMode returns how the keeper reacts to an invariant found broken by
+// CheckInvariants.
+func (k Keeper) CheckMode() types.CheckMode { return k.checkMode }
+
 // AssertInvariants asserts all registered invariants. If any invariant fails,
 // the method panics.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
+	k.assertInvariants(ctx, k.Routes(), types.CheckModeHalt)
+}
+
+// CheckInvariants asserts the registered invariants which are due at the
+// current block height according to their check period. If any invariant
+// fails, the method either panics or logs an error and emits an event,
+// depending on the keeper's check mode.
+func (k Keeper) CheckInvariants(ctx sdk.Context) {
+	var invarRoutes []types.InvarRoute
+	for _, ir := range k.Routes() {
+		period := k.CheckPeriod(ir)
+		if period != 0 && ctx.BlockHeight()%int64(period) == 0 {
+			invarRoutes = append(invarRoutes, ir)
+		}
+	}
+
+	if len(invarRoutes) == 0 {
+		return
+	}
+
+	k.assertInvariants(ctx, invarRoutes, k.checkMode)
+}
+
+func (k Keeper) assertInvariants(ctx sdk.Context, invarRoutes []types.InvarRoute, mode types.CheckMode) {
 	logger := k.Logger(ctx)
 
 	start := time.Now()
-	invarRoutes := k.Routes()
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i, "/", n), "name", ir.FullRoute())
-		if res, stop := ir.Invar(ctx); stop {
+		res, stop := ir.Invar(ctx)
+		k.lastChecks.set(ir.FullRoute(), checkResult{height: ctx.BlockHeight(), broken: stop, message: res})
+		if !stop {
+			continue
+		}
+
+		if mode == types.CheckModeHalt {
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
 				"\tCRITICAL please submit the following transaction:\n"+
 				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
 		}
+
+		logger.Error("invariant broken", "name", ir.FullRoute(), "height", ctx.BlockHeight(), "msg", res)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInvariantBroken,
+				sdk.NewAttribute(sdk.AttributeKeyModule, ir.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyRoute, ir.Route),
+				sdk.NewAttribute(types.AttributeKeyMessage, res),
+			),
+		)
 	}
 
 	diff := time.Since(start)
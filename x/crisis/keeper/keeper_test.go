@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +45,63 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	var cheapChecks, expensiveChecks int
+	app.CrisisKeeper.RegisterRoute("testModule", "cheap", func(sdk.Context) (string, bool) {
+		cheapChecks++
+		return "", false
+	})
+	app.CrisisKeeper.RegisterRoute("testModule", "expensive", func(sdk.Context) (string, bool) {
+		expensiveChecks++
+		return "", false
+	})
+	app.CrisisKeeper.SetCheckPeriod("testModule", "cheap", 1)
+	app.CrisisKeeper.SetCheckPeriod("testModule", "expensive", 10)
+
+	for height := int64(1); height <= 20; height++ {
+		ctx := app.NewContext(true, tmproto.Header{Height: height})
+		require.NotPanics(t, func() { app.CrisisKeeper.CheckInvariants(ctx) })
+	}
+	require.Equal(t, 20, cheapChecks)
+	require.Equal(t, 2, expensiveChecks)
+
+	// a zero period disables the invariant
+	app.CrisisKeeper.SetCheckPeriod("testModule", "expensive", 0)
+	app.CrisisKeeper.CheckInvariants(app.NewContext(true, tmproto.Header{Height: 30}))
+	require.Equal(t, 21, cheapChecks)
+	require.Equal(t, 2, expensiveChecks)
+}
+
+func TestCheckInvariantsAlertMode(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "broken", func(sdk.Context) (string, bool) { return "broken invariant", true })
+	app.CrisisKeeper.SetCheckPeriod("testModule", "broken", 1)
+
+	ctx := app.NewContext(true, tmproto.Header{Height: 3})
+	require.Equal(t, types.CheckModeHalt, app.CrisisKeeper.CheckMode())
+	require.Panics(t, func() { app.CrisisKeeper.CheckInvariants(ctx) })
+
+	app.CrisisKeeper.SetCheckMode(types.CheckModeAlert)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { app.CrisisKeeper.CheckInvariants(ctx) })
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeInvariantBroken, events[0].Type)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: []byte(sdk.AttributeKeyModule), Value: []byte("testModule")},
+		{Key: []byte(types.AttributeKeyRoute), Value: []byte("broken")},
+		{Key: []byte(types.AttributeKeyMessage), Value: []byte("broken invariant")},
+	}, events[0].Attributes)
+
+	// AssertInvariants always halts
+	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+}
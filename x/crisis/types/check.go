@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CheckMode defines how the crisis module reacts to an invariant found broken
+// while checking invariants at the end of a block.
+type CheckMode int
+
+const (
+	// CheckModeHalt halts the chain by panicking when an invariant is broken.
+	CheckModeHalt CheckMode = iota
+	// CheckModeAlert logs an error and emits an event when an invariant is
+	// broken, without halting the chain.
+	CheckModeAlert
+)
+
+// String implements the Stringer interface.
+func (m CheckMode) String() string {
+	switch m {
+	case CheckModeHalt:
+		return "halt"
+	case CheckModeAlert:
+		return "alert"
+	default:
+		return fmt.Sprintf("CheckMode(%d)", int(m))
+	}
+}
+
+// CheckPeriod overrides the number of blocks between two checks of a single
+// invariant route at the end of a block.
+type CheckPeriod struct {
+	ModuleName string
+	Route      string
+	Period     uint
+}
+
+// NewCheckPeriod creates a new CheckPeriod object
+func NewCheckPeriod(moduleName, route string, period uint) CheckPeriod {
+	return CheckPeriod{
+		ModuleName: moduleName,
+		Route:      route,
+		Period:     period,
+	}
+}
+
+// ParseCheckPeriods parses invariant check periods given in the
+// "<module-name>/<invariant-route>=<period>" format.
+func ParseCheckPeriods(entries []string) ([]CheckPeriod, error) {
+	checkPeriods := make([]CheckPeriod, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid invariant check period %q: expected <module-name>/<invariant-route>=<period>", entry)
+		}
+
+		fullRoute := parts[0]
+		routeParts := strings.SplitN(fullRoute, "/", 2)
+		if len(routeParts) != 2 || routeParts[0] == "" || routeParts[1] == "" {
+			return nil, fmt.Errorf("invalid invariant route %q: expected <module-name>/<invariant-route>", fullRoute)
+		}
+
+		period, err := strconv.ParseUint(parts[1], 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid check period for invariant %s: %w", fullRoute, err)
+		}
+
+		checkPeriods = append(checkPeriods, NewCheckPeriod(routeParts[0], routeParts[1], uint(period)))
+	}
+
+	return checkPeriods, nil
+}
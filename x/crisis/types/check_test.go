@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestParseCheckPeriods(t *testing.T) {
+	testCases := []struct {
+		name      string
+		entries   []string
+		expected  []types.CheckPeriod
+		expectErr bool
+	}{
+		{"no entries", nil, []types.CheckPeriod{}, false},
+		{
+			"valid entries",
+			[]string{"bank/total-supply=1", "staking/module-accounts=0"},
+			[]types.CheckPeriod{
+				types.NewCheckPeriod("bank", "total-supply", 1),
+				types.NewCheckPeriod("staking", "module-accounts", 0),
+			},
+			false,
+		},
+		{"missing period", []string{"bank/total-supply"}, nil, true},
+		{"missing route", []string{"bank=1"}, nil, true},
+		{"empty module name", []string{"/total-supply=1"}, nil, true},
+		{"negative period", []string{"bank/total-supply=-1"}, nil, true},
+		{"invalid period", []string{"bank/total-supply=often"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			checkPeriods, err := types.ParseCheckPeriods(tc.entries)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, checkPeriods)
+		})
+	}
+}
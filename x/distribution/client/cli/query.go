@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 
 	distQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryValidatorDistributionInfo(),
 		GetCmdQueryValidatorOutstandingRewards(),
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
@@ -153,6 +154,52 @@ $ %s query distribution commission %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorDistributionInfo implements the query validator
+// distribution info command.
+func GetCmdQueryValidatorDistributionInfo() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-distribution-info [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query validator distribution info",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the self-delegation rewards and accumulated commission of a validator.
+
+Example:
+$ %s query distribution validator-distribution-info %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorDistributionInfo(
+				cmd.Context(),
+				&types.QueryValidatorDistributionInfoRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryValidatorSlashes implements the query validator slashes command.
 func GetCmdQueryValidatorSlashes() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,10 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewCommunityPoolSpendCmd(),
+		NewDepositValidatorRewardsPoolCmd(),
+		NewSetAutoCompoundCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
 	)
 
 	return distTxCmd
@@ -221,6 +226,76 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Short: "enable or disable the auto-compounding of staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the periodic re-delegation of the bond denom rewards of all
+delegations of the delegator. Auto-compounding can only be enabled while rewards are
+withdrawn to the delegator address.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Short: "withdraw the rewards of the tokenized delegations of all owned tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the tokenized delegations of all the tokenize share
+records owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-community-pool [amount]",
@@ -257,6 +332,99 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+// NewCommunityPoolSpendCmd returns a CLI command handler for creating a
+// MsgCommunityPoolSpend transaction.
+func NewCommunityPoolSpendCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "community-pool-spend [recipient] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Spend the specified amount from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the specified amount from the community pool to the recipient.
+The transaction must be signed by the distribution module authority, so it is
+usually generated with --generate-only and executed by the authority account.
+When the authority is the governance module account, submit the spend with
+"tx gov submit-proposal community-pool-spend" instead.
+
+Example:
+$ %s tx distribution community-pool-spend %s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq 100uatom --from authority --generate-only
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			authority := clientCtx.GetFromAddress()
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommunityPoolSpend(authority, recipient, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDepositValidatorRewardsPoolCmd returns a CLI command handler for creating
+// a MsgDepositValidatorRewardsPool transaction.
+func NewDepositValidatorRewardsPoolCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "fund-validator-rewards-pool [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Fund the validator rewards pool with the specified amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit the specified amount into the rewards pool of a validator. The
+validator commission is deducted and the remainder is distributed among its delegators.
+
+Example:
+$ %s tx distribution fund-validator-rewards-pool %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100uatom --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			depositorAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositValidatorRewardsPool(depositorAddr, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
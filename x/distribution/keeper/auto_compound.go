@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound opts a delegator in or out of the periodic auto-compounding
+// of its bond denom rewards. Since auto-compounded rewards are re-delegated
+// from the delegator account, opting in requires the withdraw address to be
+// the delegator address.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) error {
+	if enabled && !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	if enabled {
+		k.SetDelegatorAutoCompound(ctx, delAddr)
+	} else {
+		k.DeleteDelegatorAutoCompound(ctx, delAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// AutoCompound processes the next batch of the ongoing auto-compounding round.
+// A new round starts every AutoCompoundPeriod blocks and visits at most
+// AutoCompoundBatchSize delegators per block, in store order, until every
+// delegator which opted in has been processed.
+func (k Keeper) AutoCompound(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.AutoCompoundEnabled() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.AutoCompoundCursorKey)
+	if start == nil {
+		if uint64(ctx.BlockHeight())%params.AutoCompoundPeriod != 0 {
+			return
+		}
+		start = types.AutoCompoundDelegatorPrefix
+	}
+
+	// collect the batch first, as compounding writes to the store
+	batchSize := int(params.AutoCompoundBatchSize)
+	delegators := make([]sdk.AccAddress, 0, batchSize)
+	var next []byte
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if len(delegators) == batchSize {
+			next = iter.Key()
+			break
+		}
+		delegators = append(delegators, types.GetAutoCompoundDelegatorAddress(iter.Key()))
+	}
+	iter.Close()
+
+	if next != nil {
+		store.Set(types.AutoCompoundCursorKey, next)
+	} else {
+		store.Delete(types.AutoCompoundCursorKey)
+	}
+
+	var gasUsed sdk.Gas
+	for _, delAddr := range delegators {
+		gasUsed += k.autoCompoundDelegator(ctx, delAddr)
+	}
+
+	telemetry.IncrCounter(float32(len(delegators)), types.ModuleName, "auto_compound", "delegators")
+	telemetry.IncrCounter(float32(gasUsed), types.ModuleName, "auto_compound", "gas_used")
+}
+
+// autoCompoundDelegator withdraws the rewards of every delegation of a
+// delegator and re-delegates the bond denom part of them to the same
+// validator. Gas is metered separately from the block and returned so that it
+// is accounted to the module.
+func (k Keeper) autoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) sdk.Gas {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	// rewards sent to another address can't be re-delegated
+	compounded := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	if k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		var valAddrs []sdk.ValAddress
+		k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+			return false
+		})
+
+		for _, valAddr := range valAddrs {
+			amount, err := k.compoundDelegation(ctx, delAddr, valAddr, compounded.Denom)
+			if err != nil {
+				k.Logger(ctx).Error("failed to auto-compound rewards", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+				continue
+			}
+			compounded.Amount = compounded.Amount.Add(amount)
+		}
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+
+	return gasUsed
+}
+
+// compoundDelegation withdraws the rewards of a single delegation and
+// re-delegates the bond denom rewards. State is only written if both steps
+// succeed.
+func (k Keeper) compoundDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) (sdk.Int, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if amount.IsPositive() {
+		validator, found := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+		if !found {
+			return sdk.ZeroInt(), types.ErrNoValidatorExists
+		}
+
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return sdk.ZeroInt(), err
+		}
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return amount, nil
+}
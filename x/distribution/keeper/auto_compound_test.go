@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with no commission and three delegators
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	for _, delAddr := range addrs[1:] {
+		tstaking.Delegate(delAddr, valAddrs[0], sdk.NewInt(100))
+	}
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundPeriod = 10
+	params.AutoCompoundBatchSize = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// every delegator opts in, but the last one then withdraws rewards elsewhere
+	for _, delAddr := range addrs[1:] {
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delAddr, true))
+	}
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[3], addrs[0]))
+
+	// each delegation is entitled to 100 tokens of rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	require.NoError(t, app.DistrKeeper.DepositValidatorRewardsPool(ctx, rewards, addrs[0], valAddrs[0]))
+
+	autoCompound := func(height int64) int {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		app.DistrKeeper.AutoCompound(ctx)
+
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeAutoCompound {
+				count++
+			}
+		}
+		return count
+	}
+
+	// no round takes place outside of the period
+	require.Equal(t, 0, autoCompound(11))
+
+	// a round is processed in batches
+	require.Equal(t, 2, autoCompound(20))
+	require.Equal(t, 1, autoCompound(21))
+	require.Equal(t, 0, autoCompound(22))
+
+	delegatedTokens := func(delAddr sdk.AccAddress) sdk.Int {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+		require.True(t, found)
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+		require.True(t, found)
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	// rewards of the opted-in delegators were re-delegated
+	for _, delAddr := range addrs[1:3] {
+		require.Equal(t, sdk.NewInt(200), delegatedTokens(delAddr))
+		require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom).Amount)
+	}
+
+	// rewards withdrawn to another address are left untouched
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(addrs[3]))
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	del := app.StakingKeeper.Delegation(ctx, addrs[3], valAddrs[0])
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod))
+}
@@ -86,6 +86,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		delegatorAddress, err := sdk.AccAddressFromBech32(del)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegatorAutoCompound(ctx, delegatorAddress)
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -190,5 +198,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, del.String())
+		return false
+	})
+
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegators = autoCompound
+
+	return genState
 }
@@ -25,6 +25,45 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorDistributionInfo queries the self-delegation rewards and
+// accumulated commission of a validator
+func (k Keeper) ValidatorDistributionInfo(c context.Context, req *types.QueryValidatorDistributionInfoRequest) (*types.QueryValidatorDistributionInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val := k.stakingKeeper.Validator(ctx, valAdr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	delAdr := sdk.AccAddress(valAdr)
+	var rewards sdk.DecCoins
+	if del := k.stakingKeeper.Delegation(ctx, delAdr, valAdr); del != nil {
+		endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+		rewards = k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	}
+
+	commission := k.GetValidatorAccumulatedCommission(ctx, valAdr)
+
+	return &types.QueryValidatorDistributionInfoResponse{
+		OperatorAddress: delAdr.String(),
+		SelfBondRewards: rewards,
+		Commission:      commission.Commission,
+	}, nil
+}
+
 // ValidatorOutstandingRewards queries rewards of a validator address
 func (k Keeper) ValidatorOutstandingRewards(c context.Context, req *types.QueryValidatorOutstandingRewardsRequest) (*types.QueryValidatorOutstandingRewardsResponse, error) {
 	if req == nil {
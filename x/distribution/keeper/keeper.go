@@ -25,13 +25,17 @@ type Keeper struct {
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgCommunityPoolSpend, typically the
+	// x/gov module account, which executes it through CommunityPoolSpendProposal.
+	authority string
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, blockedAddrs map[string]bool,
+	feeCollectorName string, blockedAddrs map[string]bool, authority string,
 ) Keeper {
 
 	// ensure distribution module account is set
@@ -53,9 +57,15 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
 		blockedAddrs:     blockedAddrs,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -182,3 +192,21 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.
 
 	return nil
 }
+
+// DepositValidatorRewardsPool transfers the amount from the depositor to the
+// distribution module account and allocates it to the validator as rewards,
+// to be shared between the validator commission and its delegators.
+func (k Keeper) DepositValidatorRewardsPool(ctx sdk.Context, amount sdk.Coins, depositor sdk.AccAddress, valAddr sdk.ValAddress) error {
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return sdkerrors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	k.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(amount...))
+
+	return nil
+}
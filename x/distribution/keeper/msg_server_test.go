@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Equal(t, authority.String(), app.DistrKeeper.GetAuthority())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addrs[0], amount))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+
+	spend := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+
+	// only the authority may spend from the community pool
+	_, err := msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(addrs[0], addrs[1], spend))
+	require.Error(t, err)
+
+	// module accounts can't receive funds
+	distrAcc := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, distrAcc, spend))
+	require.Error(t, err)
+
+	// the community pool can't be overdrawn
+	overdraw := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 101))
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], overdraw))
+	require.Error(t, err)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], spend))
+	require.NoError(t, err)
+	require.Equal(t, spend, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 40)), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestMsgDepositValidatorRewardsPool(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// the validator must exist
+	_, err := msgServer.DepositValidatorRewardsPool(sdk.WrapSDKContext(ctx), types.NewMsgDepositValidatorRewardsPool(addrs[1], valAddrs[1], amount))
+	require.Error(t, err)
+
+	_, err = msgServer.DepositValidatorRewardsPool(sdk.WrapSDKContext(ctx), types.NewMsgDepositValidatorRewardsPool(addrs[1], valAddrs[0], amount))
+	require.NoError(t, err)
+
+	expected := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(5)}}
+	require.Equal(t, expected, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission)
+	require.Equal(t, expected, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0]).Rewards)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount...), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+	require.Equal(t, sdk.NewInt(1224), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount)
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+
+	_, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addrs[0], true))
+	require.NoError(t, err)
+	require.True(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addrs[0]))
+
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addrs[0], false))
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addrs[0]))
+
+	// rewards must be withdrawn to the delegator address
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[0], addrs[1]))
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addrs[0], true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addrs[0]))
+}
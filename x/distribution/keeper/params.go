@@ -41,3 +41,17 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetAutoCompoundPeriod returns the number of blocks between two
+// auto-compounding rounds.
+func (k Keeper) GetAutoCompoundPeriod(ctx sdk.Context) (period uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundPeriod, &period)
+	return period
+}
+
+// GetAutoCompoundBatchSize returns the maximum number of delegators whose
+// rewards are auto-compounded in a single block.
+func (k Keeper) GetAutoCompoundBatchSize(ctx sdk.Context) (size uint32) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundBatchSize, &size)
+	return size
+}
@@ -2,28 +2,19 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// HandleCommunityPoolSpendProposal is a handler for executing a passed community spend proposal
+// HandleCommunityPoolSpendProposal is a handler for executing a passed community spend proposal.
+// The proposal is executed as a MsgCommunityPoolSpend sent by the module authority, which lets
+// governance spend from the community pool although its module account can't sign transactions.
 func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolSpendProposal) error {
-	if k.blockedAddrs[p.Recipient] {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
+	msg := &types.MsgCommunityPoolSpend{
+		Authority: k.authority,
+		Recipient: p.Recipient,
+		Amount:    p.Amount,
 	}
 
-	recipient, addrErr := sdk.AccAddressFromBech32(p.Recipient)
-	if addrErr != nil {
-		return addrErr
-	}
-
-	err := k.DistributeFromFeePool(ctx, p.Amount, recipient)
-	if err != nil {
-		return err
-	}
-
-	logger := k.Logger(ctx)
-	logger.Info("transferred from the community pool to recipient", "amount", p.Amount.String(), "recipient", p.Recipient)
-
-	return nil
+	_, err := NewMsgServerImpl(k).CommunityPoolSpend(sdk.WrapSDKContext(ctx), msg)
+	return err
 }
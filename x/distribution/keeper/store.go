@@ -43,6 +43,37 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// check whether a delegator opted in to auto-compounding
+func (k Keeper) HasDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// opt a delegator in to auto-compounding
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+}
+
+// opt a delegator out of auto-compounding
+func (k Keeper) DeleteDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// iterate over the delegators which opted in to auto-compounding
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del := types.GetAutoCompoundDelegatorAddress(iter.Key())
+		if handler(del) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
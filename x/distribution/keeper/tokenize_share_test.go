@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with no commission and a delegator
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the owner has no records yet
+	_, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[2])
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecord)
+
+	// the delegator tokenizes its whole delegation, with rewards going to the owner
+	_, record, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], sdk.NewInt(100), addrs[2])
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the tokenized delegation is entitled to half of the rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	require.NoError(t, app.DistrKeeper.DepositValidatorRewardsPool(ctx, rewards, addrs[0], valAddrs[0]))
+
+	ownerBalance := app.BankKeeper.GetAllBalances(ctx, addrs[2])
+	withdrawn, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), withdrawn)
+	require.Equal(t, ownerBalance.Add(withdrawn...), app.BankKeeper.GetAllBalances(ctx, addrs[2]))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// nothing is left to withdraw
+	withdrawn, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[2])
+	require.NoError(t, err)
+	require.True(t, withdrawn.IsZero())
+}
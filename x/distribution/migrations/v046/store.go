@@ -0,0 +1,18 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the auto-compounding params to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundPeriod, types.DefaultAutoCompoundPeriod)
+	paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundBatchSize, types.DefaultAutoCompoundBatchSize)
+
+	return nil
+}
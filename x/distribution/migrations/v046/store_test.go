@@ -0,0 +1,37 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	transientKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, transientKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, transientKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// only the params which existed prior to v0.46 are set
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamStoreKeyCommunityTax, params.CommunityTax)
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseProposerReward, params.BaseProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyBonusProposerReward, params.BonusProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyWithdrawAddrEnabled, params.WithdrawAddrEnabled)
+	require.False(t, paramSpace.Has(ctx, types.ParamStoreKeyAutoCompoundPeriod))
+
+	require.NoError(t, v046distribution.MigrateParams(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Equal(t, params, migrated)
+}
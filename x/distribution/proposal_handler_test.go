@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -12,6 +13,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -74,3 +81,48 @@ func TestProposalHandlerFailed(t *testing.T) {
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
 }
+
+func TestCommunityPoolSpendProposalThroughGov(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens.MulRaw(3))
+	recipient := delAddr1
+
+	// the governance module account is the authority of the message
+	require.Equal(t, app.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String(), app.DistrKeeper.GetAuthority())
+
+	// a validator with enough power to pass the proposal
+	valCreateMsg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addrs[0]), simapp.CreateTestPubKeys(1)[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		stakingtypes.NewDescription("T", "E", "S", "T", "Z"), stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), valCreateMsg)
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+	poolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal(recipient, amount))
+	require.NoError(t, err)
+
+	deposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+	_, err = govkeeper.NewMsgServerImpl(app.GovKeeper).Deposit(sdk.WrapSDKContext(ctx), govtypes.NewMsgDeposit(addrs[0], proposal.ProposalId, deposit))
+	require.NoError(t, err)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+
+	header := ctx.BlockHeader()
+	header.Time = header.Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(header)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, found := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.Equal(t, govtypes.StatusPassed, proposal.Status)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Equal(t, poolBefore.Sub(sdk.NewDecCoinsFromCoins(amount...)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
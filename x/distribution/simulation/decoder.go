@@ -63,6 +63,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundDelegatorPrefix):
+			return fmt.Sprintf("%v\n%v", types.GetAutoCompoundDelegatorAddress(kvA.Key), types.GetAutoCompoundDelegatorAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			return fmt.Sprintf("%v\n%v", types.GetAutoCompoundDelegatorAddress(kvA.Value), types.GetAutoCompoundDelegatorAddress(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
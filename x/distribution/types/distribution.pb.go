@@ -32,6 +32,12 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_period is the number of blocks between two auto-compounding
+	// rounds. A zero value disables auto-compounding.
+	AutoCompoundPeriod uint64 `protobuf:"varint,5,opt,name=auto_compound_period,json=autoCompoundPeriod,proto3" json:"auto_compound_period,omitempty"`
+	// auto_compound_batch_size is the maximum number of delegators whose
+	// rewards are auto-compounded in a single block.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +79,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundPeriod() uint64 {
+	if m != nil {
+		return m.AutoCompoundPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -626,65 +646,69 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x31, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0x5c, 0x1c, 0x27, 0x79, 0x77, 0x49, 0x60, 0xe2, 0xe4, 0x9c, 0xdc, 0xc9, 0xb6, 0x2c,
+	0x01, 0x46, 0xa7, 0x38, 0xc9, 0x5d, 0x81, 0x14, 0xd1, 0xc4, 0x4e, 0x10, 0x54, 0x17, 0x6d, 0x10,
+	0x20, 0x9a, 0xd5, 0x78, 0x76, 0x62, 0x8f, 0xb2, 0x3b, 0xb3, 0xcc, 0xcc, 0x3a, 0xb9, 0x6b, 0x69,
+	0x80, 0x0a, 0x89, 0x06, 0x51, 0xa0, 0x2b, 0x11, 0xf5, 0x35, 0x94, 0x74, 0x57, 0x1e, 0xd7, 0x80,
+	0x28, 0x02, 0x4a, 0x84, 0x84, 0x28, 0xf9, 0x05, 0x68, 0x76, 0xc7, 0x6b, 0x07, 0xc2, 0xe9, 0x8a,
+	0x58, 0x57, 0xd9, 0xf3, 0xde, 0xcc, 0xfb, 0xde, 0xf7, 0xcd, 0x9b, 0xf7, 0x16, 0x5a, 0x54, 0xea,
+	0x48, 0xea, 0x8d, 0x80, 0x6b, 0xa3, 0x78, 0x37, 0x31, 0x5c, 0x8a, 0x8d, 0xc1, 0x56, 0x97, 0x19,
+	0xb2, 0x75, 0xc1, 0xd8, 0x8a, 0x95, 0x34, 0x12, 0xdf, 0xca, 0xf6, 0xb7, 0x2e, 0xb8, 0xdc, 0xfe,
+	0xb5, 0x72, 0x4f, 0xf6, 0x64, 0xba, 0x6f, 0xc3, 0xfe, 0xcb, 0x8e, 0xac, 0x55, 0x1d, 0x44, 0x97,
+	0x68, 0x96, 0x87, 0xa6, 0x92, 0xbb, 0x90, 0x6b, 0xab, 0x99, 0xdf, 0xcf, 0x0e, 0xba, 0xf8, 0xe9,
+	0xa2, 0xf1, 0xf7, 0x14, 0x94, 0xf6, 0x89, 0x22, 0x91, 0xc6, 0x04, 0xe6, 0xa9, 0x8c, 0xa2, 0x44,
+	0x70, 0xf3, 0xc0, 0x37, 0xe4, 0xa4, 0x82, 0xea, 0xa8, 0x39, 0xd7, 0x7e, 0xfb, 0xc9, 0x69, 0xad,
+	0xf0, 0xeb, 0x69, 0xed, 0xf5, 0x1e, 0x37, 0xfd, 0xa4, 0xdb, 0xa2, 0x32, 0x72, 0x21, 0xdc, 0xcf,
+	0xba, 0x0e, 0x8e, 0x36, 0xcc, 0x83, 0x98, 0xe9, 0xd6, 0x2e, 0xa3, 0xcf, 0x1e, 0xaf, 0x83, 0x43,
+	0xd8, 0x65, 0xd4, 0xbb, 0x91, 0x87, 0x7c, 0x9f, 0x9c, 0x60, 0x01, 0x65, 0x9b, 0xa3, 0x4d, 0x24,
+	0x96, 0x9a, 0x29, 0x5f, 0xb1, 0x63, 0xa2, 0x82, 0xca, 0xb5, 0x2b, 0x40, 0xc2, 0x36, 0xf2, 0xbe,
+	0x0b, 0xec, 0xa5, 0x71, 0x71, 0x0c, 0xcb, 0x5d, 0x29, 0x12, 0xfd, 0x1f, 0xc0, 0xa9, 0x2b, 0x00,
+	0x5c, 0x4a, 0x43, 0xff, 0x0b, 0xf1, 0x2e, 0x2c, 0x1f, 0x73, 0xd3, 0x0f, 0x14, 0x39, 0xf6, 0x49,
+	0x10, 0x28, 0x9f, 0x09, 0xd2, 0x0d, 0x59, 0x50, 0x29, 0xd6, 0x51, 0x73, 0xd6, 0x5b, 0x1a, 0x3a,
+	0x77, 0x82, 0x40, 0xed, 0x65, 0x2e, 0xbc, 0x09, 0x65, 0x92, 0x18, 0xe9, 0x53, 0x19, 0xc5, 0x32,
+	0x11, 0x81, 0x1f, 0x33, 0xc5, 0x65, 0x50, 0x99, 0xae, 0xa3, 0x66, 0xd1, 0xc3, 0xd6, 0xd7, 0x71,
+	0xae, 0xfd, 0xd4, 0x83, 0xdf, 0x82, 0xca, 0xc5, 0x13, 0x5d, 0x62, 0x68, 0xdf, 0xd7, 0xfc, 0x21,
+	0xab, 0x94, 0xea, 0xa8, 0x39, 0xef, 0x2d, 0x8f, 0x9f, 0x6a, 0x5b, 0xef, 0x01, 0x7f, 0xc8, 0xb6,
+	0x8b, 0x5f, 0x3f, 0xaa, 0x15, 0x1a, 0x3f, 0x21, 0x58, 0xfb, 0x80, 0x84, 0x3c, 0x20, 0x46, 0xaa,
+	0x77, 0xb9, 0x36, 0x52, 0x71, 0x4a, 0xc2, 0x8c, 0x82, 0xc6, 0x9f, 0x23, 0xb8, 0x49, 0x93, 0x28,
+	0x09, 0x89, 0xe1, 0x03, 0xe6, 0x24, 0xf3, 0x15, 0x31, 0x5c, 0x56, 0x50, 0x7d, 0xaa, 0x79, 0xfd,
+	0xee, 0x6d, 0x57, 0xd4, 0x2d, 0xab, 0xf9, 0xb0, 0x38, 0xad, 0x28, 0x1d, 0xc9, 0x45, 0xfb, 0x9e,
+	0x95, 0xf5, 0xfb, 0xdf, 0x6a, 0x77, 0x5e, 0x4c, 0x56, 0x7b, 0x46, 0x7b, 0xcb, 0x23, 0xc4, 0x2c,
+	0x0f, 0xcf, 0xe2, 0xe1, 0x37, 0x60, 0x51, 0xb1, 0x43, 0xa6, 0x98, 0xa0, 0xcc, 0xa7, 0x32, 0x11,
+	0x26, 0x2d, 0x96, 0x79, 0x6f, 0x21, 0x37, 0x77, 0xac, 0xb5, 0xf1, 0x2d, 0x82, 0x9b, 0x39, 0xa7,
+	0x4e, 0xa2, 0x14, 0x13, 0x66, 0x48, 0xe8, 0x08, 0x66, 0x32, 0x12, 0x7a, 0x72, 0xf9, 0x0f, 0x11,
+	0xf0, 0x0a, 0x94, 0xdc, 0xfd, 0x5d, 0x4b, 0xef, 0xcf, 0xad, 0x1a, 0x5f, 0x21, 0xa8, 0xe6, 0x09,
+	0xee, 0x50, 0x47, 0x97, 0x05, 0x1d, 0x19, 0x45, 0x5c, 0x6b, 0x2e, 0x05, 0xfe, 0x04, 0x80, 0xe6,
+	0xab, 0xc9, 0xa5, 0x3a, 0x06, 0xd2, 0xf8, 0x02, 0xc1, 0xad, 0x3c, 0xab, 0xfb, 0x89, 0xd1, 0x86,
+	0x88, 0x80, 0x8b, 0xde, 0xcb, 0x90, 0xae, 0xf1, 0x0d, 0x82, 0xa5, 0x3c, 0x99, 0x83, 0x90, 0xe8,
+	0xfe, 0xde, 0x80, 0x09, 0x83, 0xdf, 0x84, 0x57, 0x06, 0x43, 0xf3, 0xf0, 0x71, 0xa0, 0x54, 0xdc,
+	0xc5, 0xdc, 0xee, 0x5e, 0xc6, 0x47, 0x30, 0x7b, 0xa8, 0x08, 0xb5, 0x4d, 0xf3, 0x4a, 0xba, 0x4a,
+	0x1e, 0xcd, 0x2a, 0x55, 0xbe, 0x24, 0x39, 0x8d, 0x43, 0x58, 0x19, 0x65, 0xa7, 0xad, 0xc3, 0x67,
+	0xa9, 0xc7, 0x29, 0xb6, 0xd9, 0x7a, 0x4e, 0x47, 0x6f, 0x5d, 0x12, 0xb2, 0x5d, 0xb4, 0x29, 0x7b,
+	0xe5, 0xc1, 0x25, 0x68, 0xee, 0x05, 0x7f, 0x8a, 0x60, 0xe6, 0x1d, 0xc6, 0xf6, 0xa5, 0x0c, 0xf1,
+	0x09, 0x2c, 0x8c, 0xfa, 0x76, 0x2c, 0x65, 0x38, 0xb9, 0x9b, 0x1a, 0x0d, 0x08, 0x8b, 0xdc, 0xf8,
+	0x03, 0xc1, 0x5a, 0x67, 0xdc, 0x72, 0x10, 0x33, 0x11, 0x64, 0x1d, 0x91, 0x84, 0xb8, 0x0c, 0xd3,
+	0x86, 0x9b, 0x90, 0x65, 0x83, 0xc4, 0xcb, 0x16, 0xb8, 0x0e, 0xd7, 0x03, 0xa6, 0xa9, 0xe2, 0xf1,
+	0xe8, 0x92, 0xbc, 0x71, 0x13, 0xbe, 0x0d, 0x73, 0x8a, 0x51, 0x1e, 0x73, 0x26, 0x4c, 0xd6, 0xa9,
+	0xbd, 0x91, 0x01, 0x53, 0x28, 0x91, 0x28, 0x6d, 0x04, 0xc5, 0x94, 0xe6, 0xea, 0xa5, 0x34, 0x53,
+	0x8e, 0x9b, 0x8e, 0x63, 0xf3, 0x05, 0x38, 0x66, 0x04, 0x5d, 0xe8, 0xed, 0x1b, 0x9f, 0x3d, 0xaa,
+	0x15, 0xac, 0xd2, 0x7f, 0x5a, 0xb5, 0x7f, 0x44, 0xb0, 0xbc, 0xcb, 0x42, 0xd6, 0x4b, 0x2f, 0xc3,
+	0x10, 0x65, 0xb8, 0xe8, 0xbd, 0x27, 0x0e, 0xd3, 0xf6, 0x14, 0x2b, 0x36, 0xe0, 0xd2, 0xce, 0x98,
+	0xf1, 0xc2, 0x5c, 0x18, 0x9a, 0x5d, 0x5d, 0x7a, 0x30, 0xad, 0x0d, 0x39, 0x62, 0x57, 0x52, 0x94,
+	0x59, 0x28, 0x7c, 0x07, 0x4a, 0x7d, 0xc6, 0x7b, 0xfd, 0x4c, 0xa4, 0x62, 0x7b, 0xe9, 0xaf, 0xd3,
+	0xda, 0x22, 0x55, 0xcc, 0x36, 0x4e, 0xe1, 0x67, 0x2e, 0xcf, 0x6d, 0x69, 0xfc, 0x8c, 0x60, 0xd5,
+	0x71, 0xe0, 0x52, 0xe4, 0x6c, 0xdc, 0xd8, 0xda, 0x83, 0x57, 0x47, 0x35, 0x6c, 0xe7, 0x16, 0xd3,
+	0xda, 0xcd, 0xff, 0xca, 0xb3, 0xc7, 0xeb, 0x65, 0x07, 0xbe, 0x93, 0x79, 0x0e, 0x8c, 0xb2, 0x2d,
+	0x62, 0xf4, 0x28, 0x9d, 0x1d, 0x73, 0x28, 0xe5, 0x13, 0x7d, 0x42, 0x25, 0xe8, 0x00, 0xb6, 0x67,
+	0xdd, 0x0d, 0xa1, 0xc6, 0x0f, 0x08, 0x5e, 0xfb, 0xff, 0x2a, 0xfc, 0x90, 0x9b, 0xfe, 0x2e, 0x8b,
+	0xa5, 0xe6, 0x66, 0x42, 0x05, 0xb9, 0x32, 0x56, 0x90, 0xd6, 0xe5, 0x56, 0xb8, 0x02, 0x33, 0x41,
+	0x06, 0x9c, 0x4e, 0xf2, 0x39, 0x6f, 0xb8, 0x1c, 0xe5, 0xde, 0xbe, 0xff, 0xdd, 0x59, 0x15, 0x3d,
+	0x39, 0xab, 0xa2, 0xa7, 0x67, 0x55, 0xf4, 0xfb, 0x59, 0x15, 0x7d, 0x79, 0x5e, 0x2d, 0x3c, 0x3d,
+	0xaf, 0x16, 0x7e, 0x39, 0xaf, 0x16, 0x3e, 0xde, 0x7a, 0xae, 0x30, 0x27, 0x17, 0x3f, 0x29, 0x53,
+	0x9d, 0xba, 0xa5, 0xf4, 0xb3, 0xee, 0xde, 0x3f, 0x03, 0x00, 0xda, 0x90, 0xc0, 0xab, 0x76, 0x0a,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +742,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundPeriod != that1.AutoCompoundPeriod {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1045,6 +1075,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1587,6 +1627,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundPeriod))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
 	return n
 }
 
@@ -1949,6 +1995,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundPeriod", wireType)
+			}
+			m.AutoCompoundPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -38,6 +40,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []string{},
 	}
 }
 
@@ -46,5 +49,10 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, del := range gs.AutoCompoundDelegators {
+		if _, err := sdk.AccAddressFromBech32(del); err != nil {
+			return fmt.Errorf("invalid auto-compound delegator address %s: %w", del, err)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
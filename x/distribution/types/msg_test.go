@@ -93,3 +93,72 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCommunityPoolSpend
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), true},
+		{emptyDelAddr, delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1, emptyDelAddr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1, delAddr2, sdk.NewCoins(), false},
+		{delAddr1, delAddr2, sdk.Coins{sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uatom", 10)}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgDepositValidatorRewardsPool
+func TestMsgDepositValidatorRewardsPool(t *testing.T) {
+	tests := []struct {
+		depositor     sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coins
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), true},
+		{emptyDelAddr, valAddr1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1, emptyValAddr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1, valAddr1, sdk.NewCoins(), false},
+		{delAddr1, valAddr1, sdk.Coins{sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uatom", 10)}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgDepositValidatorRewardsPool(tc.depositor, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -11,10 +11,12 @@ import (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax          = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward    = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward   = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled   = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoCompoundPeriod    = []byte("autocompoundperiod")
+	ParamStoreKeyAutoCompoundBatchSize = []byte("autocompoundbatchsize")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -22,16 +24,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// Default auto-compounding parameter values
+const (
+	DefaultAutoCompoundPeriod    uint64 = 100
+	DefaultAutoCompoundBatchSize uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:          sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:    sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:   sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:   true,
+		AutoCompoundPeriod:    DefaultAutoCompoundPeriod,
+		AutoCompoundBatchSize: DefaultAutoCompoundBatchSize,
 	}
 }
 
+// AutoCompoundEnabled returns true if the parameters allow for auto-compounding
+// rounds to take place. A zero period or batch size disables auto-compounding.
+func (p Params) AutoCompoundEnabled() bool {
+	return p.AutoCompoundPeriod > 0 && p.AutoCompoundBatchSize > 0
+}
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -44,6 +60,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundPeriod, &p.AutoCompoundPeriod, validateAutoCompoundPeriod),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundBatchSize, &p.AutoCompoundBatchSize, validateAutoCompoundBatchSize),
 	}
 }
 
@@ -138,3 +156,21 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundPeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundBatchSize(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
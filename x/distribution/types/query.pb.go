@@ -116,6 +116,120 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryValidatorDistributionInfoRequest is the request type for the
+// Query/ValidatorDistributionInfo RPC method.
+type QueryValidatorDistributionInfoRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorDistributionInfoRequest) Reset()         { *m = QueryValidatorDistributionInfoRequest{} }
+func (m *QueryValidatorDistributionInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDistributionInfoRequest) ProtoMessage()    {}
+func (*QueryValidatorDistributionInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{2}
+}
+func (m *QueryValidatorDistributionInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDistributionInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDistributionInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDistributionInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDistributionInfoRequest.Merge(m, src)
+}
+func (m *QueryValidatorDistributionInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDistributionInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDistributionInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDistributionInfoRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDistributionInfoRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorDistributionInfoResponse is the response type for the
+// Query/ValidatorDistributionInfo RPC method.
+type QueryValidatorDistributionInfoResponse struct {
+	// operator_address defines the validator operator address.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// self_bond_rewards defines the rewards of the validator self-delegation.
+	SelfBondRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=self_bond_rewards,json=selfBondRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"self_bond_rewards"`
+	// commission defines the commission the validator received.
+	Commission github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"commission"`
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Reset() {
+	*m = QueryValidatorDistributionInfoResponse{}
+}
+func (m *QueryValidatorDistributionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDistributionInfoResponse) ProtoMessage()    {}
+func (*QueryValidatorDistributionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{3}
+}
+func (m *QueryValidatorDistributionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDistributionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDistributionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDistributionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDistributionInfoResponse.Merge(m, src)
+}
+func (m *QueryValidatorDistributionInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDistributionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDistributionInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDistributionInfoResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDistributionInfoResponse) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorDistributionInfoResponse) GetSelfBondRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.SelfBondRewards
+	}
+	return nil
+}
+
+func (m *QueryValidatorDistributionInfoResponse) GetCommission() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
 // QueryValidatorOutstandingRewardsRequest is the request type for the
 // Query/ValidatorOutstandingRewards RPC method.
 type QueryValidatorOutstandingRewardsRequest struct {
@@ -129,7 +243,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Reset() {
 func (m *QueryValidatorOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorOutstandingRewardsRequest) ProtoMessage()    {}
 func (*QueryValidatorOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{2}
+	return fileDescriptor_5efd02cbc06efdc9, []int{4}
 }
 func (m *QueryValidatorOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +291,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Reset() {
 func (m *QueryValidatorOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorOutstandingRewardsResponse) ProtoMessage()    {}
 func (*QueryValidatorOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{3}
+	return fileDescriptor_5efd02cbc06efdc9, []int{5}
 }
 func (m *QueryValidatorOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +338,7 @@ func (m *QueryValidatorCommissionRequest) Reset()         { *m = QueryValidatorC
 func (m *QueryValidatorCommissionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorCommissionRequest) ProtoMessage()    {}
 func (*QueryValidatorCommissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{4}
+	return fileDescriptor_5efd02cbc06efdc9, []int{6}
 }
 func (m *QueryValidatorCommissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +385,7 @@ func (m *QueryValidatorCommissionResponse) Reset()         { *m = QueryValidator
 func (m *QueryValidatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorCommissionResponse) ProtoMessage()    {}
 func (*QueryValidatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{5}
+	return fileDescriptor_5efd02cbc06efdc9, []int{7}
 }
 func (m *QueryValidatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +438,7 @@ func (m *QueryValidatorSlashesRequest) Reset()         { *m = QueryValidatorSlas
 func (m *QueryValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesRequest) ProtoMessage()    {}
 func (*QueryValidatorSlashesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{6}
+	return fileDescriptor_5efd02cbc06efdc9, []int{8}
 }
 func (m *QueryValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +480,7 @@ func (m *QueryValidatorSlashesResponse) Reset()         { *m = QueryValidatorSla
 func (m *QueryValidatorSlashesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesResponse) ProtoMessage()    {}
 func (*QueryValidatorSlashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{7}
+	return fileDescriptor_5efd02cbc06efdc9, []int{9}
 }
 func (m *QueryValidatorSlashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +536,7 @@ func (m *QueryDelegationRewardsRequest) Reset()         { *m = QueryDelegationRe
 func (m *QueryDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{8}
+	return fileDescriptor_5efd02cbc06efdc9, []int{10}
 }
 func (m *QueryDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +576,7 @@ func (m *QueryDelegationRewardsResponse) Reset()         { *m = QueryDelegationR
 func (m *QueryDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{9}
+	return fileDescriptor_5efd02cbc06efdc9, []int{11}
 }
 func (m *QueryDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +623,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{10}
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +665,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{11}
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +719,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +759,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +799,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +839,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +877,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +917,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,6 +956,8 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorDistributionInfoRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorDistributionInfoRequest")
+	proto.RegisterType((*QueryValidatorDistributionInfoResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorDistributionInfoResponse")
 	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsRequest")
 	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
 	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionRequest")
@@ -865,78 +981,84 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x84, 0xdc, 0x4d, 0xb0, 0xa3, 0x0d,
-	0x25, 0x11, 0x51, 0xbc, 0x4d, 0x22, 0x15, 0x68, 0x41, 0x90, 0x5f, 0xa5, 0x52, 0xab, 0x36, 0x75,
-	0xab, 0xa6, 0x70, 0xb1, 0xd6, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0xc7, 0x44, 0x55,
-	0x2f, 0x94, 0x4a, 0x5c, 0x90, 0x90, 0xb8, 0xf4, 0x98, 0x33, 0x67, 0x10, 0x12, 0x7f, 0x41, 0x8f,
-	0x15, 0x48, 0xa8, 0x27, 0x40, 0x09, 0x42, 0xbd, 0x70, 0xe6, 0x5a, 0x79, 0x66, 0xd6, 0xde, 0xb5,
-	0xd7, 0x6b, 0x3b, 0xae, 0x4f, 0x75, 0x67, 0xe7, 0x7d, 0xdf, 0xfb, 0xbc, 0x99, 0x37, 0xef, 0x29,
-	0x30, 0x5f, 0xa4, 0xac, 0x42, 0x99, 0x61, 0x39, 0x8c, 0x7b, 0x4e, 0xa1, 0xc6, 0x1d, 0xea, 0x1a,
-	0xbb, 0xcb, 0x05, 0xc2, 0xcd, 0x65, 0xe3, 0x41, 0x8d, 0x78, 0x7b, 0xd9, 0xaa, 0x47, 0x39, 0xc5,
-	0xd3, 0x72, 0x63, 0x36, 0xb8, 0x31, 0xab, 0x36, 0x6a, 0xef, 0x2b, 0x95, 0x82, 0xc9, 0x88, 0xb4,
-	0x6a, 0x6a, 0x54, 0x4d, 0xdb, 0x71, 0x4d, 0xb1, 0x5b, 0x08, 0x69, 0x53, 0x36, 0xb5, 0xa9, 0xf8,
-	0x69, 0x34, 0x7e, 0xa9, 0xd5, 0x19, 0x9b, 0x52, 0xbb, 0x4c, 0x0c, 0xb3, 0xea, 0x18, 0xa6, 0xeb,
-	0x52, 0x2e, 0x4c, 0x98, 0xfa, 0x9a, 0x0e, 0xea, 0xfb, 0xca, 0x45, 0xea, 0xf8, 0x9a, 0xd9, 0x38,
-	0x8a, 0x50, 0xc4, 0x72, 0xff, 0x39, 0xb9, 0x3f, 0x2f, 0xc3, 0x50, 0x64, 0xe2, 0x3f, 0xfa, 0x14,
-	0xe0, 0x5b, 0x0d, 0x80, 0x6d, 0xd3, 0x33, 0x2b, 0x2c, 0x47, 0x1e, 0xd4, 0x08, 0xe3, 0xfa, 0x3d,
-	0x38, 0x1b, 0x5a, 0x65, 0x55, 0xea, 0x32, 0x82, 0xd7, 0x60, 0xbc, 0x2a, 0x56, 0x52, 0x68, 0x16,
-	0x2d, 0x9c, 0x5a, 0x99, 0xcb, 0xc6, 0x64, 0x29, 0x2b, 0x8d, 0xd7, 0xc7, 0x9e, 0xfd, 0x99, 0x49,
-	0xe4, 0x94, 0xa1, 0x5e, 0x85, 0x79, 0xa1, 0x7c, 0xd7, 0x2c, 0x3b, 0x96, 0xc9, 0xa9, 0x77, 0xb3,
-	0xc6, 0x19, 0x37, 0x5d, 0xcb, 0x71, 0xed, 0x1c, 0xa9, 0x9b, 0x9e, 0xe5, 0x07, 0x81, 0xb7, 0xe0,
-	0xcc, 0xae, 0xbf, 0x2b, 0x6f, 0x5a, 0x96, 0x47, 0x98, 0x74, 0x7c, 0x72, 0x3d, 0xf5, 0xdb, 0x4f,
-	0x4b, 0x53, 0xca, 0xf7, 0x9a, 0xfc, 0x72, 0x9b, 0x7b, 0x0d, 0x89, 0xc9, 0xa6, 0x89, 0x5a, 0xd7,
-	0xbf, 0x41, 0xb0, 0xd0, 0xdb, 0xa5, 0x22, 0xbc, 0x07, 0x27, 0x3c, 0xb9, 0xa4, 0x10, 0x3f, 0x8c,
-	0x45, 0x8c, 0x91, 0x54, 0xdc, 0xbe, 0x9c, 0x5e, 0x82, 0x4c, 0x38, 0x8a, 0x0d, 0x5a, 0xa9, 0x38,
-	0x8c, 0x39, 0xd4, 0x7d, 0xcd, 0xc0, 0x4f, 0x10, 0xcc, 0x76, 0x77, 0xa5, 0x40, 0x4d, 0x80, 0x62,
-	0x73, 0x55, 0xb1, 0x5e, 0xee, 0x8f, 0x75, 0xad, 0x58, 0xac, 0x55, 0x6a, 0x65, 0x93, 0x13, 0xab,
-	0x25, 0xac, 0x70, 0x03, 0xa2, 0xfa, 0x93, 0x24, 0xcc, 0x84, 0xe3, 0xb8, 0x5d, 0x36, 0x59, 0x89,
-	0xbc, 0xe6, 0x03, 0xc6, 0xf3, 0x30, 0xc1, 0xb8, 0xe9, 0x71, 0xc7, 0xb5, 0xf3, 0x25, 0xe2, 0xd8,
-	0x25, 0x9e, 0x4a, 0xce, 0xa2, 0x85, 0xb1, 0xdc, 0x5b, 0xfe, 0xf2, 0x55, 0xb1, 0x8a, 0xe7, 0xe0,
-	0x34, 0x11, 0x47, 0xe4, 0x6f, 0x3b, 0x26, 0xb6, 0xbd, 0x29, 0x17, 0xd5, 0xa6, 0x2b, 0x00, 0xad,
-	0x1a, 0x4e, 0x8d, 0x89, 0xc4, 0xbc, 0xe7, 0x27, 0xa6, 0x51, 0x90, 0x59, 0xf9, 0x4c, 0xb4, 0x6e,
-	0xb9, 0x4d, 0x14, 0x50, 0x2e, 0x60, 0x79, 0xe9, 0x8d, 0x6f, 0xf7, 0x33, 0x89, 0xa7, 0xfb, 0x19,
-	0xa4, 0xff, 0x8a, 0xe0, 0x9d, 0x2e, 0x79, 0x50, 0x87, 0xb1, 0x0d, 0x27, 0x98, 0x5c, 0x4a, 0xa1,
-	0xd9, 0x63, 0x0b, 0xa7, 0x56, 0x2e, 0xf4, 0x77, 0x12, 0x42, 0x67, 0x6b, 0x97, 0xb8, 0xdc, 0xbf,
-	0x6d, 0x4a, 0x06, 0x7f, 0x1e, 0xa2, 0x48, 0x0a, 0x8a, 0xf9, 0x9e, 0x14, 0x32, 0x9c, 0x20, 0x86,
-	0xfe, 0x8b, 0x1f, 0xfc, 0x26, 0x29, 0x13, 0x5b, 0xac, 0x75, 0x96, 0xa9, 0x25, 0xbf, 0x0d, 0x72,
-	0x8a, 0x4d, 0x13, 0xff, 0x14, 0x23, 0x2f, 0x43, 0x72, 0xd0, 0xcb, 0x20, 0xd3, 0xfe, 0x72, 0x3f,
-	0x93, 0xd0, 0xbf, 0x43, 0x90, 0xee, 0x16, 0xb9, 0xca, 0xfb, 0xfd, 0x60, 0xb5, 0x37, 0xf2, 0x3e,
-	0x13, 0x4a, 0x91, 0x9f, 0x9c, 0x4d, 0x52, 0xdc, 0xa0, 0x8e, 0xbb, 0xbe, 0xda, 0xc8, 0xf1, 0x8f,
-	0x7f, 0x65, 0x16, 0x6d, 0x87, 0x97, 0x6a, 0x85, 0x6c, 0x91, 0x56, 0xd4, 0x63, 0xaa, 0xfe, 0x59,
-	0x62, 0xd6, 0x7d, 0x83, 0xef, 0x55, 0x09, 0xf3, 0x6d, 0x58, 0xeb, 0x01, 0xa8, 0x81, 0xde, 0x16,
-	0xce, 0x1d, 0xca, 0xcd, 0xf2, 0x48, 0xb2, 0x19, 0x48, 0xc3, 0xbf, 0x08, 0xe6, 0x62, 0xfd, 0xaa,
-	0x5c, 0xdc, 0x6d, 0xcf, 0xc5, 0xc5, 0xd8, 0x3b, 0xd8, 0x52, 0xdb, 0xf4, 0x7d, 0x4b, 0xc5, 0xb6,
-	0x77, 0x0f, 0xdb, 0x70, 0x9c, 0x37, 0xfc, 0xa5, 0x92, 0xa3, 0xca, 0xb0, 0xd4, 0xd7, 0x3d, 0xf5,
-	0xc0, 0x36, 0xe3, 0x69, 0x96, 0xc9, 0xe8, 0x92, 0x7b, 0x5d, 0xbd, 0xb4, 0x91, 0x3e, 0x55, 0x62,
-	0xd3, 0x00, 0xcd, 0x5b, 0x2a, 0x73, 0x7b, 0x32, 0x17, 0x58, 0x09, 0xa8, 0xd5, 0xe1, 0xdd, 0xb0,
-	0xda, 0x8e, 0xc3, 0x4b, 0x96, 0x67, 0xd6, 0x95, 0xe3, 0x91, 0x61, 0xec, 0xc2, 0xf9, 0x1e, 0x8e,
-	0x15, 0xcb, 0x06, 0x4c, 0xd6, 0xd5, 0xa7, 0xbe, 0x1d, 0x4f, 0xd4, 0xc3, 0x62, 0x01, 0xbf, 0xd3,
-	0x70, 0x4e, 0xf8, 0x6d, 0xb4, 0x91, 0x9a, 0xeb, 0xf0, 0xbd, 0x6d, 0x4a, 0xcb, 0xfe, 0x0c, 0xf2,
-	0x18, 0x81, 0x16, 0xf5, 0x55, 0x85, 0x42, 0x60, 0xac, 0x4a, 0x69, 0x79, 0x74, 0x85, 0x2b, 0xe4,
-	0x57, 0x5e, 0x4c, 0xc0, 0x71, 0x11, 0x05, 0x7e, 0x8a, 0x60, 0x5c, 0x8e, 0x34, 0xd8, 0x88, 0x2d,
-	0x8d, 0xce, 0x79, 0x4a, 0xbb, 0xd0, 0xbf, 0x81, 0xc4, 0xd3, 0x17, 0xbf, 0xfe, 0xfd, 0x9f, 0x1f,
-	0x92, 0xe7, 0xf1, 0x9c, 0x11, 0x37, 0xeb, 0xc9, 0xa1, 0x0a, 0x3f, 0x4e, 0xc2, 0x74, 0xcc, 0x28,
-	0x82, 0x37, 0x7b, 0xbb, 0xef, 0x3d, 0x8f, 0x69, 0x5b, 0x43, 0xaa, 0x28, 0xb2, 0x1d, 0x41, 0x76,
-	0x0b, 0xdf, 0x8c, 0x25, 0x6b, 0x15, 0x88, 0xf1, 0xb0, 0xa3, 0x2f, 0x3c, 0x32, 0x68, 0x4b, 0x3f,
-	0xef, 0xbf, 0x34, 0x07, 0x08, 0xce, 0x46, 0x8c, 0x3c, 0xf8, 0xe3, 0x01, 0xe2, 0xee, 0x18, 0xca,
-	0xb4, 0x4f, 0x8e, 0x68, 0xad, 0x68, 0x6f, 0x08, 0xda, 0xab, 0xf8, 0xca, 0x30, 0xb4, 0xad, 0xa1,
-	0x0a, 0xff, 0x81, 0x60, 0xb2, 0x7d, 0x8e, 0xc0, 0x1f, 0x0d, 0x10, 0x63, 0x78, 0x06, 0xd3, 0x2e,
-	0x1d, 0xc5, 0x54, 0xb1, 0x5d, 0x13, 0x6c, 0x5b, 0x78, 0x63, 0x18, 0x36, 0x7f, 0x62, 0xf9, 0x0f,
-	0xc1, 0x99, 0x8e, 0x4e, 0x8d, 0xfb, 0x08, 0xaf, 0xdb, 0x60, 0xa2, 0x5d, 0x3e, 0x92, 0xad, 0x62,
-	0xcb, 0x0b, 0xb6, 0x2f, 0xf0, 0x4e, 0x2c, 0x5b, 0xf3, 0x4d, 0x65, 0xc6, 0xc3, 0x8e, 0x27, 0xf9,
-	0x91, 0xa1, 0x6e, 0x66, 0x14, 0x37, 0x7e, 0x89, 0xe0, 0xed, 0xe8, 0x96, 0x8c, 0x3f, 0x1d, 0x24,
-	0xf0, 0x88, 0x21, 0x42, 0xfb, 0xec, 0xe8, 0x02, 0x03, 0x1d, 0x6d, 0x7f, 0xf8, 0xa2, 0x30, 0x23,
-	0x3a, 0x64, 0x3f, 0x85, 0xd9, 0xbd, 0x99, 0xf7, 0x53, 0x98, 0x31, 0x6d, 0xb9, 0xcf, 0xc2, 0xec,
-	0x41, 0xd8, 0xba, 0xdb, 0xf8, 0x7f, 0x04, 0xa9, 0x6e, 0xfd, 0x13, 0xaf, 0x0d, 0x10, 0x6b, 0x74,
-	0xd3, 0xd7, 0xd6, 0x87, 0x91, 0x50, 0xcc, 0x77, 0x04, 0xf3, 0x0d, 0x7c, 0x7d, 0x18, 0xe6, 0xf6,
-	0x01, 0x00, 0xff, 0x8c, 0xe0, 0x74, 0xa8, 0x47, 0xe3, 0x8b, 0xbd, 0x63, 0x8d, 0x6a, 0xf9, 0xda,
-	0x07, 0x03, 0xdb, 0x29, 0xb0, 0x55, 0x01, 0xb6, 0x84, 0x17, 0x63, 0xc1, 0x8a, 0xbe, 0x6d, 0xbe,
-	0xd1, 0xda, 0xd7, 0xaf, 0x3d, 0x3b, 0x48, 0xa3, 0xe7, 0x07, 0x69, 0xf4, 0xf7, 0x41, 0x1a, 0x7d,
-	0x7f, 0x98, 0x4e, 0x3c, 0x3f, 0x4c, 0x27, 0x5e, 0x1c, 0xa6, 0x13, 0x5f, 0x2e, 0xc7, 0xce, 0x09,
-	0x5f, 0x85, 0xd5, 0xc5, 0xd8, 0x50, 0x18, 0x17, 0x7f, 0x4e, 0x59, 0x7d, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0xc9, 0xca, 0xd1, 0x61, 0x12, 0x00, 0x00,
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x69, 0x4a, 0x5f, 0x29, 0x49, 0xa6, 0x11, 0x72, 0x36, 0xc1, 0x8e, 0x36, 0xa4,
+	0x89, 0x88, 0xe2, 0x6d, 0x12, 0xa9, 0x40, 0x43, 0x04, 0xb1, 0x93, 0x52, 0xd4, 0xaa, 0x4d, 0xdd,
+	0xaa, 0x29, 0x5c, 0xac, 0xb5, 0x77, 0xba, 0x5e, 0xd5, 0xde, 0x71, 0x76, 0xd6, 0x09, 0x51, 0x95,
+	0x0b, 0xa5, 0x12, 0x17, 0x24, 0x24, 0x2e, 0x3d, 0xe6, 0xcc, 0x19, 0x84, 0xc4, 0x99, 0x43, 0x8f,
+	0x15, 0x48, 0x88, 0x13, 0x45, 0x09, 0x42, 0xe5, 0xc0, 0x99, 0x2b, 0xf2, 0xcc, 0xac, 0xbd, 0x1b,
+	0xdb, 0x6b, 0x3b, 0x8e, 0x4f, 0xd9, 0xbc, 0x9d, 0xf7, 0xbd, 0xf7, 0xbd, 0x9f, 0xd9, 0x2f, 0x81,
+	0xd9, 0x3c, 0x65, 0x25, 0xca, 0x34, 0xc3, 0x62, 0xae, 0x63, 0xe5, 0x2a, 0xae, 0x45, 0x6d, 0x6d,
+	0x67, 0x31, 0x47, 0x5c, 0x7d, 0x51, 0xdb, 0xae, 0x10, 0x67, 0x2f, 0x59, 0x76, 0xa8, 0x4b, 0xf1,
+	0x84, 0x38, 0x98, 0xf4, 0x1f, 0x4c, 0xca, 0x83, 0xca, 0x3b, 0x12, 0x25, 0xa7, 0x33, 0x22, 0xbc,
+	0x6a, 0x18, 0x65, 0xdd, 0xb4, 0x6c, 0x9d, 0x9f, 0xe6, 0x40, 0xca, 0x98, 0x49, 0x4d, 0xca, 0x1f,
+	0xb5, 0xea, 0x93, 0xb4, 0x4e, 0x9a, 0x94, 0x9a, 0x45, 0xa2, 0xe9, 0x65, 0x4b, 0xd3, 0x6d, 0x9b,
+	0xba, 0xdc, 0x85, 0xc9, 0xb7, 0x71, 0x3f, 0xbe, 0x87, 0x9c, 0xa7, 0x96, 0x87, 0x99, 0x0c, 0x63,
+	0x11, 0xc8, 0x58, 0x9c, 0x1f, 0x17, 0xe7, 0xb3, 0x22, 0x0d, 0xc9, 0x8c, 0xff, 0xa2, 0x8e, 0x01,
+	0xbe, 0x53, 0x25, 0xb0, 0xa9, 0x3b, 0x7a, 0x89, 0x65, 0xc8, 0x76, 0x85, 0x30, 0x57, 0x7d, 0x00,
+	0x17, 0x03, 0x56, 0x56, 0xa6, 0x36, 0x23, 0x78, 0x0d, 0x86, 0xca, 0xdc, 0x12, 0x43, 0x53, 0x68,
+	0xee, 0xfc, 0xd2, 0x74, 0x32, 0xa4, 0x4a, 0x49, 0xe1, 0x9c, 0x1a, 0x7c, 0xfe, 0x47, 0x22, 0x92,
+	0x91, 0x8e, 0xaa, 0x0d, 0x33, 0x1c, 0xf9, 0xbe, 0x5e, 0xb4, 0x0c, 0xdd, 0xa5, 0xce, 0xba, 0xcf,
+	0xf5, 0x13, 0xfb, 0x21, 0x95, 0x29, 0xe0, 0x0d, 0x18, 0xdd, 0xf1, 0xce, 0x64, 0x75, 0xc3, 0x70,
+	0x08, 0x13, 0x61, 0xcf, 0xa5, 0x62, 0xbf, 0x7c, 0xbf, 0x30, 0x26, 0x23, 0xaf, 0x89, 0x37, 0x77,
+	0x5d, 0xc7, 0xb2, 0xcd, 0xcc, 0x48, 0xcd, 0x45, 0xda, 0xd5, 0x97, 0x51, 0xb8, 0xd4, 0x2e, 0xa0,
+	0x64, 0x97, 0x86, 0x11, 0x5a, 0x26, 0x4e, 0x57, 0x01, 0x87, 0x3d, 0x0f, 0x69, 0xc6, 0xfb, 0x30,
+	0xca, 0x48, 0xf1, 0x61, 0x36, 0x47, 0x6d, 0x23, 0xeb, 0x90, 0x5d, 0xdd, 0x31, 0x58, 0x2c, 0x3a,
+	0x35, 0x30, 0x77, 0x7e, 0x69, 0xd2, 0xab, 0x56, 0xb5, 0xad, 0xb5, 0x2a, 0xad, 0x93, 0x7c, 0x9a,
+	0x5a, 0x76, 0x6a, 0xb9, 0x5a, 0xa6, 0xef, 0x5e, 0x26, 0xe6, 0x4d, 0xcb, 0x2d, 0x54, 0x72, 0xc9,
+	0x3c, 0x2d, 0xc9, 0x4e, 0xc9, 0x1f, 0x0b, 0xcc, 0x78, 0xa4, 0xb9, 0x7b, 0x65, 0xc2, 0x3c, 0x1f,
+	0x96, 0x19, 0xae, 0xc6, 0x4a, 0x51, 0xdb, 0xc8, 0x88, 0x48, 0x78, 0x1b, 0x20, 0x4f, 0x4b, 0x25,
+	0x8b, 0x31, 0x8b, 0xda, 0xb1, 0x81, 0x7e, 0xc5, 0xf5, 0x05, 0x51, 0xcb, 0x30, 0x1b, 0x2c, 0xf0,
+	0xed, 0x8a, 0xcb, 0x5c, 0xdd, 0x36, 0xaa, 0xf5, 0x11, 0x69, 0x9d, 0x72, 0x4f, 0xbf, 0x44, 0x30,
+	0xd7, 0x3e, 0xa4, 0xec, 0xea, 0x03, 0x38, 0xeb, 0xb5, 0x41, 0x0c, 0xed, 0x7b, 0xa1, 0x43, 0x1b,
+	0x02, 0x29, 0x27, 0xd9, 0x83, 0x53, 0x0b, 0x90, 0x08, 0x66, 0x91, 0xae, 0x15, 0xe5, 0x94, 0x09,
+	0x3f, 0x45, 0x30, 0xd5, 0x3a, 0x94, 0x24, 0xaa, 0x07, 0x5a, 0x2f, 0xb8, 0xae, 0x74, 0xc6, 0x75,
+	0x2d, 0x9f, 0xaf, 0x94, 0x2a, 0x45, 0xdd, 0x25, 0x46, 0x1d, 0x58, 0xd2, 0xf5, 0xb7, 0xfa, 0x69,
+	0x14, 0x26, 0x83, 0x79, 0xdc, 0x2d, 0xea, 0xac, 0x40, 0x4e, 0xb9, 0xc1, 0x78, 0x16, 0x86, 0x99,
+	0xab, 0x3b, 0xae, 0x65, 0x9b, 0xd9, 0x02, 0xb1, 0xcc, 0x82, 0x1b, 0x8b, 0x4e, 0xa1, 0xb9, 0xc1,
+	0xcc, 0x1b, 0x9e, 0xf9, 0x3a, 0xb7, 0xe2, 0x69, 0xb8, 0x40, 0x6c, 0xc3, 0x77, 0x6c, 0x80, 0x1f,
+	0x7b, 0x5d, 0x18, 0xe5, 0xa1, 0x6b, 0x00, 0xf5, 0x5b, 0x39, 0x36, 0xc8, 0x0b, 0x73, 0x29, 0xb0,
+	0x13, 0xe2, 0xe2, 0xaf, 0xdf, 0x5b, 0x26, 0x91, 0x84, 0x32, 0x3e, 0xcf, 0xab, 0xaf, 0x7d, 0x75,
+	0x90, 0x88, 0x3c, 0x3b, 0x48, 0x20, 0xf5, 0x27, 0x04, 0x6f, 0xb5, 0xa8, 0x83, 0x6c, 0xc6, 0x26,
+	0x9c, 0x65, 0xc2, 0x14, 0x43, 0x7c, 0x09, 0x2f, 0x77, 0xd6, 0x09, 0x8e, 0xb3, 0xb1, 0x43, 0x6c,
+	0xd7, 0x9b, 0x36, 0x09, 0x83, 0x3f, 0x0e, 0xb0, 0x88, 0x72, 0x16, 0xb3, 0x6d, 0x59, 0x88, 0x74,
+	0xfc, 0x34, 0xd4, 0x1f, 0xbd, 0xe4, 0xd7, 0x49, 0x91, 0x98, 0xdc, 0xd6, 0xb8, 0xa6, 0x86, 0x78,
+	0xd7, 0x4d, 0x17, 0x6b, 0x2e, 0x5e, 0x17, 0x9b, 0x0e, 0x43, 0xb4, 0xdb, 0x61, 0x10, 0x65, 0x7f,
+	0x75, 0x90, 0x88, 0xa8, 0x5f, 0x23, 0x88, 0xb7, 0xca, 0x5c, 0xd6, 0xfd, 0x91, 0x7f, 0xdb, 0xfb,
+	0x74, 0xf9, 0xd5, 0x2e, 0x80, 0x0a, 0xa8, 0xc7, 0xd2, 0xb9, 0x47, 0x5d, 0xbd, 0xd8, 0x97, 0x6a,
+	0xfa, 0xca, 0xf0, 0x37, 0x82, 0xe9, 0xd0, 0xb8, 0xb2, 0x16, 0xf7, 0x8f, 0xd7, 0xe2, 0x4a, 0xe8,
+	0x0c, 0xd6, 0xd1, 0xd6, 0xbd, 0xd8, 0x02, 0xf1, 0xd8, 0xbd, 0x87, 0x4d, 0x38, 0xe3, 0x56, 0xe3,
+	0xf5, 0xef, 0xb3, 0x26, 0xf0, 0x55, 0x47, 0x5e, 0xb0, 0xb5, 0x7c, 0x6a, 0x6b, 0xd2, 0xbf, 0xe2,
+	0xde, 0x84, 0xa9, 0xd6, 0x31, 0x65, 0x61, 0xe3, 0x00, 0xb5, 0x29, 0x15, 0xb5, 0x3d, 0x97, 0xf1,
+	0x59, 0x7c, 0x68, 0xbb, 0xf0, 0x76, 0x10, 0x6d, 0xcb, 0x72, 0x0b, 0x86, 0xa3, 0xef, 0xca, 0xc0,
+	0x7d, 0xa3, 0xb1, 0x03, 0x33, 0x6d, 0x02, 0xd7, 0x45, 0xcf, 0xae, 0x7c, 0xd5, 0xb9, 0xe8, 0xd9,
+	0x0d, 0x82, 0xf9, 0xe2, 0x4e, 0xc0, 0x38, 0x8f, 0x5b, 0xfd, 0x8c, 0x54, 0x6c, 0xcb, 0xdd, 0xdb,
+	0xa4, 0xb4, 0xe8, 0xa9, 0xca, 0x27, 0x08, 0x94, 0x66, 0x6f, 0x65, 0x2a, 0x04, 0x06, 0xcb, 0x94,
+	0x16, 0xfb, 0xb7, 0xb8, 0x1c, 0x7e, 0xe9, 0xe7, 0x51, 0x38, 0xc3, 0xb3, 0xc0, 0xcf, 0x10, 0x0c,
+	0x09, 0x91, 0x8a, 0xb5, 0xd0, 0xd5, 0x68, 0x54, 0xc8, 0xca, 0xe5, 0xce, 0x1d, 0x04, 0x3d, 0x75,
+	0xfe, 0x8b, 0x5f, 0xff, 0xfa, 0x36, 0x3a, 0x83, 0xa7, 0xb5, 0x30, 0xf5, 0x2e, 0x64, 0x32, 0xfe,
+	0x07, 0xc1, 0x78, 0x4b, 0xc5, 0x8a, 0x53, 0xed, 0x83, 0xb7, 0xd3, 0xd7, 0x4a, 0xba, 0x27, 0x0c,
+	0xc9, 0x29, 0xcd, 0x39, 0xad, 0xe2, 0x95, 0x50, 0x4e, 0xf5, 0xd5, 0xd0, 0x1e, 0x37, 0x7c, 0x11,
+	0xf6, 0xf1, 0x93, 0x28, 0x4c, 0x84, 0xc8, 0x2e, 0xbc, 0xde, 0x45, 0xa6, 0x2d, 0xb5, 0xa7, 0xb2,
+	0xd1, 0x23, 0x8a, 0x64, 0xbc, 0xc5, 0x19, 0xdf, 0xc1, 0xb7, 0x7b, 0x60, 0xac, 0xd1, 0x3a, 0xbe,
+	0xf7, 0x37, 0x02, 0x3e, 0x44, 0x70, 0xb1, 0x89, 0xbc, 0xc3, 0x1f, 0x74, 0x91, 0x77, 0x83, 0x00,
+	0x55, 0x56, 0x4f, 0xe8, 0x2d, 0xd9, 0xde, 0xe2, 0x6c, 0xaf, 0xe3, 0x6b, 0xbd, 0xb0, 0xad, 0x0b,
+	0x48, 0xfc, 0x1b, 0x82, 0x91, 0xe3, 0x9a, 0x09, 0xbf, 0xdf, 0x45, 0x8e, 0x41, 0xbd, 0xa9, 0x5c,
+	0x3d, 0x89, 0xab, 0xe4, 0x76, 0x83, 0x73, 0xdb, 0xc0, 0xe9, 0x5e, 0xb8, 0x79, 0xea, 0xec, 0x5f,
+	0x04, 0xa3, 0x0d, 0xaa, 0x04, 0x77, 0x90, 0x5e, 0x2b, 0x11, 0xa6, 0xac, 0x9c, 0xc8, 0x57, 0x72,
+	0xcb, 0x72, 0x6e, 0x9f, 0xe2, 0xad, 0x50, 0x6e, 0xb5, 0xef, 0x07, 0xd3, 0x1e, 0x37, 0x7c, 0x7e,
+	0xf6, 0x35, 0x39, 0x99, 0x4d, 0x77, 0xf6, 0x15, 0x82, 0x37, 0x9b, 0xcb, 0x0f, 0xfc, 0x61, 0x37,
+	0x89, 0x37, 0x11, 0x4c, 0xca, 0x47, 0x27, 0x07, 0xe8, 0xaa, 0xb5, 0x9d, 0xd1, 0xe7, 0x8b, 0xd9,
+	0x44, 0x0d, 0x74, 0xb2, 0x98, 0xad, 0x85, 0x8b, 0xb2, 0x7a, 0x42, 0xef, 0xae, 0x16, 0xb3, 0x0d,
+	0xc3, 0xfa, 0x6c, 0xe3, 0xff, 0x10, 0xc4, 0x5a, 0x69, 0x05, 0xbc, 0xd6, 0x45, 0xae, 0xcd, 0x05,
+	0x8e, 0x92, 0xea, 0x05, 0x42, 0x72, 0xbe, 0xc7, 0x39, 0xdf, 0xc2, 0x37, 0x7b, 0xe1, 0x7c, 0x5c,
+	0xec, 0xe0, 0x1f, 0x10, 0x5c, 0x08, 0xe8, 0x11, 0x7c, 0xa5, 0x7d, 0xae, 0xcd, 0xe4, 0x8d, 0xf2,
+	0x6e, 0xd7, 0x7e, 0x92, 0xd8, 0x32, 0x27, 0xb6, 0x80, 0xe7, 0x43, 0x89, 0xe5, 0x3d, 0xdf, 0x6c,
+	0x55, 0xc6, 0xa4, 0x6e, 0x3c, 0x3f, 0x8c, 0xa3, 0x17, 0x87, 0x71, 0xf4, 0xe7, 0x61, 0x1c, 0x7d,
+	0x73, 0x14, 0x8f, 0xbc, 0x38, 0x8a, 0x47, 0x7e, 0x3f, 0x8a, 0x47, 0x3e, 0x5b, 0x0c, 0xd5, 0x44,
+	0x9f, 0x07, 0xd1, 0xb9, 0x44, 0xca, 0x0d, 0xf1, 0x7f, 0x06, 0x2e, 0xff, 0x3f, 0x00, 0x9e, 0xe9,
+	0xb3, 0x0a, 0x1f, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -953,6 +1075,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries params of the distribution module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorDistributionInfo queries the self-delegation rewards and
+	// accumulated commission of a validator.
+	ValidatorDistributionInfo(ctx context.Context, in *QueryValidatorDistributionInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDistributionInfoResponse, error)
 	// ValidatorOutstandingRewards queries rewards of a validator address.
 	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
@@ -989,6 +1114,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorDistributionInfo(ctx context.Context, in *QueryValidatorDistributionInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDistributionInfoResponse, error) {
+	out := new(QueryValidatorDistributionInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
 	out := new(QueryValidatorOutstandingRewardsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards", in, out, opts...)
@@ -1065,6 +1199,9 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 type QueryServer interface {
 	// Params queries params of the distribution module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorDistributionInfo queries the self-delegation rewards and
+	// accumulated commission of a validator.
+	ValidatorDistributionInfo(context.Context, *QueryValidatorDistributionInfoRequest) (*QueryValidatorDistributionInfoResponse, error)
 	// ValidatorOutstandingRewards queries rewards of a validator address.
 	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
@@ -1091,6 +1228,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorDistributionInfo(ctx context.Context, req *QueryValidatorDistributionInfoRequest) (*QueryValidatorDistributionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDistributionInfo not implemented")
+}
 func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
 }
@@ -1138,6 +1278,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorDistributionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDistributionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDistributionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDistributionInfo(ctx, req.(*QueryValidatorDistributionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorOutstandingRewardsRequest)
 	if err := dec(in); err != nil {
@@ -1290,6 +1448,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorDistributionInfo",
+			Handler:    _Query_ValidatorDistributionInfo_Handler,
+		},
 		{
 			MethodName: "ValidatorOutstandingRewards",
 			Handler:    _Query_ValidatorOutstandingRewards_Handler,
@@ -1383,6 +1545,94 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorDistributionInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDistributionInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDistributionInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDistributionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDistributionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for iNdEx := len(m.SelfBondRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelfBondRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1978,6 +2228,44 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2351,6 +2639,238 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorDistributionInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDistributionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfBondRewards = append(m.SelfBondRewards, types.DecCoin{})
+			if err := m.SelfBondRewards[len(m.SelfBondRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,10 +1,19 @@
 package cli
 
 import (
-	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 // GetTxCmd returns a CLI command that has all the native evidence module tx
@@ -22,11 +31,12 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 	}
 
 	submitEvidenceCmd := SubmitEvidenceCmd()
+	submitEvidenceCmd.AddCommand(SubmitDuplicateVoteCmd())
 	for _, childCmd := range childCmds {
 		submitEvidenceCmd.AddCommand(childCmd)
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
@@ -36,9 +46,75 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 // under this command.
 func SubmitEvidenceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "submit",
-		Short: "Submit arbitrary evidence of misbehavior",
+		Use:                        "submit",
+		Short:                      "Submit arbitrary evidence of misbehavior",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	return cmd
 }
+
+// SubmitDuplicateVoteCmd returns a CLI command handler for submitting evidence
+// of a validator signing two conflicting votes.
+func SubmitDuplicateVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "duplicate-vote [vote-a-file] [vote-b-file]",
+		Short: "Submit evidence of a validator signing two conflicting votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of a validator signing two conflicting votes for the same
+height, round and step. Each file holds a JSON encoded Tendermint vote. The votes
+are verified against the validator set at their height, which must not have been
+pruned from the historical info kept by the staking module.
+
+Example:
+$ %s tx %s submit duplicate-vote vote_a.json vote_b.json --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voteA, err := readVote(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			voteB, err := readVote(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), types.NewDuplicateVote(voteA, voteB))
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readVote reads a JSON encoded Tendermint vote from a file.
+func readVote(cdc codec.JSONCodec, filename string) (*tmproto.Vote, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var vote tmproto.Vote
+	if err := cdc.UnmarshalJSON(bz, &vote); err != nil {
+		return nil, fmt.Errorf("failed to parse vote file %s: %w", filename, err)
+	}
+
+	return &vote, nil
+}
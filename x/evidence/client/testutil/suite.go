@@ -1,13 +1,24 @@
 package testutil
 
 import (
+	"bytes"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 type IntegrationTestSuite struct {
@@ -75,3 +86,82 @@ func (s *IntegrationTestSuite) TestGetQueryCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestSubmitDuplicateVoteCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	// sign conflicting votes with a key which is not part of the validator set
+	privKey := ed25519.GenPrivKey()
+	writeVote := func(blockHash byte) string {
+		vote := &tmproto.Vote{
+			Type:   tmproto.PrevoteType,
+			Height: 1,
+			BlockID: tmproto.BlockID{
+				Hash:          bytes.Repeat([]byte{blockHash}, tmhash.Size),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{blockHash}, tmhash.Size)},
+			},
+			Timestamp:        time.Now().UTC(),
+			ValidatorAddress: privKey.PubKey().Address(),
+		}
+
+		sig, err := privKey.Sign(tmtypes.VoteSignBytes(s.cfg.ChainID, vote))
+		s.Require().NoError(err)
+		vote.Signature = sig
+
+		bz, err := clientCtx.Codec.MarshalJSON(vote)
+		s.Require().NoError(err)
+
+		return testutil.WriteToNewTempFile(s.T(), string(bz)).Name()
+	}
+	voteA, voteB := writeVote(1), writeVote(2)
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"missing vote file",
+			append([]string{voteA, "does-not-exist.json"}, commonFlags...),
+			true, 0,
+		},
+		{
+			"invalid vote file",
+			append([]string{voteA, testutil.WriteToNewTempFile(s.T(), "{").Name()}, commonFlags...),
+			true, 0,
+		},
+		{
+			"validator not in the validator set",
+			append([]string{voteA, voteB}, commonFlags...),
+			false, types.ErrInvalidEvidence.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.SubmitDuplicateVoteCmd()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
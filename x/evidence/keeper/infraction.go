@@ -3,9 +3,12 @@ package keeper
 import (
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
@@ -102,13 +105,22 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
-	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
+	// ABCI, and now received as evidence.
+	k.slashAndTombstone(ctx, validator, consAddr, evidence.GetValidatorPower(), distributionHeight)
+	k.SetEvidence(ctx, evidence)
+}
+
+// slashAndTombstone slashes the validator committing a double-sign by the
+// double-sign slash fraction, then jails and tombstones it. The fraction is
+// passed in separately to slash unbonding and rebonding delegations.
+func (k Keeper) slashAndTombstone(
+	ctx sdk.Context, validator stakingtypes.ValidatorI, consAddr sdk.ConsAddress, power, distributionHeight int64,
+) {
 	k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
 		k.slashingKeeper.SlashFractionDoubleSign(ctx),
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 	)
 
 	// Jail the validator if not already jailed. This will begin unbonding the
@@ -119,5 +131,125 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
-	k.SetEvidence(ctx, evidence)
+}
+
+// NewDuplicateVoteHandler returns the evidence Handler for DuplicateVote
+// evidence submitted through MsgSubmitEvidence. It must be registered on the
+// evidence router under types.RouteDuplicateVote.
+func NewDuplicateVoteHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, evidence exported.Evidence) error {
+		e, ok := evidence.(*types.DuplicateVote)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", evidence)
+		}
+
+		return k.HandleDuplicateVote(ctx, e)
+	}
+}
+
+// HandleDuplicateVote verifies that a validator signed two conflicting votes
+// and, if so, slashes, jails and tombstones it the same way an Equivocation
+// does. Both vote signatures are verified against the consensus public key the
+// validator had in the historical validator set at the height of the votes.
+// Contrary to HandleEquivocationEvidence, an error is returned for evidence
+// which cannot be handled since it is submitted by users rather than by
+// Tendermint.
+//
+// The evidence is considered invalid if:
+// - it fails stateless validation
+// - it is from a future height or is too old
+// - the historical validator set at its height has been pruned
+// - the validator is not part of the historical validator set
+// - either vote signature is invalid
+// - the validator is unbonded or does not exist
+// - the signing info does not exist
+// - the validator is already tombstoned
+func (k Keeper) HandleDuplicateVote(ctx sdk.Context, evidence *types.DuplicateVote) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+
+	if infractionHeight > ctx.BlockHeight() {
+		return fmt.Errorf("duplicate vote height %d is greater than the current height %d", infractionHeight, ctx.BlockHeight())
+	}
+
+	// reject evidence if the double-sign is too old, using the same rules as for
+	// Equivocation
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Evidence != nil {
+		ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+		ageBlocks := ctx.BlockHeader().Height - infractionHeight
+		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
+			return fmt.Errorf("duplicate vote at height %d is too old", infractionHeight)
+		}
+	}
+
+	historicalInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, infractionHeight)
+	if !found {
+		return fmt.Errorf("no historical info found for height %d", infractionHeight)
+	}
+
+	var (
+		signer stakingtypes.Validator
+		signed bool
+	)
+	for _, val := range historicalInfo.Valset {
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		if valConsAddr.Equals(consAddr) {
+			signer, signed = val, true
+			break
+		}
+	}
+	if !signed {
+		return fmt.Errorf("validator %s is not part of the validator set at height %d", consAddr, infractionHeight)
+	}
+
+	pk, err := signer.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	chainID := ctx.ChainID()
+	if !pk.VerifySignature(tmtypes.VoteSignBytes(chainID, evidence.VoteA), evidence.VoteA.Signature) {
+		return fmt.Errorf("invalid signature on duplicate vote A from validator %s", consAddr)
+	}
+	if !pk.VerifySignature(tmtypes.VoteSignBytes(chainID, evidence.VoteB), evidence.VoteB.Signature) {
+		return fmt.Errorf("invalid signature on duplicate vote B from validator %s", consAddr)
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", consAddr)
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		return fmt.Errorf("no signing info found for validator %s", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s is already tombstoned", consAddr)
+	}
+
+	k.Logger(ctx).Info(
+		"confirmed duplicate vote",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_time", infractionTime,
+	)
+
+	// The power is the validator's power in the historical validator set, which
+	// is the power Tendermint would have reported in an Equivocation.
+	power := signer.ConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+	k.slashAndTombstone(ctx, validator, consAddr, power, distributionHeight)
+
+	return nil
 }
@@ -1,10 +1,19 @@
 package keeper_test
 
 import (
+	"bytes"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
@@ -111,3 +120,151 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) signVote(ctx sdk.Context, privKey cryptotypes.PrivKey, blockHash byte) *tmproto.Vote {
+	vote := &tmproto.Vote{
+		Type:   tmproto.PrevoteType,
+		Height: ctx.BlockHeight(),
+		Round:  0,
+		BlockID: tmproto.BlockID{
+			Hash:          bytes.Repeat([]byte{blockHash}, tmhash.Size),
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{blockHash}, tmhash.Size)},
+		},
+		Timestamp:        ctx.BlockTime(),
+		ValidatorAddress: privKey.PubKey().Address(),
+		ValidatorIndex:   0,
+	}
+
+	sig, err := privKey.Sign(tmtypes.VoteSignBytes(ctx.ChainID(), vote))
+	suite.Require().NoError(err)
+	vote.Signature = sig
+
+	return vote
+}
+
+func (suite *KeeperTestSuite) TestHandleDuplicateVote() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+
+	privKey := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	operatorAddr := sdk.ValAddress(privKey.PubKey().Address())
+	suite.NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.AccAddress(operatorAddr), initCoins))
+
+	power := int64(100)
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, privKey.PubKey(), power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info and record the validator set
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, privKey.PubKey().Address(), power, true)
+	suite.app.StakingKeeper.TrackHistoricalInfo(ctx)
+
+	voteA, voteB := suite.signVote(ctx, privKey, 1), suite.signVote(ctx, privKey, 2)
+	otherKey := ed25519.GenPrivKey()
+
+	testCases := []struct {
+		name     string
+		ctx      sdk.Context
+		evidence *types.DuplicateVote
+		expErr   string
+	}{
+		{
+			"same vote twice",
+			ctx,
+			&types.DuplicateVote{VoteA: voteA, VoteB: voteA},
+			"different blocks",
+		},
+		{
+			"future height",
+			ctx.WithBlockHeight(0),
+			types.NewDuplicateVote(voteA, voteB),
+			"greater than the current height",
+		},
+		{
+			"missing historical info",
+			ctx.WithBlockHeight(1000),
+			types.NewDuplicateVote(
+				suite.signVote(ctx.WithBlockHeight(1000), privKey, 1),
+				suite.signVote(ctx.WithBlockHeight(1000), privKey, 2),
+			),
+			"no historical info found",
+		},
+		{
+			"validator not in validator set",
+			ctx,
+			types.NewDuplicateVote(suite.signVote(ctx, otherKey, 1), suite.signVote(ctx, otherKey, 2)),
+			"is not part of the validator set",
+		},
+		{
+			"invalid signature",
+			ctx,
+			types.NewDuplicateVote(
+				voteA,
+				func() *tmproto.Vote {
+					vote := suite.signVote(ctx, otherKey, 2)
+					vote.ValidatorAddress = voteA.ValidatorAddress
+					return vote
+				}(),
+			),
+			"invalid signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := suite.app.EvidenceKeeper.HandleDuplicateVote(tc.ctx, tc.evidence)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), tc.expErr)
+		})
+	}
+
+	// the validator should not have been penalized by any invalid evidence
+	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// valid evidence
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.Require().NoError(suite.app.EvidenceKeeper.HandleDuplicateVote(ctx, types.NewDuplicateVote(voteA, voteB)))
+
+	// should be jailed and tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// tokens should be decreased by the double sign slash fraction
+	slashFraction := suite.app.SlashingKeeper.SlashFractionDoubleSign(ctx)
+	expTokens := oldTokens.Sub(slashFraction.MulInt(oldTokens).TruncateInt())
+	suite.Equal(expTokens, suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+
+	// submitting evidence for an already tombstoned validator fails
+	err := suite.app.EvidenceKeeper.HandleDuplicateVote(ctx, types.NewDuplicateVote(
+		suite.signVote(ctx, privKey, 3), suite.signVote(ctx, privKey, 4),
+	))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "already tombstoned")
+}
+
+func (suite *KeeperTestSuite) TestSubmitDuplicateVote() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+
+	privKey := ed25519.GenPrivKey()
+	voteA, voteB := suite.signVote(ctx, privKey, 1), suite.signVote(ctx, privKey, 2)
+
+	// the test keeper only routes Equivocation evidence
+	err := suite.app.EvidenceKeeper.SubmitEvidence(ctx, types.NewDuplicateVote(voteA, voteB))
+	suite.Require().ErrorIs(err, types.ErrNoEvidenceHandlerExists)
+
+	evidenceKeeper := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.StakingKeeper, suite.app.SlashingKeeper,
+	)
+	evidenceKeeper.SetRouter(types.NewRouter().AddRoute(types.RouteDuplicateVote, keeper.NewDuplicateVoteHandler(*evidenceKeeper)))
+
+	// the validator is unknown so the evidence is rejected and not persisted
+	evidence := types.NewDuplicateVote(voteA, voteB)
+	err = evidenceKeeper.SubmitEvidence(ctx, evidence)
+	suite.Require().ErrorIs(err, types.ErrInvalidEvidence)
+
+	_, found := evidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	suite.False(found)
+}
@@ -1,12 +1,17 @@
 package types
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +20,16 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation  = "equivocation"
+	TypeEquivocation   = "equivocation"
+	RouteDuplicateVote = "duplicatevote"
+	TypeDuplicateVote  = "duplicatevote"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &DuplicateVote{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +111,119 @@ func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// NewDuplicateVote returns a new DuplicateVote object from two conflicting
+// votes. The votes are sorted by block ID so that the same pair of votes always
+// results in the same evidence.
+func NewDuplicateVote(vote1, vote2 *tmproto.Vote) *DuplicateVote {
+	voteA, voteB := vote1, vote2
+	if strings.Compare(voteBlockIDKey(vote1), voteBlockIDKey(vote2)) > 0 {
+		voteA, voteB = vote2, vote1
+	}
+
+	return &DuplicateVote{
+		VoteA: voteA,
+		VoteB: voteB,
+	}
+}
+
+// Route returns the Evidence Handler route for a DuplicateVote type.
+func (e *DuplicateVote) Route() string { return RouteDuplicateVote }
+
+// Type returns the Evidence Handler type for a DuplicateVote type.
+func (e *DuplicateVote) Type() string { return TypeDuplicateVote }
+
+func (e *DuplicateVote) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a DuplicateVote object.
+func (e *DuplicateVote) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a DuplicateVote
+// object. The vote signatures can only be verified against the validator set
+// at the time of the infraction, which is done by the evidence handler.
+func (e *DuplicateVote) ValidateBasic() error {
+	if e.VoteA == nil || e.VoteB == nil {
+		return errors.New("invalid duplicate vote: both votes must be set")
+	}
+
+	voteA, err := tmtypes.VoteFromProto(e.VoteA)
+	if err != nil {
+		return fmt.Errorf("invalid duplicate vote A: %w", err)
+	}
+	voteB, err := tmtypes.VoteFromProto(e.VoteB)
+	if err != nil {
+		return fmt.Errorf("invalid duplicate vote B: %w", err)
+	}
+
+	if voteA.Height < 1 {
+		return fmt.Errorf("invalid duplicate vote height: %d", voteA.Height)
+	}
+	if voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return fmt.Errorf(
+			"duplicate votes must have the same height, round and type; got %d/%d/%s and %d/%d/%s",
+			voteA.Height, voteA.Round, voteA.Type, voteB.Height, voteB.Round, voteB.Type,
+		)
+	}
+	if !bytes.Equal(voteA.ValidatorAddress, voteB.ValidatorAddress) {
+		return fmt.Errorf(
+			"duplicate votes must be signed by the same validator; got %s and %s",
+			voteA.ValidatorAddress, voteB.ValidatorAddress,
+		)
+	}
+	if voteA.ValidatorIndex != voteB.ValidatorIndex {
+		return fmt.Errorf(
+			"duplicate votes must have the same validator index; got %d and %d",
+			voteA.ValidatorIndex, voteB.ValidatorIndex,
+		)
+	}
+	if voteA.BlockID.Equals(voteB.BlockID) {
+		return fmt.Errorf("duplicate votes must be for different blocks; got %s twice", voteA.BlockID)
+	}
+
+	// enforce votes are sorted by block ID so that the evidence hash is unique
+	if strings.Compare(voteA.BlockID.Key(), voteB.BlockID.Key()) >= 0 {
+		return errors.New("duplicate votes must be sorted by block ID")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator that
+// signed the conflicting votes.
+func (e DuplicateVote) GetConsensusAddress() sdk.ConsAddress {
+	return sdk.ConsAddress(e.VoteA.ValidatorAddress)
+}
+
+// GetHeight returns the height at which the conflicting votes were signed.
+func (e DuplicateVote) GetHeight() int64 {
+	return e.VoteA.Height
+}
+
+// GetTime returns the time at which the first conflicting vote was signed.
+func (e DuplicateVote) GetTime() time.Time {
+	return e.VoteA.Timestamp
+}
+
+// voteBlockIDKey returns the block ID key of a vote used to order the votes
+// of a DuplicateVote, or an empty key if the vote or its block ID is invalid.
+func voteBlockIDKey(vote *tmproto.Vote) string {
+	if vote == nil {
+		return ""
+	}
+
+	blockID, err := tmtypes.BlockIDFromProto(&vote.BlockID)
+	if err != nil {
+		return ""
+	}
+
+	return blockID.Key()
+}
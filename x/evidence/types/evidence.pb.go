@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -69,8 +70,52 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// DuplicateVote implements the Evidence interface and defines evidence of a
+// validator signing two conflicting votes for the same height, round and step.
+// Unlike Equivocation, which is reported by Tendermint, it may be submitted by
+// any account through MsgSubmitEvidence and is verified against the historical
+// validator set.
+type DuplicateVote struct {
+	// vote_a and vote_b are the conflicting votes, sorted by block ID.
+	VoteA *types.Vote `protobuf:"bytes,1,opt,name=vote_a,json=voteA,proto3" json:"vote_a,omitempty"`
+	VoteB *types.Vote `protobuf:"bytes,2,opt,name=vote_b,json=voteB,proto3" json:"vote_b,omitempty"`
+}
+
+func (m *DuplicateVote) Reset()      { *m = DuplicateVote{} }
+func (*DuplicateVote) ProtoMessage() {}
+func (*DuplicateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *DuplicateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateVote.Merge(m, src)
+}
+func (m *DuplicateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateVote proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*DuplicateVote)(nil), "cosmos.evidence.v1beta1.DuplicateVote")
 }
 
 func init() {
@@ -78,28 +123,32 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xbb, 0x4e, 0xf3, 0x30,
-	0x18, 0xb5, 0xff, 0xf6, 0xaf, 0x20, 0x74, 0x80, 0xa8, 0x82, 0xd0, 0xc1, 0xa9, 0x18, 0x50, 0x97,
-	0x26, 0x2a, 0x2c, 0x88, 0x8d, 0x4a, 0x9d, 0xd8, 0x0a, 0x13, 0x4b, 0x95, 0x8b, 0x71, 0x2d, 0x48,
-	0xbe, 0x10, 0x3b, 0x05, 0xde, 0x80, 0xb1, 0x23, 0x63, 0x47, 0x1e, 0x80, 0x87, 0xa8, 0xc4, 0x52,
-	0x31, 0x31, 0x01, 0x4a, 0x17, 0x1e, 0x03, 0xd5, 0x76, 0xcb, 0x64, 0x9f, 0xe3, 0x73, 0xb1, 0xfd,
-	0x59, 0x87, 0x11, 0x88, 0x04, 0x84, 0x4f, 0xc7, 0x3c, 0xa6, 0x69, 0x44, 0xfd, 0x71, 0x37, 0xa4,
-	0x32, 0xe8, 0xae, 0x09, 0x2f, 0xcb, 0x41, 0x82, 0xbd, 0xa7, 0x75, 0xde, 0x9a, 0x36, 0xba, 0x66,
-	0x83, 0x01, 0x03, 0xa5, 0xf1, 0x97, 0x3b, 0x2d, 0x6f, 0xba, 0x0c, 0x80, 0xdd, 0x52, 0x5f, 0xa1,
-	0xb0, 0xb8, 0xf6, 0x25, 0x4f, 0xa8, 0x90, 0x41, 0x92, 0x19, 0xc1, 0xbe, 0xce, 0x1b, 0x6a, 0xa7,
-	0x09, 0x57, 0xe0, 0xe0, 0x0d, 0x5b, 0xf5, 0xfe, 0x5d, 0xc1, 0xc7, 0x10, 0x05, 0x92, 0x43, 0x6a,
-	0xef, 0x5a, 0xb5, 0x11, 0xe5, 0x6c, 0x24, 0x1d, 0xdc, 0xc2, 0xed, 0xca, 0xc0, 0x20, 0xfb, 0xc4,
-	0xaa, 0x2e, 0x63, 0x9d, 0x7f, 0x2d, 0xdc, 0xde, 0x3a, 0x6a, 0x7a, 0xba, 0xd3, 0x5b, 0x75, 0x7a,
-	0x97, 0xab, 0xce, 0xde, 0xc6, 0xec, 0xd3, 0x45, 0x93, 0x2f, 0x17, 0x0f, 0x94, 0xc3, 0x6e, 0x58,
-	0xff, 0x33, 0xb8, 0xa7, 0xb9, 0x53, 0x51, 0x81, 0x1a, 0xd8, 0x7d, 0x6b, 0x27, 0x82, 0x54, 0xd0,
-	0x54, 0x14, 0x62, 0x18, 0xc4, 0x71, 0x4e, 0x85, 0x70, 0xaa, 0x2d, 0xdc, 0xde, 0xec, 0x39, 0xef,
-	0xaf, 0x9d, 0x86, 0xb9, 0xe5, 0x99, 0x3e, 0xb9, 0x90, 0x39, 0x4f, 0xd9, 0x60, 0x7b, 0x6d, 0x31,
-	0xfc, 0x69, 0xfd, 0x69, 0xea, 0xa2, 0xe7, 0xa9, 0x8b, 0x7e, 0xa6, 0x2e, 0xea, 0x9d, 0xbf, 0x94,
-	0x04, 0xcf, 0x4a, 0x82, 0xe7, 0x25, 0xc1, 0xdf, 0x25, 0xc1, 0x93, 0x05, 0x41, 0xf3, 0x05, 0x41,
-	0x1f, 0x0b, 0x82, 0xae, 0x3a, 0x8c, 0xcb, 0x51, 0x11, 0x7a, 0x11, 0x24, 0xe6, 0x13, 0xcc, 0xd2,
-	0x11, 0xf1, 0x8d, 0xff, 0xf0, 0x37, 0x16, 0xf9, 0x98, 0x51, 0x11, 0xd6, 0xd4, 0xdb, 0x8e, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x32, 0xf0, 0x2c, 0xb6, 0x01, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbd, 0xae, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0x7b, 0xdb, 0x0a, 0xdc, 0x22, 0x41, 0x54, 0x95, 0x50, 0xa1, 0xa4, 0xea, 0x80,
+	0xba, 0xc4, 0x51, 0xcb, 0x82, 0xd8, 0x1a, 0xd1, 0x89, 0x2d, 0x20, 0x06, 0x96, 0x2a, 0x1f, 0x87,
+	0xd4, 0xa2, 0x89, 0x43, 0xec, 0x04, 0x10, 0x2f, 0xc0, 0xd8, 0x91, 0xb1, 0x23, 0x0f, 0xc0, 0x43,
+	0x54, 0x62, 0xa9, 0x98, 0x98, 0x00, 0xa5, 0x0b, 0x8f, 0x81, 0x12, 0xbb, 0xa9, 0x98, 0xee, 0x92,
+	0xf8, 0x9c, 0xf3, 0x3b, 0x5f, 0x7f, 0x1b, 0x3f, 0x0a, 0x19, 0x4f, 0x18, 0x77, 0xa0, 0xa4, 0x11,
+	0xa4, 0x21, 0x38, 0xe5, 0x3c, 0x00, 0xe1, 0xcf, 0x5b, 0x07, 0xc9, 0x72, 0x26, 0x98, 0x7e, 0x5f,
+	0x72, 0xa4, 0x75, 0x2b, 0x6e, 0x3c, 0x8c, 0x59, 0xcc, 0x1a, 0xc6, 0xa9, 0x4f, 0x12, 0x1f, 0x5b,
+	0x31, 0x63, 0xf1, 0x16, 0x9c, 0xc6, 0x0a, 0x8a, 0x37, 0x8e, 0xa0, 0x09, 0x70, 0xe1, 0x27, 0x99,
+	0x02, 0x1e, 0xc8, 0x7a, 0x6b, 0x99, 0xa9, 0x8a, 0xcb, 0xd0, 0x43, 0x01, 0x69, 0x04, 0x79, 0x42,
+	0x53, 0xe1, 0x88, 0x8f, 0x19, 0x70, 0xf9, 0x95, 0xd1, 0xe9, 0x77, 0x84, 0x07, 0xab, 0x77, 0x05,
+	0x2d, 0x59, 0xe8, 0x0b, 0xca, 0x52, 0x7d, 0x84, 0x7b, 0x1b, 0xa0, 0xf1, 0x46, 0x18, 0x68, 0x82,
+	0x66, 0xd7, 0x9e, 0xb2, 0xf4, 0x27, 0xb8, 0x53, 0x37, 0x35, 0xae, 0x26, 0x68, 0xd6, 0x5f, 0x8c,
+	0x89, 0x9c, 0x88, 0x9c, 0x27, 0x22, 0x2f, 0xcf, 0x13, 0xb9, 0xb7, 0x0e, 0xbf, 0x2c, 0x6d, 0xf7,
+	0xdb, 0x42, 0x5e, 0x93, 0xa1, 0x0f, 0x71, 0x37, 0x63, 0xef, 0x21, 0x37, 0xae, 0x9b, 0x82, 0xd2,
+	0xd0, 0x57, 0xf8, 0x5e, 0xc8, 0x52, 0x0e, 0x29, 0x2f, 0xf8, 0xda, 0x8f, 0xa2, 0x1c, 0x38, 0x37,
+	0x3a, 0x13, 0x34, 0xbb, 0xed, 0x1a, 0x3f, 0xbe, 0xd9, 0x43, 0xb5, 0xc3, 0x52, 0x46, 0x5e, 0x88,
+	0x9c, 0xa6, 0xb1, 0x77, 0xb7, 0x4d, 0x51, 0xfe, 0xa7, 0x83, 0xcf, 0x7b, 0x4b, 0xfb, 0xb2, 0xb7,
+	0xb4, 0xbf, 0x7b, 0x4b, 0x9b, 0x7e, 0xc2, 0x77, 0x9e, 0x15, 0xd9, 0x96, 0x86, 0xbe, 0x80, 0x57,
+	0x4c, 0x80, 0x6e, 0xe3, 0x5e, 0xc9, 0x04, 0xac, 0xfd, 0x66, 0x9b, 0xfe, 0x62, 0x44, 0x2e, 0x6a,
+	0x10, 0xa9, 0x43, 0xcd, 0x79, 0xdd, 0x9a, 0x5a, 0xb6, 0x78, 0x60, 0x5c, 0xdd, 0x8c, 0xbb, 0xff,
+	0x37, 0x77, 0x9f, 0x7f, 0xad, 0x4c, 0x74, 0xa8, 0x4c, 0x74, 0xac, 0x4c, 0xf4, 0xa7, 0x32, 0xd1,
+	0xee, 0x64, 0x6a, 0xc7, 0x93, 0xa9, 0xfd, 0x3c, 0x99, 0xda, 0x6b, 0x3b, 0xa6, 0x62, 0x53, 0x04,
+	0x24, 0x64, 0x89, 0xba, 0x1f, 0xf5, 0xb3, 0x79, 0xf4, 0xd6, 0xf9, 0x70, 0x79, 0x31, 0x4d, 0x9b,
+	0xa0, 0xd7, 0x08, 0xfb, 0xf8, 0xdf, 0x00, 0xe6, 0xcd, 0x20, 0x7d, 0x51, 0x02, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -150,6 +199,53 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DuplicateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteB != nil {
+		{
+			size, err := m.VoteB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VoteA != nil {
+		{
+			size, err := m.VoteA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -182,6 +278,23 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *DuplicateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteA != nil {
+		l = m.VoteA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.VoteB != nil {
+		l = m.VoteB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -341,6 +454,128 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DuplicateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteA == nil {
+				m.VoteA = &types.Vote{}
+			}
+			if err := m.VoteA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteB == nil {
+				m.VoteB = &types.Vote{}
+			}
+			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
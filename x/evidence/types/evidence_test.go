@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -88,3 +91,99 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	require.Equal(t, tmEvidence.Validator.Address, consAddr.Bytes())
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
+
+func makeVote(blockHash byte, mutate func(*tmproto.Vote)) *tmproto.Vote {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	vote := &tmproto.Vote{
+		Type:   tmproto.PrevoteType,
+		Height: 100,
+		Round:  0,
+		BlockID: tmproto.BlockID{
+			Hash:          bytes.Repeat([]byte{blockHash}, tmhash.Size),
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{blockHash}, tmhash.Size)},
+		},
+		Timestamp:        n,
+		ValidatorAddress: []byte("foo_________________"),
+		ValidatorIndex:   0,
+		Signature:        []byte("signature"),
+	}
+	if mutate != nil {
+		mutate(vote)
+	}
+
+	return vote
+}
+
+func TestDuplicateVote_Valid(t *testing.T) {
+	voteA, voteB := makeVote(1, nil), makeVote(2, nil)
+
+	e := types.NewDuplicateVote(voteB, voteA)
+	require.Equal(t, voteA, e.VoteA)
+	require.Equal(t, voteB, e.VoteB)
+	require.Equal(t, types.NewDuplicateVote(voteA, voteB).Hash(), e.Hash())
+
+	require.Equal(t, sdk.ConsAddress("foo_________________"), e.GetConsensusAddress())
+	require.Equal(t, int64(100), e.GetHeight())
+	require.Equal(t, voteA.Timestamp, e.GetTime())
+	require.Equal(t, types.TypeDuplicateVote, e.Type())
+	require.Equal(t, types.RouteDuplicateVote, e.Route())
+	require.NoError(t, e.ValidateBasic())
+}
+
+func TestDuplicateVoteValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		e         *types.DuplicateVote
+		expectErr bool
+	}{
+		{"valid", types.NewDuplicateVote(makeVote(1, nil), makeVote(2, nil)), false},
+		{"missing vote", &types.DuplicateVote{VoteA: makeVote(1, nil)}, true},
+		{"unsorted votes", &types.DuplicateVote{VoteA: makeVote(2, nil), VoteB: makeVote(1, nil)}, true},
+		{"same block", &types.DuplicateVote{VoteA: makeVote(1, nil), VoteB: makeVote(1, nil)}, true},
+		{
+			"invalid height",
+			types.NewDuplicateVote(
+				makeVote(1, func(v *tmproto.Vote) { v.Height = 0 }),
+				makeVote(2, func(v *tmproto.Vote) { v.Height = 0 }),
+			),
+			true,
+		},
+		{
+			"different heights",
+			types.NewDuplicateVote(makeVote(1, nil), makeVote(2, func(v *tmproto.Vote) { v.Height = 101 })),
+			true,
+		},
+		{
+			"different rounds",
+			types.NewDuplicateVote(makeVote(1, nil), makeVote(2, func(v *tmproto.Vote) { v.Round = 1 })),
+			true,
+		},
+		{
+			"different types",
+			types.NewDuplicateVote(makeVote(1, nil), makeVote(2, func(v *tmproto.Vote) { v.Type = tmproto.PrecommitType })),
+			true,
+		},
+		{
+			"different validators",
+			types.NewDuplicateVote(makeVote(1, nil), makeVote(2, func(v *tmproto.Vote) { v.ValidatorAddress = []byte("bar_________________") })),
+			true,
+		},
+		{
+			"different validator indexes",
+			types.NewDuplicateVote(makeVote(1, nil), makeVote(2, func(v *tmproto.Vote) { v.ValidatorIndex = 1 })),
+			true,
+		},
+		{
+			"missing signature",
+			types.NewDuplicateVote(makeVote(1, nil), makeVote(2, func(v *tmproto.Vote) { v.Signature = nil })),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
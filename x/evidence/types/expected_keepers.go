@@ -13,6 +13,8 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		GetHistoricalInfo(sdk.Context, int64) (stakingtypes.HistoricalInfo, bool)
+		PowerReduction(sdk.Context) sdk.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
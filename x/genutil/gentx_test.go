@@ -234,13 +234,14 @@ func (suite *GenTxTestSuite) TestDeliverGenTxs() {
 				_ = suite.setAccountBalance(addr2, 0)
 
 				msg := banktypes.NewMsgSend(addr1, addr2, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)})
+				accNum := suite.app.AccountKeeper.GetAccount(suite.ctx, addr1).GetAccountNumber()
 				tx, err := helpers.GenTx(
 					suite.encodingConfig.TxConfig,
 					[]sdk.Msg{msg},
 					sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)},
 					helpers.DefaultGenTxGas,
 					suite.ctx.ChainID(),
-					[]uint64{7},
+					[]uint64{accNum},
 					[]uint64{0},
 					priv1,
 				)
@@ -31,11 +31,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryProposals(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
+		GetCmdQueryVotesByVoter(),
 		GetCmdQueryParam(),
 		GetCmdQueryParams(),
 		GetCmdQueryProposer(),
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
+		GetCmdQueryDepositsByDepositor(),
 		GetCmdQueryTally(),
 	)
 
@@ -335,6 +337,59 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryVotesByVoter implements the command to query for the votes of a
+// voter.
+func GetCmdQueryVotesByVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-voter [voter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query votes cast by a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the votes cast by a voter on the proposals which are still in their
+voting period.
+
+Example:
+$ %[1]s query gov votes-by-voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query gov votes-by-voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotesByVoter(
+				cmd.Context(),
+				&types.QueryVotesByVoterRequest{Voter: voterAddr.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-voter")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryDeposit implements the query proposal deposit command. Command to
 // get a specific Deposit Information
 func GetCmdQueryDeposit() *cobra.Command {
@@ -454,6 +509,59 @@ $ %s query gov deposits 1
 	return cmd
 }
 
+// GetCmdQueryDepositsByDepositor implements the command to query for the
+// deposits of a depositor.
+func GetCmdQueryDepositsByDepositor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits-by-depositor [depositor-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query deposits made by a depositor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposits made by a depositor which have not been refunded or burned
+yet.
+
+Example:
+$ %[1]s query gov deposits-by-depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query gov deposits-by-depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			depositorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DepositsByDepositor(
+				cmd.Context(),
+				&types.QueryDepositsByDepositorRequest{Depositor: depositorAddr.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "deposits-by-depositor")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryTally implements the command to query for proposal tally result.
 func GetCmdQueryTally() *cobra.Command {
 	cmd := &cobra.Command{
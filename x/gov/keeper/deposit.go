@@ -31,6 +31,14 @@ func (keeper Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
 	}
 
 	store.Set(types.DepositKey(deposit.ProposalId, depositor), bz)
+	store.Set(types.DepositByDepositorKey(depositor, deposit.ProposalId), []byte{})
+}
+
+// deleteDeposit deletes a deposit from a given proposalID and depositor from the store
+func (keeper Keeper) deleteDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.DepositKey(proposalID, depositorAddr))
+	store.Delete(types.DepositByDepositorKey(depositorAddr, proposalID))
 }
 
 // GetAllDeposits returns all the deposits from the store
@@ -55,8 +63,6 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 
 // DeleteAndBurnDeposits deletes and burn all the deposits on a specific proposal.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
 		if err != nil {
@@ -67,7 +73,7 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 		if err != nil {
 			panic(err)
 		}
-		store.Delete(types.DepositKey(proposalID, depositor))
+		keeper.deleteDeposit(ctx, proposalID, depositor)
 		return false
 	})
 }
@@ -168,8 +174,6 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
 		if err != nil {
@@ -181,7 +185,7 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 			panic(err)
 		}
 
-		store.Delete(types.DepositKey(proposalID, depositor))
+		keeper.deleteDeposit(ctx, proposalID, depositor)
 		return false
 	})
 }
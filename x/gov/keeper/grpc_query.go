@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -155,6 +156,44 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+// VotesByVoter returns the votes cast by a voter
+func (q Keeper) VotesByVoter(c context.Context, req *types.QueryVotesByVoterRequest) (*types.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes types.Votes
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	votesStore := prefix.NewStore(store, types.VotesByVoterKey(voter))
+
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, _ []byte) error {
+		vote, found := q.GetVote(ctx, types.GetProposalIDFromBytes(key), voter)
+		if !found {
+			return fmt.Errorf("vote of %s on proposal %d not found", req.Voter, types.GetProposalIDFromBytes(key))
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByVoterResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
 // Params queries all params
 func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -244,6 +283,44 @@ func (q Keeper) Deposits(c context.Context, req *types.QueryDepositsRequest) (*t
 	return &types.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
+// DepositsByDepositor returns the deposits of a depositor
+func (q Keeper) DepositsByDepositor(c context.Context, req *types.QueryDepositsByDepositorRequest) (*types.QueryDepositsByDepositorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Depositor == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty depositor address")
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	var deposits types.Deposits
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	depositStore := prefix.NewStore(store, types.DepositsByDepositorKey(depositor))
+
+	pageRes, err := query.Paginate(depositStore, req.Pagination, func(key []byte, _ []byte) error {
+		deposit, found := q.GetDeposit(ctx, types.GetProposalIDFromBytes(key), depositor)
+		if !found {
+			return fmt.Errorf("deposit of %s on proposal %d not found", req.Depositor, types.GetProposalIDFromBytes(key))
+		}
+
+		deposits = append(deposits, deposit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositsByDepositorResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
 // TallyResult queries the tally of a proposal vote
 func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
 	if req == nil {
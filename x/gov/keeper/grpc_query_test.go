@@ -434,6 +434,116 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryVotesByVoter() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	var (
+		req    *types.QueryVotesByVoterRequest
+		expRes *types.QueryVotesByVoterResponse
+		props  []types.Proposal
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryVotesByVoterRequest{}
+			},
+			false,
+		},
+		{
+			"invalid voter address",
+			func() {
+				req = &types.QueryVotesByVoterRequest{Voter: "invalid"}
+			},
+			false,
+		},
+		{
+			"voter without votes",
+			func() {
+				req = &types.QueryVotesByVoterRequest{Voter: addrs[0].String()}
+				expRes = &types.QueryVotesByVoterResponse{}
+			},
+			true,
+		},
+		{
+			"votes on 2 proposals",
+			func() {
+				props = nil
+				for i := 0; i < 2; i++ {
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+					suite.Require().NoError(err)
+					proposal.Status = types.StatusVotingPeriod
+					app.GovKeeper.SetProposal(ctx, proposal)
+					props = append(props, proposal)
+				}
+
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, props[0].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, props[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, props[1].ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionAbstain)))
+
+				req = &types.QueryVotesByVoterRequest{Voter: addrs[0].String()}
+				expRes = &types.QueryVotesByVoterResponse{
+					Votes: types.Votes{
+						{ProposalId: props[0].ProposalId, Voter: addrs[0].String(), Option: types.OptionYes, Options: types.NewNonSplitVoteOption(types.OptionYes)},
+						{ProposalId: props[1].ProposalId, Voter: addrs[0].String(), Option: types.OptionNo, Options: types.NewNonSplitVoteOption(types.OptionNo)},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"votes with pagination",
+			func() {
+				req = &types.QueryVotesByVoterRequest{
+					Voter:      addrs[0].String(),
+					Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+				}
+				expRes = &types.QueryVotesByVoterResponse{
+					Votes: types.Votes{
+						{ProposalId: props[1].ProposalId, Voter: addrs[0].String(), Option: types.OptionNo, Options: types.NewNonSplitVoteOption(types.OptionNo)},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"votes are removed once tallied",
+			func() {
+				app.GovKeeper.Tally(ctx, props[0])
+
+				req = &types.QueryVotesByVoterRequest{Voter: addrs[0].String()}
+				expRes = &types.QueryVotesByVoterResponse{
+					Votes: types.Votes{
+						{ProposalId: props[1].ProposalId, Voter: addrs[0].String(), Option: types.OptionNo, Options: types.NewNonSplitVoteOption(types.OptionNo)},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			votes, err := queryClient.VotesByVoter(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.GetVotes(), votes.GetVotes())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(votes)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	queryClient := suite.queryClient
 
@@ -710,6 +820,113 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryDepositsByDepositor() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	var (
+		req    *types.QueryDepositsByDepositorRequest
+		expRes *types.QueryDepositsByDepositorResponse
+		props  []types.Proposal
+	)
+
+	depositAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{}
+			},
+			false,
+		},
+		{
+			"invalid depositor address",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{Depositor: "invalid"}
+			},
+			false,
+		},
+		{
+			"depositor without deposits",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{Depositor: addrs[0].String()}
+				expRes = &types.QueryDepositsByDepositorResponse{}
+			},
+			true,
+		},
+		{
+			"deposits on 2 proposals",
+			func() {
+				props = nil
+				for i := 0; i < 2; i++ {
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+					suite.Require().NoError(err)
+					props = append(props, proposal)
+
+					_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], depositAmount)
+					suite.Require().NoError(err)
+				}
+				_, err := app.GovKeeper.AddDeposit(ctx, props[1].ProposalId, addrs[1], depositAmount)
+				suite.Require().NoError(err)
+
+				req = &types.QueryDepositsByDepositorRequest{Depositor: addrs[0].String()}
+				expRes = &types.QueryDepositsByDepositorResponse{
+					Deposits: types.Deposits{
+						types.NewDeposit(props[0].ProposalId, addrs[0], depositAmount),
+						types.NewDeposit(props[1].ProposalId, addrs[0], depositAmount),
+					},
+				}
+			},
+			true,
+		},
+		{
+			"deposits with pagination",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{
+					Depositor:  addrs[0].String(),
+					Pagination: &query.PageRequest{Limit: 1},
+				}
+				expRes = &types.QueryDepositsByDepositorResponse{
+					Deposits: types.Deposits{types.NewDeposit(props[0].ProposalId, addrs[0], depositAmount)},
+				}
+			},
+			true,
+		},
+		{
+			"deposits are removed once refunded",
+			func() {
+				app.GovKeeper.RefundAndDeleteDeposits(ctx, props[0].ProposalId)
+
+				req = &types.QueryDepositsByDepositorRequest{Depositor: addrs[0].String()}
+				expRes = &types.QueryDepositsByDepositorResponse{
+					Deposits: types.Deposits{types.NewDeposit(props[1].ProposalId, addrs[0], depositAmount)},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			deposits, err := queryClient.DepositsByDepositor(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.GetDeposits(), deposits.GetDeposits())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(deposits)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 
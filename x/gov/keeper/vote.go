@@ -89,6 +89,7 @@ func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
 		panic(err)
 	}
 	store.Set(types.VoteKey(vote.ProposalId, addr), bz)
+	store.Set(types.VoteByVoterKey(addr, vote.ProposalId), []byte{})
 }
 
 // IterateAllVotes iterates over the all the stored votes and performs a callback function
@@ -129,6 +130,7 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
+	store.Delete(types.VoteByVoterKey(voterAddr, proposalID))
 }
 
 // populateLegacyOption adds graceful fallback of deprecated `Option` field, in case
@@ -0,0 +1,39 @@
+package v046
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// addIndexes back-fills the index keys returned by indexKey for all the keys
+// stored under prefixBz, which are split by splitKey.
+func addIndexes(
+	store sdk.KVStore, prefixBz []byte,
+	splitKey func(key []byte) (uint64, sdk.AccAddress),
+	indexKey func(addr sdk.AccAddress, proposalID uint64) []byte,
+) {
+	iterator := sdk.KVStorePrefixIterator(store, prefixBz)
+
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, addr := splitKey(iterator.Key())
+		indexKeys = append(indexKeys, indexKey(addr, proposalID))
+	}
+	iterator.Close()
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.46. The
+// migration includes:
+//
+// - Add the votes by voter and deposits by depositor indexes.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	addIndexes(store, types.VotesKeyPrefix, types.SplitKeyVote, types.VoteByVoterKey)
+	addIndexes(store, types.DepositsKeyPrefix, types.SplitKeyDeposit, types.DepositByDepositorKey)
+	return nil
+}
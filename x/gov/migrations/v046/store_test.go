@@ -0,0 +1,43 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	vote := types.NewVote(1, addr1, types.NewNonSplitVoteOption(types.OptionYes))
+	store.Set(types.VoteKey(1, addr1), cdc.MustMarshal(&vote))
+	vote = types.NewVote(2, addr1, types.NewNonSplitVoteOption(types.OptionNo))
+	store.Set(types.VoteKey(2, addr1), cdc.MustMarshal(&vote))
+
+	deposit := types.NewDeposit(1, addr2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	store.Set(types.DepositKey(1, addr2), cdc.MustMarshal(&deposit))
+
+	require.False(t, store.Has(types.VoteByVoterKey(addr1, 1)))
+	require.False(t, store.Has(types.DepositByDepositorKey(addr2, 1)))
+
+	require.NoError(t, v046gov.MigrateStore(ctx, govKey))
+
+	require.True(t, store.Has(types.VoteByVoterKey(addr1, 1)))
+	require.True(t, store.Has(types.VoteByVoterKey(addr1, 2)))
+	require.False(t, store.Has(types.VoteByVoterKey(addr2, 1)))
+	require.True(t, store.Has(types.DepositByDepositorKey(addr2, 1)))
+	require.False(t, store.Has(types.DepositByDepositorKey(addr1, 1)))
+}
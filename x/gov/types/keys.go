@@ -36,16 +36,22 @@ const (
 //
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
+// - 0x11<depositorAddrLen (1 Byte)><depositorAddr_Bytes><proposalID_Bytes>: []byte{}
+//
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x21<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
 
-	DepositsKeyPrefix = []byte{0x10}
+	DepositsKeyPrefix            = []byte{0x10}
+	DepositsByDepositorKeyPrefix = []byte{0x11}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix        = []byte{0x20}
+	VotesByVoterKeyPrefix = []byte{0x21}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +113,29 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// DepositsByDepositorKey gets the first part of the deposits by depositor index
+// key based on the depositor address
+func DepositsByDepositorKey(depositorAddr sdk.AccAddress) []byte {
+	return append(DepositsByDepositorKeyPrefix, address.MustLengthPrefix(depositorAddr.Bytes())...)
+}
+
+// DepositByDepositorKey key of a specific deposit in the deposits by depositor
+// index
+func DepositByDepositorKey(depositorAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(DepositsByDepositorKey(depositorAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// VotesByVoterKey gets the first part of the votes by voter index key based on
+// the voter address
+func VotesByVoterKey(voterAddr sdk.AccAddress) []byte {
+	return append(VotesByVoterKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoteByVoterKey key of a specific vote in the votes by voter index
+func VoteByVoterKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(VotesByVoterKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
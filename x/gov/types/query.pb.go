@@ -419,6 +419,104 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryVotesByVoterRequest is the request type for the Query/VotesByVoter RPC
+// method.
+type QueryVotesByVoterRequest struct {
+	// voter defines the voter address to query votes for.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotesByVoterRequest) Reset()         { *m = QueryVotesByVoterRequest{} }
+func (m *QueryVotesByVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesByVoterRequest) ProtoMessage()    {}
+func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{8}
+}
+func (m *QueryVotesByVoterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesByVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesByVoterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotesByVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesByVoterRequest.Merge(m, src)
+}
+func (m *QueryVotesByVoterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesByVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesByVoterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotesByVoterRequest proto.InternalMessageInfo
+
+// QueryVotesByVoterResponse is the response type for the Query/VotesByVoter
+// RPC method.
+type QueryVotesByVoterResponse struct {
+	// votes defined the queried votes, ordered by proposal id.
+	Votes []Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotesByVoterResponse) Reset()         { *m = QueryVotesByVoterResponse{} }
+func (m *QueryVotesByVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesByVoterResponse) ProtoMessage()    {}
+func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{9}
+}
+func (m *QueryVotesByVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesByVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesByVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotesByVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesByVoterResponse.Merge(m, src)
+}
+func (m *QueryVotesByVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesByVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesByVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotesByVoterResponse proto.InternalMessageInfo
+
+func (m *QueryVotesByVoterResponse) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *QueryVotesByVoterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
@@ -430,7 +528,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{8}
+	return fileDescriptor_e35c0d133e91c0a2, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +578,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{9}
+	return fileDescriptor_e35c0d133e91c0a2, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +640,7 @@ func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
 func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositRequest) ProtoMessage()    {}
 func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{10}
+	return fileDescriptor_e35c0d133e91c0a2, []int{12}
 }
 func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +679,7 @@ func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
 func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositResponse) ProtoMessage()    {}
 func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{11}
+	return fileDescriptor_e35c0d133e91c0a2, []int{13}
 }
 func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +727,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{12}
+	return fileDescriptor_e35c0d133e91c0a2, []int{14}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +781,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{13}
+	return fileDescriptor_e35c0d133e91c0a2, []int{15}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,6 +824,104 @@ func (m *QueryDepositsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDepositsByDepositorRequest is the request type for the
+// Query/DepositsByDepositor RPC method.
+type QueryDepositsByDepositorRequest struct {
+	// depositor defines the depositor address to query deposits for.
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositsByDepositorRequest) Reset()         { *m = QueryDepositsByDepositorRequest{} }
+func (m *QueryDepositsByDepositorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsByDepositorRequest) ProtoMessage()    {}
+func (*QueryDepositsByDepositorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{16}
+}
+func (m *QueryDepositsByDepositorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositsByDepositorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositsByDepositorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositsByDepositorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositsByDepositorRequest.Merge(m, src)
+}
+func (m *QueryDepositsByDepositorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositsByDepositorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositsByDepositorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositsByDepositorRequest proto.InternalMessageInfo
+
+// QueryDepositsByDepositorResponse is the response type for the
+// Query/DepositsByDepositor RPC method.
+type QueryDepositsByDepositorResponse struct {
+	// deposits defined the queried deposits, ordered by proposal id.
+	Deposits []Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositsByDepositorResponse) Reset()         { *m = QueryDepositsByDepositorResponse{} }
+func (m *QueryDepositsByDepositorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsByDepositorResponse) ProtoMessage()    {}
+func (*QueryDepositsByDepositorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{17}
+}
+func (m *QueryDepositsByDepositorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositsByDepositorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositsByDepositorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositsByDepositorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositsByDepositorResponse.Merge(m, src)
+}
+func (m *QueryDepositsByDepositorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositsByDepositorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositsByDepositorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositsByDepositorResponse proto.InternalMessageInfo
+
+func (m *QueryDepositsByDepositorResponse) GetDeposits() []Deposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *QueryDepositsByDepositorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTallyResultRequest is the request type for the Query/Tally RPC method.
 type QueryTallyResultRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -736,7 +932,7 @@ func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest
 func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultRequest) ProtoMessage()    {}
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{14}
+	return fileDescriptor_e35c0d133e91c0a2, []int{18}
 }
 func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +978,7 @@ func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultRespon
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e35c0d133e91c0a2, []int{15}
+	return fileDescriptor_e35c0d133e91c0a2, []int{19}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,12 +1023,16 @@ func init() {
 	proto.RegisterType((*QueryVoteResponse)(nil), "cosmos.gov.v1beta1.QueryVoteResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "cosmos.gov.v1beta1.QueryVotesRequest")
 	proto.RegisterType((*QueryVotesResponse)(nil), "cosmos.gov.v1beta1.QueryVotesResponse")
+	proto.RegisterType((*QueryVotesByVoterRequest)(nil), "cosmos.gov.v1beta1.QueryVotesByVoterRequest")
+	proto.RegisterType((*QueryVotesByVoterResponse)(nil), "cosmos.gov.v1beta1.QueryVotesByVoterResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.gov.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.gov.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryDepositRequest)(nil), "cosmos.gov.v1beta1.QueryDepositRequest")
 	proto.RegisterType((*QueryDepositResponse)(nil), "cosmos.gov.v1beta1.QueryDepositResponse")
 	proto.RegisterType((*QueryDepositsRequest)(nil), "cosmos.gov.v1beta1.QueryDepositsRequest")
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryDepositsByDepositorRequest)(nil), "cosmos.gov.v1beta1.QueryDepositsByDepositorRequest")
+	proto.RegisterType((*QueryDepositsByDepositorResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsByDepositorResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta1.QueryTallyResultResponse")
 }
@@ -840,70 +1040,77 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x4e, 0x6b, 0xbf, 0xb4, 0x01, 0x1e, 0x01, 0x8c, 0x29, 0x76, 0x58, 0xd1, 0xd6,
-	0xa4, 0xc4, 0x4b, 0x92, 0x52, 0xd4, 0x16, 0x50, 0x6b, 0xa1, 0xb6, 0xa8, 0x12, 0x2a, 0x4e, 0x05,
-	0x12, 0x97, 0x68, 0x53, 0xaf, 0x96, 0x15, 0x8e, 0x67, 0xeb, 0x19, 0x5b, 0x8d, 0x42, 0x84, 0xc4,
-	0x09, 0xc4, 0x05, 0x54, 0xc4, 0x0d, 0xa8, 0x54, 0x89, 0x5f, 0xc0, 0x8f, 0xe8, 0xb1, 0x02, 0x0e,
-	0x9c, 0x10, 0x4a, 0x38, 0xf0, 0x23, 0x38, 0xa0, 0x9d, 0x79, 0xb3, 0xde, 0x4d, 0xd6, 0xd9, 0x75,
-	0xa9, 0x38, 0xc5, 0x9e, 0xf9, 0xbe, 0xf7, 0xbe, 0xf7, 0xcd, 0x9b, 0x37, 0x0e, 0xd4, 0x6e, 0x71,
-	0xb1, 0xc9, 0x85, 0xed, 0xf1, 0xa1, 0x3d, 0x5c, 0xde, 0x70, 0xa5, 0xb3, 0x6c, 0xdf, 0x1e, 0xb8,
-	0xfd, 0xad, 0x66, 0xd0, 0xe7, 0x92, 0x23, 0xea, 0xfd, 0xa6, 0xc7, 0x87, 0x4d, 0xda, 0xaf, 0x2e,
-	0x12, 0x67, 0xc3, 0x11, 0xae, 0x06, 0x47, 0xd4, 0xc0, 0xf1, 0xfc, 0x9e, 0x23, 0x7d, 0xde, 0xd3,
-	0xfc, 0xea, 0xbc, 0xc7, 0x3d, 0xae, 0x3e, 0xda, 0xe1, 0x27, 0x5a, 0x3d, 0xe1, 0x71, 0xee, 0x75,
-	0x5d, 0xdb, 0x09, 0x7c, 0xdb, 0xe9, 0xf5, 0xb8, 0x54, 0x14, 0x61, 0x76, 0x53, 0x34, 0x85, 0xf9,
-	0xf5, 0xee, 0xf3, 0x7a, 0x77, 0x5d, 0x07, 0x25, 0x79, 0xea, 0x8b, 0xf5, 0x06, 0xcc, 0xbf, 0x1f,
-	0xca, 0xb9, 0xd1, 0xe7, 0x01, 0x17, 0x4e, 0xb7, 0xed, 0xde, 0x1e, 0xb8, 0x42, 0x62, 0x1d, 0x66,
-	0x03, 0x5a, 0x5a, 0xf7, 0x3b, 0x15, 0xb6, 0xc0, 0x1a, 0xc5, 0x36, 0x98, 0xa5, 0x77, 0x3b, 0xd6,
-	0x87, 0xf0, 0xcc, 0x3e, 0xa2, 0x08, 0x78, 0x4f, 0xb8, 0xf8, 0x36, 0x94, 0x0c, 0x4c, 0xd1, 0x66,
-	0x57, 0x4e, 0x34, 0x0f, 0x3a, 0xd2, 0x34, 0xbc, 0x56, 0xf1, 0xc1, 0x1f, 0xf5, 0x42, 0x3b, 0xe2,
-	0x58, 0x3f, 0x4c, 0xed, 0x8b, 0x2c, 0x8c, 0xa6, 0xeb, 0xf0, 0x44, 0xa4, 0x49, 0x48, 0x47, 0x0e,
-	0x84, 0x4a, 0x30, 0xb7, 0x62, 0x1d, 0x96, 0x60, 0x4d, 0x21, 0xdb, 0x73, 0x41, 0xe2, 0x3b, 0x36,
-	0x61, 0x66, 0xc8, 0xa5, 0xdb, 0xaf, 0x4c, 0x2d, 0xb0, 0x46, 0xb9, 0x55, 0xf9, 0xe5, 0xe7, 0xa5,
-	0x79, 0x8a, 0x72, 0xb9, 0xd3, 0xe9, 0xbb, 0x42, 0xac, 0xc9, 0xbe, 0xdf, 0xf3, 0xda, 0x1a, 0x86,
-	0xe7, 0xa0, 0xdc, 0x71, 0x03, 0x2e, 0x7c, 0xc9, 0xfb, 0x95, 0xe9, 0x0c, 0xce, 0x08, 0x8a, 0x57,
-	0x00, 0x46, 0x27, 0x5c, 0x29, 0x2a, 0x43, 0x4e, 0x19, 0xbd, 0x61, 0x3b, 0x34, 0x75, 0xef, 0x44,
-	0xb2, 0x1d, 0xcf, 0xa5, 0x82, 0xdb, 0x31, 0xe6, 0x85, 0xd2, 0x17, 0xf7, 0xea, 0x85, 0xbf, 0xef,
-	0xd5, 0x0b, 0xd6, 0x7d, 0x06, 0xcf, 0xee, 0x37, 0x88, 0xbc, 0xbf, 0x04, 0x65, 0x53, 0x66, 0xe8,
-	0xcd, 0x74, 0x4e, 0xf3, 0x47, 0x24, 0xbc, 0x9a, 0x90, 0x3b, 0xa5, 0xe4, 0x9e, 0xce, 0x94, 0xab,
-	0xd3, 0xc7, 0xf5, 0x5a, 0x9b, 0xf0, 0xa4, 0x12, 0xf9, 0x01, 0x97, 0x6e, 0xde, 0xa6, 0x9a, 0xf4,
-	0x50, 0x62, 0xa6, 0x5c, 0x85, 0xa7, 0x62, 0xe9, 0xc8, 0x8e, 0x15, 0x28, 0x86, 0x38, 0x6a, 0xc3,
-	0x4a, 0x9a, 0x13, 0x21, 0x9e, 0x5c, 0x50, 0x58, 0xeb, 0xd3, 0x58, 0x20, 0x91, 0x5b, 0xf8, 0x95,
-	0x14, 0xdb, 0x1e, 0xe1, 0x94, 0xad, 0xbb, 0x0c, 0x30, 0x9e, 0x9e, 0x0a, 0x39, 0xab, 0x7d, 0x31,
-	0x67, 0x9a, 0x55, 0x89, 0x06, 0x3f, 0xbe, 0xb3, 0x7c, 0x9d, 0x44, 0xdd, 0x70, 0xfa, 0xce, 0x66,
-	0xc2, 0x14, 0xb5, 0xb0, 0x2e, 0xb7, 0x02, 0x6d, 0x72, 0x39, 0xa4, 0x85, 0x4b, 0x37, 0xb7, 0x02,
-	0xd7, 0xfa, 0x87, 0xc1, 0xd3, 0x09, 0x1e, 0x55, 0x73, 0x1d, 0x8e, 0x0f, 0xb9, 0xf4, 0x7b, 0xde,
-	0xba, 0x06, 0xd3, 0xf9, 0x2c, 0x8c, 0xa9, 0xca, 0xef, 0x79, 0x3a, 0x00, 0x55, 0x77, 0x6c, 0x18,
-	0x5b, 0xc3, 0xf7, 0x60, 0x8e, 0x2e, 0x9b, 0x89, 0xa6, 0x0b, 0x7d, 0x29, 0x2d, 0xda, 0x3b, 0x1a,
-	0x99, 0x08, 0x77, 0xbc, 0x13, 0x5f, 0xc4, 0x6b, 0x70, 0x4c, 0x3a, 0xdd, 0xee, 0x96, 0x89, 0x36,
-	0xad, 0xa2, 0xd5, 0xd3, 0xa2, 0xdd, 0x0c, 0x71, 0x89, 0x58, 0xb3, 0x72, 0xb4, 0x64, 0xdd, 0xa1,
-	0xea, 0x29, 0x69, 0xee, 0x5e, 0x4a, 0x4c, 0x9a, 0xa9, 0xdc, 0x93, 0x26, 0x76, 0x19, 0xd6, 0x68,
-	0xa8, 0x47, 0x99, 0xc9, 0xf8, 0x8b, 0x70, 0x94, 0xe0, 0x64, 0xf9, 0x0b, 0x87, 0x98, 0x44, 0x25,
-	0x19, 0x86, 0xf5, 0x59, 0x32, 0xe8, 0xff, 0x7f, 0x37, 0x7e, 0x64, 0xf4, 0x30, 0x8c, 0x14, 0x50,
-	0x5d, 0x6f, 0x41, 0x89, 0x54, 0x9a, 0x1b, 0x92, 0xa3, 0xb0, 0x88, 0xf2, 0xf8, 0xee, 0xc9, 0x05,
-	0x78, 0x4e, 0x09, 0x54, 0x8d, 0xd1, 0x76, 0xc5, 0xa0, 0x2b, 0x27, 0x78, 0x4f, 0x2b, 0x07, 0xb9,
-	0xd1, 0xb9, 0xcd, 0xa8, 0xc6, 0xa2, 0x53, 0x1b, 0xdf, 0x8c, 0x9a, 0x67, 0xa6, 0x80, 0xe2, 0xac,
-	0xfc, 0x56, 0x86, 0x19, 0x15, 0x19, 0xbf, 0x65, 0x50, 0x32, 0x93, 0x1f, 0x1b, 0x69, 0x41, 0xd2,
-	0x7e, 0x0a, 0x54, 0x5f, 0xc9, 0x81, 0xd4, 0x42, 0xad, 0xd5, 0xcf, 0x7f, 0xfd, 0xeb, 0xee, 0xd4,
-	0x12, 0x9e, 0xb1, 0x53, 0x7e, 0x8f, 0x44, 0x8f, 0x8c, 0xbd, 0x1d, 0xb3, 0x62, 0x07, 0xbf, 0x64,
-	0x50, 0x8e, 0x9e, 0x32, 0xcc, 0xce, 0x66, 0x3a, 0xaf, 0xba, 0x98, 0x07, 0x4a, 0xca, 0x4e, 0x2a,
-	0x65, 0x75, 0x7c, 0xf1, 0x50, 0x65, 0xf8, 0x1d, 0x83, 0x62, 0x38, 0x48, 0xf1, 0xe5, 0xb1, 0xb1,
-	0x63, 0x0f, 0x5a, 0xf5, 0x64, 0x06, 0x8a, 0x92, 0x5f, 0x56, 0xc9, 0x2f, 0xe2, 0xf9, 0x09, 0x6c,
-	0xb1, 0xd5, 0x0c, 0xb7, 0xb7, 0xd5, 0x43, 0xb7, 0x83, 0xdf, 0x30, 0x98, 0x51, 0x6f, 0x02, 0x1e,
-	0x9e, 0x33, 0x32, 0xe7, 0x54, 0x16, 0x8c, 0xb4, 0x9d, 0x57, 0xda, 0x56, 0x71, 0x79, 0x62, 0x6d,
-	0xf8, 0x15, 0x83, 0x23, 0x34, 0x35, 0xc7, 0x67, 0x4b, 0xbc, 0x19, 0xd5, 0xd3, 0x99, 0x38, 0x92,
-	0xf5, 0x9a, 0x92, 0xb5, 0x88, 0x8d, 0x54, 0x59, 0x0a, 0x6b, 0x6f, 0xc7, 0x9e, 0x9f, 0x1d, 0xfc,
-	0x89, 0xc1, 0x51, 0xba, 0xe1, 0x38, 0x3e, 0x4d, 0x72, 0x18, 0x57, 0x1b, 0xd9, 0x40, 0x12, 0x74,
-	0x4d, 0x09, 0x6a, 0xe1, 0xa5, 0x49, 0x7c, 0x32, 0x23, 0xc6, 0xde, 0x8e, 0xc6, 0xf4, 0x0e, 0x7e,
-	0xcf, 0xa0, 0x64, 0x46, 0x18, 0x66, 0x0a, 0x10, 0xd9, 0xd7, 0x70, 0xff, 0x3c, 0xb4, 0xde, 0x54,
-	0x5a, 0xcf, 0xe1, 0xd9, 0x47, 0xd1, 0x8a, 0xf7, 0x19, 0xcc, 0xc6, 0xa6, 0x09, 0x9e, 0x19, 0x9b,
-	0xf8, 0xe0, 0x9c, 0xab, 0xbe, 0x9a, 0x0f, 0xfc, 0x5f, 0x9a, 0x4f, 0x8d, 0xb5, 0x56, 0xeb, 0xc1,
-	0x6e, 0x8d, 0x3d, 0xdc, 0xad, 0xb1, 0x3f, 0x77, 0x6b, 0xec, 0xeb, 0xbd, 0x5a, 0xe1, 0xe1, 0x5e,
-	0xad, 0xf0, 0xfb, 0x5e, 0xad, 0xf0, 0x51, 0xc3, 0xf3, 0xe5, 0xc7, 0x83, 0x8d, 0xe6, 0x2d, 0xbe,
-	0x69, 0xc2, 0xea, 0x3f, 0x4b, 0xa2, 0xf3, 0x89, 0x7d, 0x47, 0xe5, 0x08, 0x5b, 0x46, 0x6c, 0x1c,
-	0x51, 0xff, 0x03, 0xad, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x12, 0x1c, 0x27, 0xd2, 0x0d,
-	0x00, 0x00,
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x4e, 0x6b, 0xbf, 0xa4, 0x01, 0x5e, 0x53, 0x70, 0x97, 0x62, 0x87, 0x15, 0x6d,
+	0x4d, 0xda, 0x78, 0x49, 0x9c, 0x16, 0xb5, 0x01, 0xd4, 0x5a, 0xa8, 0x2d, 0xaa, 0x84, 0x8a, 0x53,
+	0x81, 0xc4, 0x25, 0xda, 0xd4, 0xab, 0xc5, 0xc2, 0xf1, 0x6c, 0x77, 0xd6, 0x56, 0xa3, 0x10, 0x21,
+	0x71, 0x02, 0x71, 0x01, 0x15, 0x71, 0x40, 0xfc, 0xa8, 0x54, 0x89, 0x03, 0x5c, 0x7b, 0xe5, 0xde,
+	0x63, 0x05, 0x17, 0x4e, 0x08, 0x25, 0x1c, 0xf8, 0x23, 0x38, 0xa0, 0x9d, 0x1f, 0xeb, 0x5d, 0x67,
+	0xed, 0x5d, 0x87, 0x08, 0xe5, 0xe4, 0xcd, 0xcc, 0xf7, 0xde, 0xfb, 0xde, 0xf7, 0x66, 0xde, 0x1b,
+	0x05, 0x4a, 0x77, 0x28, 0xdb, 0xa0, 0xcc, 0xb0, 0x69, 0xcf, 0xe8, 0x2d, 0xae, 0x5b, 0x9e, 0xb9,
+	0x68, 0xdc, 0xed, 0x5a, 0xee, 0x66, 0xd5, 0x71, 0xa9, 0x47, 0x11, 0xc5, 0x7e, 0xd5, 0xa6, 0xbd,
+	0xaa, 0xdc, 0xd7, 0xe6, 0xa5, 0xcd, 0xba, 0xc9, 0x2c, 0x01, 0x0e, 0x4c, 0x1d, 0xd3, 0x6e, 0x75,
+	0x4c, 0xaf, 0x45, 0x3b, 0xc2, 0x5e, 0x9b, 0xb5, 0xa9, 0x4d, 0xf9, 0xa7, 0xe1, 0x7f, 0xc9, 0xd5,
+	0x53, 0x36, 0xa5, 0x76, 0xdb, 0x32, 0x4c, 0xa7, 0x65, 0x98, 0x9d, 0x0e, 0xf5, 0xb8, 0x09, 0x53,
+	0xbb, 0x31, 0x9c, 0xfc, 0xf8, 0x62, 0xf7, 0xa4, 0xd8, 0x5d, 0x13, 0x4e, 0xc5, 0x1f, 0x62, 0x4b,
+	0x7f, 0x15, 0x66, 0xdf, 0xf1, 0xe9, 0xdc, 0x72, 0xa9, 0x43, 0x99, 0xd9, 0x6e, 0x58, 0x77, 0xbb,
+	0x16, 0xf3, 0xb0, 0x0c, 0x53, 0x8e, 0x5c, 0x5a, 0x6b, 0x35, 0x8b, 0x64, 0x8e, 0x54, 0x72, 0x0d,
+	0x50, 0x4b, 0x6f, 0x35, 0xf5, 0xf7, 0xe0, 0xc4, 0x80, 0x21, 0x73, 0x68, 0x87, 0x59, 0xf8, 0x06,
+	0xe4, 0x15, 0x8c, 0x9b, 0x4d, 0x2d, 0x9d, 0xaa, 0xee, 0x55, 0xa4, 0xaa, 0xec, 0xea, 0xb9, 0xc7,
+	0x7f, 0x94, 0x33, 0x8d, 0xc0, 0x46, 0xff, 0x3e, 0x3b, 0xe0, 0x99, 0x29, 0x4e, 0x37, 0xe1, 0xa9,
+	0x80, 0x13, 0xf3, 0x4c, 0xaf, 0xcb, 0x78, 0x80, 0x99, 0x25, 0x7d, 0x54, 0x80, 0x55, 0x8e, 0x6c,
+	0xcc, 0x38, 0x91, 0xbf, 0xb1, 0x0a, 0x93, 0x3d, 0xea, 0x59, 0x6e, 0x31, 0x3b, 0x47, 0x2a, 0x85,
+	0x7a, 0xf1, 0xd7, 0x47, 0x0b, 0xb3, 0xd2, 0xcb, 0xd5, 0x66, 0xd3, 0xb5, 0x18, 0x5b, 0xf5, 0xdc,
+	0x56, 0xc7, 0x6e, 0x08, 0x18, 0x5e, 0x84, 0x42, 0xd3, 0x72, 0x28, 0x6b, 0x79, 0xd4, 0x2d, 0x4e,
+	0x24, 0xd8, 0xf4, 0xa1, 0x78, 0x0d, 0xa0, 0x5f, 0xe1, 0x62, 0x8e, 0x0b, 0x72, 0x46, 0xf1, 0xf5,
+	0x8f, 0x43, 0x55, 0x9c, 0x9d, 0x80, 0xb6, 0x69, 0x5b, 0x32, 0xe1, 0x46, 0xc8, 0xf2, 0x72, 0xfe,
+	0xd3, 0x07, 0xe5, 0xcc, 0xdf, 0x0f, 0xca, 0x19, 0xfd, 0x21, 0x81, 0x67, 0x07, 0x05, 0x92, 0xda,
+	0x5f, 0x81, 0x82, 0x4a, 0xd3, 0xd7, 0x66, 0x22, 0xa5, 0xf8, 0x7d, 0x23, 0xbc, 0x1e, 0xa1, 0x9b,
+	0xe5, 0x74, 0xcf, 0x26, 0xd2, 0x15, 0xe1, 0xc3, 0x7c, 0xf5, 0x0d, 0x78, 0x9a, 0x93, 0x7c, 0x97,
+	0x7a, 0x56, 0xda, 0x43, 0x35, 0x6e, 0x51, 0x42, 0xa2, 0x5c, 0x87, 0x67, 0x42, 0xe1, 0xa4, 0x1c,
+	0x4b, 0x90, 0xf3, 0x71, 0xf2, 0x18, 0x16, 0xe3, 0x94, 0xf0, 0xf1, 0x52, 0x05, 0x8e, 0xd5, 0x3f,
+	0x0a, 0x39, 0x62, 0xa9, 0x89, 0x5f, 0x8b, 0x91, 0x6d, 0x1f, 0x55, 0xd6, 0xef, 0x13, 0xc0, 0x70,
+	0x78, 0x99, 0xc8, 0xb2, 0xd0, 0x45, 0xd5, 0x34, 0x29, 0x13, 0x01, 0x3e, 0xb8, 0x5a, 0x7e, 0x4b,
+	0xa0, 0xd8, 0x67, 0x55, 0xe7, 0x3f, 0xae, 0xd2, 0x26, 0xa8, 0x19, 0x49, 0x77, 0x91, 0x0e, 0x48,
+	0xaa, 0x50, 0xed, 0xbf, 0x21, 0x70, 0x32, 0x86, 0xde, 0xe1, 0xd0, 0xee, 0x82, 0x2c, 0xe8, 0x2d,
+	0xd3, 0x35, 0x37, 0x22, 0x07, 0x8a, 0x2f, 0xac, 0x79, 0x9b, 0x8e, 0x38, 0xa0, 0x85, 0x06, 0x88,
+	0xa5, 0xdb, 0x9b, 0x8e, 0xa5, 0xff, 0x43, 0xe0, 0x78, 0xc4, 0x4e, 0x66, 0x73, 0x13, 0x8e, 0xf5,
+	0xa8, 0xd7, 0xea, 0xd8, 0x6b, 0x02, 0x2c, 0xcf, 0xf6, 0xdc, 0x90, 0xac, 0x5a, 0x1d, 0x5b, 0x38,
+	0x90, 0xd9, 0x4d, 0xf7, 0x42, 0x6b, 0xf8, 0x36, 0xcc, 0xc8, 0x46, 0xa5, 0xbc, 0x89, 0x44, 0x5f,
+	0x8c, 0xf3, 0xf6, 0xa6, 0x40, 0x46, 0xdc, 0x1d, 0x6b, 0x86, 0x17, 0xf1, 0x06, 0x4c, 0x7b, 0x66,
+	0xbb, 0xbd, 0xa9, 0xbc, 0x4d, 0x70, 0x6f, 0xe5, 0x38, 0x6f, 0xb7, 0x7d, 0x5c, 0xc4, 0xd7, 0x94,
+	0xd7, 0x5f, 0xd2, 0xef, 0xc9, 0xec, 0x65, 0xd0, 0xd4, 0xf7, 0x30, 0xd2, 0xa5, 0xb3, 0xa9, 0xbb,
+	0x74, 0xe8, 0x30, 0xad, 0xc2, 0x6c, 0x34, 0xb2, 0x14, 0x7e, 0x05, 0x8e, 0x4a, 0xb8, 0x94, 0xfc,
+	0xf9, 0x11, 0x22, 0xc9, 0x94, 0x94, 0x85, 0xfe, 0x71, 0xd4, 0xe9, 0xff, 0xdf, 0x57, 0x7e, 0x20,
+	0x70, 0x62, 0x80, 0x81, 0xcc, 0xeb, 0x75, 0xc8, 0x4b, 0x96, 0xea, 0x86, 0xa4, 0x48, 0x2c, 0x30,
+	0x39, 0xb8, 0x7b, 0xf2, 0x33, 0x81, 0x72, 0x84, 0x61, 0x5d, 0x7d, 0xd1, 0xa0, 0xd5, 0x44, 0xaa,
+	0x4b, 0xf6, 0x3b, 0x83, 0x0f, 0xa2, 0xe5, 0xfc, 0x44, 0x60, 0x6e, 0x38, 0xdb, 0x43, 0x26, 0xed,
+	0x65, 0x78, 0x8e, 0x73, 0xe5, 0x77, 0xae, 0x61, 0xb1, 0x6e, 0xdb, 0x1b, 0xe3, 0x99, 0x57, 0xdc,
+	0x6b, 0x1b, 0x5c, 0x89, 0x49, 0x7e, 0x67, 0x8b, 0x24, 0xe1, 0x9e, 0x0b, 0x3b, 0xd5, 0x60, 0xb9,
+	0xcd, 0xd2, 0xa3, 0x69, 0x98, 0xe4, 0x9e, 0xf1, 0x2b, 0x02, 0x79, 0xf5, 0x20, 0xc1, 0x4a, 0x9c,
+	0x93, 0xb8, 0x17, 0xaa, 0xf6, 0x72, 0x0a, 0xa4, 0x20, 0xaa, 0xd7, 0x3e, 0xf9, 0xed, 0xaf, 0xfb,
+	0xd9, 0x05, 0x3c, 0x67, 0xc4, 0x3c, 0x93, 0x55, 0xb2, 0xcc, 0xd8, 0x0a, 0x49, 0xb1, 0x8d, 0x9f,
+	0x11, 0x28, 0x28, 0x4f, 0x0c, 0x93, 0xa3, 0xa9, 0x4b, 0xad, 0xcd, 0xa7, 0x81, 0x4a, 0x66, 0xa7,
+	0x39, 0xb3, 0x32, 0xbe, 0x30, 0x92, 0x19, 0x7e, 0x4d, 0x20, 0xe7, 0xcf, 0x28, 0x7c, 0x69, 0xa8,
+	0xef, 0xd0, 0x3b, 0x4b, 0x3b, 0x9d, 0x80, 0x92, 0xc1, 0xaf, 0xf2, 0xe0, 0x2b, 0x78, 0x69, 0x0c,
+	0x59, 0x0c, 0x3e, 0x1e, 0x8d, 0x2d, 0xff, 0xc7, 0xdd, 0xc6, 0x2f, 0x09, 0x4c, 0xfa, 0x3e, 0x19,
+	0x8e, 0x8e, 0x19, 0x88, 0x73, 0x26, 0x09, 0x26, 0xb9, 0x5d, 0xe2, 0xdc, 0x6a, 0xb8, 0x38, 0x36,
+	0x37, 0xfc, 0x8e, 0xc0, 0x74, 0xf8, 0x25, 0x80, 0xe7, 0x47, 0xc7, 0x8c, 0xbe, 0x67, 0xb4, 0x85,
+	0x94, 0x68, 0x49, 0xf4, 0x15, 0x4e, 0x74, 0x1e, 0x2b, 0x71, 0x44, 0xb9, 0x4a, 0x81, 0x5a, 0x92,
+	0xdf, 0xe7, 0x04, 0x8e, 0xc8, 0x81, 0x39, 0x5c, 0x8d, 0xc8, 0x73, 0x41, 0x3b, 0x9b, 0x88, 0x4b,
+	0xc3, 0x46, 0x4c, 0x65, 0x63, 0x2b, 0xf4, 0xf2, 0xd8, 0xc6, 0x1f, 0x09, 0x1c, 0x95, 0x1d, 0x08,
+	0x87, 0x87, 0x89, 0xce, 0x61, 0xad, 0x92, 0x0c, 0x94, 0x84, 0x6e, 0x70, 0x42, 0x75, 0xbc, 0x32,
+	0x4e, 0x1d, 0x55, 0x0b, 0x34, 0xb6, 0x82, 0x1e, 0xbe, 0xed, 0x97, 0x35, 0x2f, 0xbd, 0x33, 0x4c,
+	0x24, 0xc0, 0x92, 0xdb, 0xc4, 0xe0, 0x28, 0xd4, 0x5f, 0xe3, 0x5c, 0x2f, 0xe2, 0xf2, 0x7e, 0xb8,
+	0xe2, 0x2f, 0x04, 0x8e, 0xc7, 0x4c, 0x03, 0xac, 0x25, 0x12, 0xd8, 0x3b, 0xe9, 0xb4, 0xe5, 0xf1,
+	0x8c, 0x64, 0x02, 0x2b, 0x3c, 0x81, 0x0b, 0x58, 0x8b, 0x4b, 0x20, 0x90, 0x32, 0x22, 0x6b, 0x9f,
+	0xff, 0x43, 0x02, 0x53, 0xa1, 0x6e, 0x8d, 0xe7, 0x86, 0x52, 0xd8, 0x3b, 0x47, 0xb4, 0xf3, 0xe9,
+	0xc0, 0xff, 0xe5, 0x72, 0xf3, 0xb1, 0x51, 0xaf, 0x3f, 0xde, 0x29, 0x91, 0x27, 0x3b, 0x25, 0xf2,
+	0xe7, 0x4e, 0x89, 0x7c, 0xb1, 0x5b, 0xca, 0x3c, 0xd9, 0x2d, 0x65, 0x7e, 0xdf, 0x2d, 0x65, 0xde,
+	0xaf, 0xd8, 0x2d, 0xef, 0x83, 0xee, 0x7a, 0xf5, 0x0e, 0xdd, 0x50, 0x6e, 0xc5, 0xcf, 0x02, 0x6b,
+	0x7e, 0x68, 0xdc, 0xe3, 0x31, 0xfc, 0x23, 0xcf, 0xd6, 0x8f, 0xf0, 0x7f, 0x7d, 0xd4, 0xfe, 0x1d,
+	0x00, 0x34, 0x14, 0x92, 0xa5, 0xc9, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -926,12 +1133,18 @@ type QueryClient interface {
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// VotesByVoter queries the votes cast by a voter on the proposals which are
+	// still in their voting period.
+	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Deposit queries single deposit information based proposalID, depositAddr.
 	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// DepositsByDepositor queries the deposits of a depositor which are still
+	// held by the module.
+	DepositsByDepositor(ctx context.Context, in *QueryDepositsByDepositorRequest, opts ...grpc.CallOption) (*QueryDepositsByDepositorResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 }
@@ -980,6 +1193,15 @@ func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error) {
+	out := new(QueryVotesByVoterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/VotesByVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/Params", in, out, opts...)
@@ -1007,6 +1229,15 @@ func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) DepositsByDepositor(ctx context.Context, in *QueryDepositsByDepositorRequest, opts ...grpc.CallOption) (*QueryDepositsByDepositorResponse, error) {
+	out := new(QueryDepositsByDepositorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/DepositsByDepositor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
 	out := new(QueryTallyResultResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/TallyResult", in, out, opts...)
@@ -1026,12 +1257,18 @@ type QueryServer interface {
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// VotesByVoter queries the votes cast by a voter on the proposals which are
+	// still in their voting period.
+	VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Deposit queries single deposit information based proposalID, depositAddr.
 	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// DepositsByDepositor queries the deposits of a depositor which are still
+	// held by the module.
+	DepositsByDepositor(context.Context, *QueryDepositsByDepositorRequest) (*QueryDepositsByDepositorResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 }
@@ -1052,6 +1289,9 @@ func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest
 func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesRequest) (*QueryVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
 }
+func (*UnimplementedQueryServer) VotesByVoter(ctx context.Context, req *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotesByVoter not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1061,6 +1301,9 @@ func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositR
 func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
 }
+func (*UnimplementedQueryServer) DepositsByDepositor(ctx context.Context, req *QueryDepositsByDepositorRequest) (*QueryDepositsByDepositorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositsByDepositor not implemented")
+}
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
@@ -1141,6 +1384,24 @@ func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesByVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotesByVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/VotesByVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotesByVoter(ctx, req.(*QueryVotesByVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1195,6 +1456,24 @@ func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DepositsByDepositor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsByDepositorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositsByDepositor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/DepositsByDepositor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositsByDepositor(ctx, req.(*QueryDepositsByDepositorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTallyResultRequest)
 	if err := dec(in); err != nil {
@@ -1233,6 +1512,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Votes",
 			Handler:    _Query_Votes_Handler,
 		},
+		{
+			MethodName: "VotesByVoter",
+			Handler:    _Query_VotesByVoter_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1245,6 +1528,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposits",
 			Handler:    _Query_Deposits_Handler,
 		},
+		{
+			MethodName: "DepositsByDepositor",
+			Handler:    _Query_DepositsByDepositor_Handler,
+		},
 		{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
@@ -1575,7 +1862,7 @@ func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1585,27 +1872,39 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ParamsType) > 0 {
-		i -= len(m.ParamsType)
-		copy(dAtA[i:], m.ParamsType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParamsType)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1615,50 +1914,46 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1668,7 +1963,90 @@ func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParamsType) > 0 {
+		i -= len(m.ParamsType)
+		copy(dAtA[i:], m.ParamsType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParamsType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1815,6 +2193,97 @@ func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDepositsByDepositorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsByDepositorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsByDepositorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsByDepositorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsByDepositorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsByDepositorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2015,6 +2484,42 @@ func (m *QueryVotesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVotesByVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesByVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2105,6 +2610,42 @@ func (m *QueryDepositsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDepositsByDepositorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsByDepositorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTallyResultRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2984,7 +3525,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3007,15 +3548,15 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3043,18 +3584,256 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamsType = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -3624,6 +4403,244 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDepositsByDepositorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsByDepositorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsByDepositorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsByDepositorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsByDepositorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsByDepositorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
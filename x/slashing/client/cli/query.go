@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
 	)
 
 	return slashingQueryCmd
@@ -109,6 +110,44 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks
+// bitmap of a validator's current signing window.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-cons-address]",
+		Short: "Query a validator's missed blocks bitmap for the current signing window",
+		Long: strings.TrimSpace(`Use a validator's consensus address to find the missed blocks bitmap of its current signing window:
+
+$ <appd> query slashing missed-blocks cosmosvalcons1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.MissedBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -72,3 +72,38 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	window := k.SignedBlocksWindow(ctx)
+	bitmap := make([]bool, window)
+	k.IterateValidatorMissedBlockBitArray(ctx, consAddr, func(index int64, missed bool) bool {
+		bitmap[index] = missed
+		return false
+	})
+
+	return &types.QueryMissedBlocksResponse{
+		Bitmap:              bitmap,
+		SignedBlocksWindow:  window,
+		IndexOffset:         signingInfo.IndexOffset,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+	}, nil
+}
@@ -105,6 +105,42 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	queryClient := suite.queryClient
+
+	missedResp, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(missedResp)
+
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress(suite.addrDels[0]).String()})
+	suite.NoError(err)
+	suite.Equal(int64(3), missedResp.IndexOffset)
+	suite.Equal(int64(10), missedResp.MissedBlocksCounter)
+	suite.Equal(suite.app.SlashingKeeper.SignedBlocksWindow(suite.ctx), missedResp.SignedBlocksWindow)
+	suite.Len(missedResp.Bitmap, int(missedResp.SignedBlocksWindow))
+	for _, missed := range missedResp.Bitmap {
+		suite.False(missed)
+	}
+
+	consAddr := sdk.ConsAddress(suite.addrDels[1])
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 1, true)
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 3, true)
+
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	for i, missed := range missedResp.Bitmap {
+		suite.Equal(i == 1 || i == 3, missed)
+	}
+
+	// unknown validator
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress([]byte("unknown")).String()})
+	suite.Error(err)
+	suite.Nil(missedResp)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
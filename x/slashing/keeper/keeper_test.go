@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -280,3 +281,76 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator being jailed for downtime repeatedly
+// Ensure that the penalties follow the downtime escalation schedule
+func TestHandleRepeatedDowntime(t *testing.T) {
+	// initial setup
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0)})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.DowntimeEscalationSchedule = []types.DowntimePenalty{
+		types.NewDowntimePenalty(2*params.DowntimeJailDuration, sdk.NewDecWithPrec(5, 2)),
+		types.NewDowntimePenalty(4*params.DowntimeJailDuration, sdk.NewDecWithPrec(10, 2)),
+	}
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.GetConsAddress(val)
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	maxMissed := window - app.SlashingKeeper.MinSignedPerWindow(ctx)
+
+	// first blocks OK
+	height := int64(0)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	expTokens := amt
+	expectedPenalties := []struct {
+		jailDuration  time.Duration
+		slashFraction sdk.Dec
+	}{
+		{params.DowntimeJailDuration, params.SlashFractionDowntime},
+		{2 * params.DowntimeJailDuration, sdk.NewDecWithPrec(5, 2)},
+		{4 * params.DowntimeJailDuration, sdk.NewDecWithPrec(10, 2)},
+		{4 * params.DowntimeJailDuration, sdk.NewDecWithPrec(10, 2)},
+	}
+
+	for i, penalty := range expectedPenalties {
+		// miss enough blocks to be jailed
+		for latest := height; height < latest+maxMissed+1; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+
+		// validator should have been jailed and slashed following the schedule
+		tstaking.CheckValidator(addr, stakingtypes.Unbonding, true)
+		expTokens = expTokens.Sub(penalty.slashFraction.MulInt(app.StakingKeeper.TokensFromConsensusPower(ctx, power)).TruncateInt())
+		validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.Equal(t, expTokens, validator.GetTokens())
+
+		signInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, int64(i+1), signInfo.JailCount)
+		require.Equal(t, ctx.BlockHeader().Time.Add(penalty.jailDuration), signInfo.JailedUntil)
+
+		// validator rejoins
+		ctx = ctx.WithBlockTime(signInfo.JailedUntil)
+		require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Bonded, false)
+	}
+}
@@ -42,6 +42,18 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeEscalationSchedule - escalating penalties for repeated downtime
+func (k Keeper) DowntimeEscalationSchedule(ctx sdk.Context) (res []types.DowntimePenalty) {
+	k.paramspace.Get(ctx, types.KeyDowntimeEscalationSchedule, &res)
+	return
+}
+
+// DowntimePenalty returns the jail duration and slash fraction to apply for a
+// downtime infraction of a validator previously jailed jailCount times.
+func (k Keeper) DowntimePenalty(ctx sdk.Context, jailCount int64) (time.Duration, sdk.Dec) {
+	return k.GetParams(ctx).DowntimePenalty(jailCount)
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
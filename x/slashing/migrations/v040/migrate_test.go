@@ -94,6 +94,7 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "downtime_escalation_schedule": [],
     "downtime_jail_duration": "600s",
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
@@ -106,6 +107,7 @@ func TestMigrate(t *testing.T) {
       "validator_signing_info": {
         "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
         "index_offset": "2",
+        "jail_count": "0",
         "jailed_until": "0001-01-01T00:00:00Z",
         "missed_blocks_counter": "2",
         "start_height": "0",
@@ -117,6 +119,7 @@ func TestMigrate(t *testing.T) {
       "validator_signing_info": {
         "address": "cosmosvalcons10e4c5p6qk0sycy9u6u43t7csmlx9fyadr9yxph",
         "index_offset": "615501",
+        "jail_count": "0",
         "jailed_until": "0001-01-01T00:00:00Z",
         "missed_blocks_counter": "1",
         "start_height": "0",
@@ -0,0 +1,16 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting an empty downtime escalation schedule.
+func MigrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	paramSpace.Set(ctx, types.KeyDowntimeEscalationSchedule, []types.DowntimePenalty{})
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	transientKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, transientKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, transientKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// only the params which existed prior to v0.46 are set
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeySignedBlocksWindow, params.SignedBlocksWindow)
+	paramSpace.Set(ctx, types.KeyMinSignedPerWindow, params.MinSignedPerWindow)
+	paramSpace.Set(ctx, types.KeyDowntimeJailDuration, params.DowntimeJailDuration)
+	paramSpace.Set(ctx, types.KeySlashFractionDoubleSign, params.SlashFractionDoubleSign)
+	paramSpace.Set(ctx, types.KeySlashFractionDowntime, params.SlashFractionDowntime)
+	require.False(t, paramSpace.Has(ctx, types.KeyDowntimeEscalationSchedule))
+
+	require.NoError(t, v046slashing.MigrateParams(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Empty(t, migrated.DowntimeEscalationSchedule)
+
+	jailDuration, slashFraction := migrated.DowntimePenalty(5)
+	require.Equal(t, params.DowntimeJailDuration, jailDuration)
+	require.Equal(t, params.SlashFractionDowntime, slashFraction)
+}
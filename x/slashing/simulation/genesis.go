@@ -16,11 +16,12 @@ import (
 
 // Simulation parameter constants
 const (
-	SignedBlocksWindow      = "signed_blocks_window"
-	MinSignedPerWindow      = "min_signed_per_window"
-	DowntimeJailDuration    = "downtime_jail_duration"
-	SlashFractionDoubleSign = "slash_fraction_double_sign"
-	SlashFractionDowntime   = "slash_fraction_downtime"
+	SignedBlocksWindow         = "signed_blocks_window"
+	MinSignedPerWindow         = "min_signed_per_window"
+	DowntimeJailDuration       = "downtime_jail_duration"
+	SlashFractionDoubleSign    = "slash_fraction_double_sign"
+	SlashFractionDowntime      = "slash_fraction_downtime"
+	DowntimeEscalationSchedule = "downtime_escalation_schedule"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +49,20 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeEscalationSchedule randomized DowntimeEscalationSchedule, with
+// penalties escalating from the given base jail duration and slash fraction
+func GenDowntimeEscalationSchedule(r *rand.Rand, jailDuration time.Duration, slashFraction sdk.Dec) []types.DowntimePenalty {
+	steps := r.Intn(4)
+	schedule := make([]types.DowntimePenalty, 0, steps)
+	for i := 0; i < steps; i++ {
+		jailDuration += GenDowntimeJailDuration(r)
+		slashFraction = sdk.MinDec(sdk.OneDec(), slashFraction.Add(GenSlashFractionDowntime(r)))
+		schedule = append(schedule, types.NewDowntimePenalty(jailDuration, slashFraction))
+	}
+
+	return schedule
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,10 +95,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeEscalationSchedule []types.DowntimePenalty
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeEscalationSchedule, &downtimeEscalationSchedule, simState.Rand,
+		func(r *rand.Rand) {
+			downtimeEscalationSchedule = GenDowntimeEscalationSchedule(r, downtimeJailDuration, slashFractionDowntime)
+		},
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
 	)
+	params.DowntimeEscalationSchedule = downtimeEscalationSchedule
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
 
@@ -22,11 +22,12 @@ var (
 
 // Parameter store keys
 var (
-	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeySignedBlocksWindow         = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow         = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration       = []byte("DowntimeJailDuration")
+	KeySlashFractionDoubleSign    = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime      = []byte("SlashFractionDowntime")
+	KeyDowntimeEscalationSchedule = []byte("DowntimeEscalationSchedule")
 )
 
 // ParamKeyTable for slashing module
@@ -57,6 +58,31 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeEscalationSchedule, &p.DowntimeEscalationSchedule, validateDowntimeEscalationSchedule),
+	}
+}
+
+// DowntimePenalty returns the jail duration and slash fraction to apply to a
+// validator that has previously been jailed for downtime jailCount times.
+func (p Params) DowntimePenalty(jailCount int64) (time.Duration, sdk.Dec) {
+	if jailCount <= 0 || len(p.DowntimeEscalationSchedule) == 0 {
+		return p.DowntimeJailDuration, p.SlashFractionDowntime
+	}
+
+	step := jailCount - 1
+	if last := int64(len(p.DowntimeEscalationSchedule) - 1); step > last {
+		step = last
+	}
+
+	penalty := p.DowntimeEscalationSchedule[step]
+	return penalty.JailDuration, penalty.SlashFraction
+}
+
+// NewDowntimePenalty creates a new DowntimePenalty object
+func NewDowntimePenalty(jailDuration time.Duration, slashFraction sdk.Dec) DowntimePenalty {
+	return DowntimePenalty{
+		JailDuration:  jailDuration,
+		SlashFraction: slashFraction,
 	}
 }
 
@@ -141,3 +167,38 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeEscalationSchedule(i interface{}) error {
+	v, ok := i.([]DowntimePenalty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for step, penalty := range v {
+		if err := validateDowntimeJailDuration(penalty.JailDuration); err != nil {
+			return fmt.Errorf("invalid downtime escalation step %d: %w", step, err)
+		}
+		if penalty.SlashFraction.IsNil() {
+			return fmt.Errorf("invalid downtime escalation step %d: slash fraction cannot be nil", step)
+		}
+		if err := validateSlashFractionDowntime(penalty.SlashFraction); err != nil {
+			return fmt.Errorf("invalid downtime escalation step %d: %w", step, err)
+		}
+
+		if step == 0 {
+			continue
+		}
+
+		prev := v[step-1]
+		if penalty.JailDuration < prev.JailDuration {
+			return fmt.Errorf("downtime escalation step %d jail duration %s is lower than the previous step: %s",
+				step, penalty.JailDuration, prev.JailDuration)
+		}
+		if penalty.SlashFraction.LT(prev.SlashFraction) {
+			return fmt.Errorf("downtime escalation step %d slash fraction %s is lower than the previous step: %s",
+				step, penalty.SlashFraction, prev.SlashFraction)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestDowntimePenalty(t *testing.T) {
+	params := types.DefaultParams()
+
+	for jailCount := int64(0); jailCount < 3; jailCount++ {
+		jailDuration, slashFraction := params.DowntimePenalty(jailCount)
+		require.Equal(t, params.DowntimeJailDuration, jailDuration)
+		require.Equal(t, params.SlashFractionDowntime, slashFraction)
+	}
+
+	params.DowntimeEscalationSchedule = []types.DowntimePenalty{
+		types.NewDowntimePenalty(time.Hour, sdk.NewDecWithPrec(5, 2)),
+		types.NewDowntimePenalty(24*time.Hour, sdk.NewDecWithPrec(1, 1)),
+	}
+
+	tests := []struct {
+		jailCount        int64
+		expJailDuration  time.Duration
+		expSlashFraction sdk.Dec
+	}{
+		{0, params.DowntimeJailDuration, params.SlashFractionDowntime},
+		{1, time.Hour, sdk.NewDecWithPrec(5, 2)},
+		{2, 24 * time.Hour, sdk.NewDecWithPrec(1, 1)},
+		{10, 24 * time.Hour, sdk.NewDecWithPrec(1, 1)},
+	}
+
+	for _, tc := range tests {
+		jailDuration, slashFraction := params.DowntimePenalty(tc.jailCount)
+		require.Equal(t, tc.expJailDuration, jailDuration, "jail count %d", tc.jailCount)
+		require.Equal(t, tc.expSlashFraction, slashFraction, "jail count %d", tc.jailCount)
+	}
+}
+
+func TestValidateDowntimeEscalationSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule []types.DowntimePenalty
+		expErr   bool
+	}{
+		{"empty", nil, false},
+		{
+			"escalating",
+			[]types.DowntimePenalty{
+				types.NewDowntimePenalty(time.Hour, sdk.NewDecWithPrec(5, 2)),
+				types.NewDowntimePenalty(time.Hour, sdk.NewDecWithPrec(1, 1)),
+			},
+			false,
+		},
+		{
+			"non-positive jail duration",
+			[]types.DowntimePenalty{types.NewDowntimePenalty(0, sdk.NewDecWithPrec(5, 2))},
+			true,
+		},
+		{
+			"slash fraction too large",
+			[]types.DowntimePenalty{types.NewDowntimePenalty(time.Hour, sdk.NewDec(2))},
+			true,
+		},
+		{
+			"nil slash fraction",
+			[]types.DowntimePenalty{{JailDuration: time.Hour}},
+			true,
+		},
+		{
+			"decreasing jail duration",
+			[]types.DowntimePenalty{
+				types.NewDowntimePenalty(2*time.Hour, sdk.NewDecWithPrec(5, 2)),
+				types.NewDowntimePenalty(time.Hour, sdk.NewDecWithPrec(5, 2)),
+			},
+			true,
+		},
+		{
+			"decreasing slash fraction",
+			[]types.DowntimePenalty{
+				types.NewDowntimePenalty(time.Hour, sdk.NewDecWithPrec(1, 1)),
+				types.NewDowntimePenalty(time.Hour, sdk.NewDecWithPrec(5, 2)),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := types.DefaultGenesisState()
+			genesis.Params.DowntimeEscalationSchedule = tc.schedule
+
+			err := types.ValidateGenesis(*genesis)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -308,6 +308,129 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query the missed blocks of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksResponse struct {
+	// bitmap holds one entry per block of the signing window, set to true if the
+	// validator missed the block recorded at that window index
+	Bitmap []bool `protobuf:"varint,1,rep,packed,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// signed_blocks_window is the size of the signing window
+	SignedBlocksWindow int64 `protobuf:"varint,2,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// index_offset is the validator's signing info index offset; the next block
+	// is recorded at index_offset % signed_blocks_window
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_blocks_counter is the number of missed blocks within the window
+	MissedBlocksCounter int64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+}
+
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetBitmap() []bool {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *QueryMissedBlocksResponse) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *QueryMissedBlocksResponse) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *QueryMissedBlocksResponse) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -315,6 +438,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "cosmos.slashing.v1beta1.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "cosmos.slashing.v1beta1.QueryMissedBlocksResponse")
 }
 
 func init() {
@@ -322,42 +447,50 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0xb3, 0xfd, 0x13, 0x7e, 0xbf, 0x49, 0x10, 0x99, 0x46, 0x9b, 0x06, 0x49, 0xed, 0x0a,
+	0x6d, 0x51, 0xb3, 0xdb, 0x46, 0xc4, 0x83, 0xf4, 0x60, 0x04, 0x8b, 0x07, 0x51, 0xb7, 0xd2, 0x82,
+	0x20, 0xcb, 0x6c, 0x76, 0xb2, 0x1d, 0xba, 0x3b, 0xb3, 0xdd, 0x99, 0xf4, 0x0f, 0xe2, 0xc5, 0xb3,
+	0x07, 0xc1, 0xd7, 0xe0, 0xc1, 0x83, 0x07, 0xa1, 0x47, 0x5f, 0x40, 0x8f, 0x45, 0x2f, 0x9e, 0x44,
+	0x5a, 0x5f, 0x88, 0x64, 0x66, 0xb6, 0xdd, 0x98, 0xae, 0x4d, 0xc5, 0x53, 0x76, 0x9f, 0x79, 0xbe,
+	0xdf, 0xe7, 0x33, 0xcf, 0xcc, 0x93, 0x05, 0xd7, 0xda, 0x8c, 0x47, 0x8c, 0xdb, 0x3c, 0x44, 0x7c,
+	0x9d, 0xd0, 0xc0, 0xde, 0x5a, 0xf4, 0xb0, 0x40, 0x8b, 0xf6, 0x66, 0x17, 0x27, 0xbb, 0x56, 0x9c,
+	0x30, 0xc1, 0xe0, 0xa4, 0x4a, 0xb2, 0xd2, 0x24, 0x4b, 0x27, 0xd5, 0xae, 0x6b, 0xb5, 0x87, 0x38,
+	0x56, 0x8a, 0x63, 0x7d, 0x8c, 0x02, 0x42, 0x91, 0x20, 0x8c, 0x2a, 0x93, 0x5a, 0x25, 0x60, 0x01,
+	0x93, 0x8f, 0x76, 0xef, 0x49, 0x47, 0xaf, 0x04, 0x8c, 0x05, 0x21, 0xb6, 0x51, 0x4c, 0x6c, 0x44,
+	0x29, 0x13, 0x52, 0xc2, 0xf5, 0xea, 0x6c, 0x1e, 0xdd, 0x31, 0x89, 0xca, 0x9b, 0x52, 0x79, 0xae,
+	0xb2, 0xd7, 0xb4, 0xf2, 0xc5, 0xac, 0x00, 0xf8, 0xb4, 0x07, 0xf6, 0x04, 0x25, 0x28, 0xe2, 0x0e,
+	0xde, 0xec, 0x62, 0x2e, 0xcc, 0x67, 0x60, 0xa2, 0x2f, 0xca, 0x63, 0x46, 0x39, 0x86, 0x4b, 0xa0,
+	0x18, 0xcb, 0x48, 0xd5, 0xb8, 0x6a, 0xcc, 0x97, 0x9a, 0xd3, 0x56, 0xce, 0xce, 0x2d, 0x25, 0x6c,
+	0x8d, 0xed, 0x7f, 0x9f, 0x2e, 0x38, 0x5a, 0x64, 0xae, 0x82, 0x49, 0xe9, 0xba, 0x42, 0x02, 0x4a,
+	0x68, 0xf0, 0x90, 0x76, 0x98, 0x2e, 0x08, 0xef, 0x82, 0x72, 0x9b, 0x51, 0xee, 0x22, 0xdf, 0x4f,
+	0x30, 0x57, 0xfe, 0xff, 0xb7, 0xaa, 0x5f, 0xf6, 0x1a, 0x15, 0x5d, 0xe2, 0x9e, 0x5a, 0x59, 0x11,
+	0x09, 0xa1, 0x81, 0x53, 0xea, 0x65, 0xeb, 0x90, 0xb9, 0x0b, 0xaa, 0x83, 0xbe, 0x1a, 0xf9, 0x05,
+	0xb8, 0xb8, 0x85, 0x42, 0x97, 0xab, 0x25, 0x97, 0xd0, 0x0e, 0xd3, 0xf0, 0x8d, 0x5c, 0xf8, 0x55,
+	0x14, 0x12, 0x1f, 0x09, 0x96, 0x64, 0x0c, 0xf5, 0x56, 0x2e, 0x6c, 0xa1, 0x30, 0x13, 0x35, 0xbd,
+	0xc1, 0xd2, 0x69, 0x13, 0xe1, 0x03, 0x00, 0x4e, 0x4e, 0x59, 0x17, 0x9d, 0x4d, 0x8b, 0xf6, 0xae,
+	0x84, 0xa5, 0x2e, 0xd1, 0x49, 0xcf, 0x02, 0xac, 0xb5, 0x4e, 0x46, 0x69, 0x7e, 0x34, 0xc0, 0xd4,
+	0x29, 0x45, 0xf4, 0x06, 0x97, 0xc1, 0x98, 0xde, 0xd4, 0xe8, 0xdf, 0x6e, 0x4a, 0x1a, 0xc0, 0xe5,
+	0x3e, 0xdc, 0x11, 0x89, 0x3b, 0x77, 0x26, 0xae, 0xa2, 0xe8, 0xe3, 0x5d, 0xd3, 0x3d, 0x79, 0x44,
+	0x38, 0xc7, 0x7e, 0x2b, 0x64, 0xed, 0x0d, 0xfe, 0x4f, 0xce, 0xf9, 0x73, 0xda, 0x88, 0x7e, 0x67,
+	0xdd, 0x88, 0xcb, 0xa0, 0xe8, 0x11, 0x11, 0xa1, 0x58, 0xb6, 0xe2, 0x3f, 0x47, 0xbf, 0xc1, 0x05,
+	0x50, 0xe9, 0x9d, 0x3e, 0xf6, 0x5d, 0x4f, 0x0a, 0xdc, 0x6d, 0x42, 0x7d, 0xb6, 0x2d, 0x77, 0x38,
+	0xea, 0x40, 0xb5, 0xa6, 0xbc, 0xd6, 0xe4, 0x0a, 0x9c, 0x01, 0x65, 0x42, 0x7d, 0xbc, 0xe3, 0xb2,
+	0x4e, 0x87, 0x63, 0x51, 0x1d, 0x95, 0x99, 0x25, 0x19, 0x7b, 0x2c, 0x43, 0xb0, 0x09, 0x2e, 0x45,
+	0x12, 0x22, 0x35, 0x6d, 0xb3, 0x2e, 0x15, 0x38, 0xa9, 0x8e, 0xc9, 0xdc, 0x89, 0x28, 0x43, 0x78,
+	0x5f, 0x2d, 0x35, 0x3f, 0x8c, 0x83, 0x71, 0x89, 0x0f, 0xdf, 0x18, 0xa0, 0xa8, 0x26, 0x04, 0xde,
+	0xc8, 0x3d, 0xb0, 0xc1, 0xb1, 0xac, 0xdd, 0x1c, 0x2e, 0x59, 0x35, 0xc4, 0x9c, 0x7b, 0xfd, 0xf5,
+	0xe7, 0xbb, 0x91, 0x19, 0x38, 0x6d, 0xe7, 0xfd, 0x4d, 0xa8, 0xb9, 0x84, 0x9f, 0x0c, 0x50, 0xca,
+	0xdc, 0x0a, 0xb8, 0xf0, 0xe7, 0x32, 0x83, 0xe3, 0x5b, 0x5b, 0x3c, 0x87, 0x42, 0xd3, 0x2d, 0x49,
+	0xba, 0x3b, 0xf0, 0x76, 0x2e, 0x5d, 0x76, 0x66, 0xb9, 0xfd, 0x32, 0x7b, 0x6f, 0x5e, 0xc1, 0xf7,
+	0x06, 0x28, 0x67, 0x6c, 0x39, 0x1c, 0x1e, 0xe1, 0xb8, 0x9d, 0xcd, 0xf3, 0x48, 0x34, 0xb6, 0x25,
+	0xb1, 0xe7, 0xe1, 0xec, 0x70, 0xd8, 0x70, 0xcf, 0x00, 0xe5, 0xec, 0x75, 0x3d, 0x8b, 0xf3, 0x94,
+	0xa1, 0xa9, 0x35, 0xcf, 0x23, 0x19, 0xba, 0xbd, 0x7d, 0xf7, 0xf7, 0xb7, 0xf6, 0xb6, 0x96, 0xf7,
+	0x0f, 0xeb, 0xc6, 0xc1, 0x61, 0xdd, 0xf8, 0x71, 0x58, 0x37, 0xde, 0x1e, 0xd5, 0x0b, 0x07, 0x47,
+	0xf5, 0xc2, 0xb7, 0xa3, 0x7a, 0xe1, 0x79, 0x23, 0x20, 0x62, 0xbd, 0xeb, 0x59, 0x6d, 0x16, 0xa5,
+	0xd6, 0xea, 0xa7, 0xc1, 0xfd, 0x0d, 0x7b, 0xe7, 0xa4, 0x8e, 0xd8, 0x8d, 0x31, 0xf7, 0x8a, 0xf2,
+	0x33, 0x73, 0xeb, 0xd7, 0x00, 0xfd, 0x36, 0xcd, 0xce, 0x49, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +511,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks bitmap of the current signing window
+	// of given cons address
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +551,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -423,6 +568,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks bitmap of the current signing window
+	// of given cons address
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +586,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,6 +648,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -513,6 +682,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -721,6 +894,87 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bitmap) > 0 {
+		for iNdEx := len(m.Bitmap) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Bitmap[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -808,6 +1062,40 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bitmap) > 0 {
+		n += 1 + sovQuery(uint64(len(m.Bitmap))) + len(m.Bitmap)*1
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovQuery(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1318,6 +1606,265 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Bitmap = append(m.Bitmap, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Bitmap) == 0 {
+					m.Bitmap = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Bitmap = append(m.Bitmap, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -48,6 +48,10 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// The number of times the validator has been jailed for liveness downtime.
+	// It selects the step of the downtime escalation schedule applied on the
+	// next downtime infraction.
+	JailCount int64 `protobuf:"varint,7,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +128,60 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetJailCount() int64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+// DowntimePenalty defines the penalty applied to a validator for a single
+// liveness downtime infraction.
+type DowntimePenalty struct {
+	JailDuration  time.Duration                          `protobuf:"bytes,1,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *DowntimePenalty) Reset()         { *m = DowntimePenalty{} }
+func (m *DowntimePenalty) String() string { return proto.CompactTextString(m) }
+func (*DowntimePenalty) ProtoMessage()    {}
+func (*DowntimePenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *DowntimePenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimePenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePenalty.Merge(m, src)
+}
+func (m *DowntimePenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePenalty proto.InternalMessageInfo
+
+func (m *DowntimePenalty) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,13 +189,19 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_escalation_schedule defines the penalties applied to validators
+	// that have already been jailed for downtime before. The i-th entry applies
+	// to a validator's (i+2)-th downtime jailing, and the last entry applies to
+	// all subsequent jailings. When empty, every downtime infraction is
+	// penalized with downtime_jail_duration and slash_fraction_downtime.
+	DowntimeEscalationSchedule []DowntimePenalty `protobuf:"bytes,6,rep,name=downtime_escalation_schedule,json=downtimeEscalationSchedule,proto3" json:"downtime_escalation_schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,8 +244,16 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeEscalationSchedule() []DowntimePenalty {
+	if m != nil {
+		return m.DowntimeEscalationSchedule
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimePenalty)(nil), "cosmos.slashing.v1beta1.DowntimePenalty")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -190,43 +262,49 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8e, 0x9b, 0x34, 0x6d, 0x2f, 0xed, 0xef, 0x27, 0x1d, 0x29, 0x75, 0x23, 0x70, 0x42, 0x87,
+	0x2a, 0x4b, 0x1d, 0x1a, 0x36, 0x36, 0x42, 0x80, 0x02, 0x03, 0x55, 0x42, 0x41, 0xb0, 0x58, 0x67,
+	0xdf, 0xc5, 0x39, 0x6a, 0xdf, 0x45, 0xbe, 0x33, 0x6d, 0xbf, 0x45, 0xc7, 0x8e, 0x1d, 0x99, 0x11,
+	0x3b, 0x6b, 0xc7, 0x8a, 0x01, 0x21, 0x86, 0x82, 0xd2, 0x85, 0x8f, 0x81, 0x7c, 0x77, 0x4e, 0xff,
+	0x09, 0x84, 0x3a, 0x25, 0x7e, 0xde, 0xe7, 0x7d, 0xde, 0xf7, 0x79, 0x5e, 0xcb, 0x60, 0x35, 0xe0,
+	0x22, 0xe6, 0xa2, 0x25, 0x22, 0x24, 0x86, 0x94, 0x85, 0xad, 0xf7, 0xeb, 0x3e, 0x91, 0x68, 0x7d,
+	0x02, 0xb8, 0xa3, 0x84, 0x4b, 0x0e, 0x97, 0x34, 0xcf, 0x9d, 0xc0, 0x86, 0x57, 0xab, 0x86, 0x3c,
+	0xe4, 0x8a, 0xd3, 0xca, 0xfe, 0x69, 0x7a, 0xcd, 0x09, 0x39, 0x0f, 0x23, 0xd2, 0x52, 0x4f, 0x7e,
+	0x3a, 0x68, 0xe1, 0x34, 0x41, 0x92, 0x72, 0x66, 0xea, 0xf5, 0xcb, 0x75, 0x49, 0x63, 0x22, 0x24,
+	0x8a, 0x47, 0x86, 0xb0, 0xac, 0xe7, 0x79, 0x5a, 0xd9, 0x0c, 0x57, 0x0f, 0x2b, 0x5f, 0xa7, 0x40,
+	0xf5, 0x15, 0x8a, 0x28, 0x46, 0x92, 0x27, 0x7d, 0x1a, 0x32, 0xca, 0xc2, 0xa7, 0x6c, 0xc0, 0x61,
+	0x1b, 0xcc, 0x20, 0x8c, 0x13, 0x22, 0x84, 0x6d, 0x35, 0xac, 0xe6, 0x5c, 0xc7, 0xfe, 0xf2, 0x69,
+	0xad, 0x6a, 0x7a, 0x1f, 0xe8, 0x4a, 0x5f, 0x26, 0x94, 0x85, 0xbd, 0x9c, 0x08, 0xef, 0x80, 0x79,
+	0x21, 0x51, 0x22, 0xbd, 0x21, 0xa1, 0xe1, 0x50, 0xda, 0x53, 0x0d, 0xab, 0x59, 0xec, 0x55, 0x14,
+	0xb6, 0xa1, 0xa0, 0x8c, 0x42, 0x19, 0x26, 0xbb, 0x1e, 0x1f, 0x0c, 0x04, 0x91, 0x76, 0x51, 0x53,
+	0x14, 0xf6, 0x42, 0x41, 0xf0, 0x09, 0x98, 0x7f, 0x87, 0x68, 0x44, 0xb0, 0x97, 0x32, 0x49, 0x23,
+	0xbb, 0xd4, 0xb0, 0x9a, 0x95, 0x76, 0xcd, 0xd5, 0x2e, 0xdd, 0xdc, 0xa5, 0xfb, 0x32, 0x77, 0xd9,
+	0x99, 0x3d, 0x3a, 0xa9, 0x17, 0xf6, 0x7f, 0xd4, 0xad, 0x5e, 0x45, 0x77, 0x6e, 0x65, 0x8d, 0xd0,
+	0x01, 0x40, 0xf2, 0xd8, 0x17, 0x92, 0x33, 0x82, 0xed, 0xe9, 0x86, 0xd5, 0x9c, 0xed, 0x9d, 0x43,
+	0x60, 0x1b, 0x2c, 0xc6, 0x54, 0x08, 0x82, 0x3d, 0x3f, 0xe2, 0xc1, 0xb6, 0xf0, 0x02, 0x9e, 0x32,
+	0x49, 0x12, 0xbb, 0xac, 0x96, 0xba, 0xa1, 0x8b, 0x1d, 0x55, 0x7b, 0xa8, 0x4b, 0xf0, 0x36, 0x00,
+	0xd9, 0x08, 0x4d, 0xb5, 0x67, 0x14, 0x71, 0x2e, 0x43, 0x14, 0xe1, 0xfe, 0xec, 0xc1, 0x61, 0xbd,
+	0xf0, 0xeb, 0xb0, 0x6e, 0xad, 0x7c, 0xb4, 0xc0, 0xff, 0x5d, 0xbe, 0xc3, 0xb2, 0x5b, 0x6c, 0x12,
+	0x86, 0x22, 0xb9, 0x07, 0x37, 0xc0, 0x82, 0x6a, 0xce, 0xef, 0xa7, 0x92, 0xad, 0xb4, 0x97, 0xaf,
+	0x58, 0xeb, 0x1a, 0x82, 0x76, 0x76, 0x90, 0x39, 0x53, 0x99, 0xe4, 0x38, 0xdc, 0x02, 0xff, 0xa9,
+	0x97, 0xc7, 0x1b, 0x24, 0x28, 0x50, 0x52, 0x59, 0xd6, 0xf3, 0x1d, 0x37, 0xe3, 0x7f, 0x3f, 0xa9,
+	0xaf, 0x86, 0x54, 0x0e, 0x53, 0xdf, 0x0d, 0x78, 0x6c, 0xee, 0x6d, 0x7e, 0xd6, 0x04, 0xde, 0x6e,
+	0xc9, 0xbd, 0x11, 0x11, 0x6e, 0x97, 0x04, 0xbd, 0x05, 0xa5, 0xf2, 0xd8, 0x88, 0xac, 0x7c, 0x2e,
+	0x81, 0xf2, 0x26, 0x4a, 0x50, 0x2c, 0xe0, 0x5d, 0x50, 0x15, 0x34, 0x64, 0x67, 0xe1, 0xec, 0x50,
+	0x86, 0xf9, 0x8e, 0x5a, 0xb9, 0xd8, 0x83, 0xba, 0xa6, 0xb3, 0x79, 0xad, 0x2a, 0x10, 0x65, 0x71,
+	0x32, 0xcf, 0x74, 0x8d, 0x48, 0x92, 0xb7, 0x5c, 0x6f, 0x35, 0x18, 0x53, 0xd6, 0x57, 0x5a, 0x9b,
+	0x24, 0x31, 0x23, 0xde, 0x80, 0x9b, 0xd8, 0x64, 0xea, 0x5d, 0x4c, 0xb2, 0xf8, 0xef, 0x49, 0x56,
+	0x73, 0x89, 0x67, 0xe7, 0x13, 0xdd, 0x06, 0xb5, 0x8b, 0x89, 0x7a, 0x98, 0xa7, 0x7e, 0x44, 0x94,
+	0x1f, 0xbb, 0x74, 0x2d, 0x0b, 0x4b, 0x17, 0xd2, 0xed, 0x2a, 0xbd, 0xcc, 0x12, 0x1c, 0x80, 0xa5,
+	0x2b, 0xc3, 0xf4, 0x4e, 0xf6, 0xf4, 0xb5, 0x26, 0x2d, 0x5e, 0x9a, 0xa4, 0xc5, 0xe0, 0x08, 0xdc,
+	0x9a, 0xe4, 0x45, 0x44, 0x80, 0x22, 0xe5, 0xd5, 0x13, 0xc1, 0x90, 0xe0, 0x34, 0x22, 0x76, 0xb9,
+	0x51, 0x6c, 0x56, 0xda, 0x4d, 0xf7, 0x0f, 0xdf, 0x23, 0xf7, 0xd2, 0x0b, 0xdc, 0x29, 0x65, 0x6b,
+	0xf5, 0x6a, 0xb9, 0xe6, 0xa3, 0x89, 0x64, 0xdf, 0x28, 0x76, 0x9e, 0x7f, 0x18, 0x3b, 0xd6, 0xd1,
+	0xd8, 0xb1, 0x8e, 0xc7, 0x8e, 0xf5, 0x73, 0xec, 0x58, 0xfb, 0xa7, 0x4e, 0xe1, 0xf8, 0xd4, 0x29,
+	0x7c, 0x3b, 0x75, 0x0a, 0x6f, 0xd7, 0xfe, 0x6a, 0x67, 0xf7, 0xec, 0xc3, 0xa9, 0x9c, 0xf9, 0x65,
+	0x75, 0xc6, 0x7b, 0xbf, 0x07, 0x00, 0x6e, 0xe7, 0x5e, 0xbb, 0x58, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +344,36 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.JailCount != that1.JailCount {
+		return false
+	}
+	return true
+}
+func (this *DowntimePenalty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimePenalty)
+	if !ok {
+		that2, ok := that.(DowntimePenalty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +410,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.DowntimeEscalationSchedule) != len(that1.DowntimeEscalationSchedule) {
+		return false
+	}
+	for i := range this.DowntimeEscalationSchedule {
+		if !this.DowntimeEscalationSchedule[i].Equal(&that1.DowntimeEscalationSchedule[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +440,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -367,6 +488,47 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimePenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -387,6 +549,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeEscalationSchedule) > 0 {
+		for iNdEx := len(m.DowntimeEscalationSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeEscalationSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +583,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -468,6 +644,22 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.JailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.JailCount))
+	}
+	return n
+}
+
+func (m *DowntimePenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -488,6 +680,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.DowntimeEscalationSchedule) > 0 {
+		for _, e := range m.DowntimeEscalationSchedule {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -668,6 +866,141 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimePenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -869,6 +1202,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeEscalationSchedule = append(m.DowntimeEscalationSchedule, DowntimePenalty{})
+			if err := m.DowntimeEscalationSchedule[len(m.DowntimeEscalationSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
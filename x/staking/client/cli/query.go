@@ -39,6 +39,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecord(),
+		GetCmdQueryTokenizeShareRecordsByOwner(),
+		GetCmdQueryLiquidStaked(),
+		GetCmdQueryValidatorPowerAt(),
+		GetCmdQueryDelegationAt(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +749,247 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecord implements the tokenize share record query command.
+func GetCmdQueryTokenizeShareRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record, along with the denom of its share tokens.
+
+Example:
+$ %s query staking tokenize-share-record 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecord(cmd.Context(), &types.QueryTokenizeShareRecordRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsByOwner implements the command to query the
+// tokenize share records of an owner.
+func GetCmdQueryTokenizeShareRecordsByOwner() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records [owner-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an account.
+
+Example:
+$ %s query staking tokenize-share-records %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsByOwner(cmd.Context(), &types.QueryTokenizeShareRecordsByOwnerRequest{
+				Owner:      owner.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQueryLiquidStaked implements the liquid staked query command.
+func GetCmdQueryLiquidStaked() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "liquid-staked [validator-addr]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the amount of tokenized tokens, in total and for a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokenized tokens and, if a validator is given,
+the amount of its tokenized delegator shares.
+
+Example:
+$ %s query staking liquid-staked %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLiquidStakedRequest{}
+			if len(args) > 0 {
+				valAddr, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = valAddr.String()
+			}
+
+			res, err := queryClient.LiquidStaked(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorPowerAt implements the validator power at height query
+// command.
+func GetCmdQueryValidatorPowerAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-power-at [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the power of the bonded validators at given height from the historical archive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consensus power of the bonded validators at given height from the
+historical archive, which must be enabled with the historical_archive param.
+
+Example:
+$ %s query staking validator-power-at 5
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			res, err := queryClient.ValidatorPowerAt(cmd.Context(), &types.QueryValidatorPowerAtRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegationAt implements the delegation at height query command.
+func GetCmdQueryDelegationAt() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegation-at [delegator-addr] [validator-addr] [height]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the shares of a delegation at given height from the historical archive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the shares of a delegation at given height from the historical archive,
+which must be enabled with the historical_archive param.
+
+Example:
+$ %s query staking delegation-at %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 5
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			res, err := queryClient.DelegationAt(cmd.Context(), &types.QueryDelegationAtRequest{
+				DelegatorAddr: delAddr.String(),
+				ValidatorAddr: valAddr.String(),
+				Height:        height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
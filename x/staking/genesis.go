@@ -103,6 +103,33 @@ func InitGenesis(
 		}
 	}
 
+	// the tokenized shares of each validator and the total amount of tokenized
+	// tokens are derived from the delegations of the records
+	totalLiquidStakedTokens := sdk.ZeroInt()
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+
+		validator, found := keeper.GetValidator(ctx, valAddr)
+		if !found {
+			panic(fmt.Sprintf("validator %s of tokenize share record %d not found", record.Validator, record.Id))
+		}
+
+		keeper.SetValidatorLiquidShares(ctx, valAddr, keeper.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		totalLiquidStakedTokens = totalLiquidStakedTokens.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	keeper.SetTotalLiquidStakedTokens(ctx, totalLiquidStakedTokens)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -204,6 +231,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
 	}
 }
 
@@ -239,9 +269,33 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record id in genesis state: %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastID)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
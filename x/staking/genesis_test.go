@@ -240,6 +240,24 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate tokenize share records
+		{"tokenize share records", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+			}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id after last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(2, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+			}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -88,6 +89,38 @@ func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddres
 	return delegations[:i] // trim if the array length < maxRetrieve
 }
 
+// GetDelegatorBonded returns the amount of tokens a delegator has delegated
+// to all validators, irrespective of the validators' bond status.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroDec()
+
+	k.IterateDelegatorDelegations(ctx, delegator, func(delegation types.Delegation) bool {
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			bonded = bonded.Add(validator.TokensFromShares(delegation.Shares))
+		}
+		return false
+	})
+
+	return bonded.TruncateInt()
+}
+
+// IterateDelegatorDelegations iterates through one delegator's delegations.
+func (k Keeper) IterateDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(delegation types.Delegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	delegatorPrefixKey := types.GetDelegationsKey(delegator)
+
+	iterator := sdk.KVStorePrefixIterator(store, delegatorPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
 // set a delegation
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
@@ -98,6 +131,7 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+	k.archiveDelegationShares(ctx, delegatorAddress, delegation.GetValidatorAddr(), delegation.Shares)
 }
 
 // remove a delegation
@@ -113,6 +147,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	k.archiveDelegationShares(ctx, delegatorAddress, delegation.GetValidatorAddr(), sdk.ZeroDec())
 	return nil
 }
 
@@ -187,6 +222,36 @@ func (k Keeper) IterateUnbondingDelegations(ctx sdk.Context, fn func(index int64
 	}
 }
 
+// GetDelegatorUnbonding returns the total amount a delegator has unbonding.
+func (k Keeper) GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	unbonding := sdk.ZeroInt()
+
+	k.IterateDelegatorUnbondingDelegations(ctx, delegator, func(ubd types.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+		return false
+	})
+
+	return unbonding
+}
+
+// IterateDelegatorUnbondingDelegations iterates through one delegator's
+// unbonding delegations.
+func (k Keeper) IterateDelegatorUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(ubd types.UnbondingDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUBDsKey(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd := types.MustUnmarshalUBD(k.cdc, iterator.Value())
+		if cb(ubd) {
+			break
+		}
+	}
+}
+
 // HasMaxUnbondingDelegationEntries - check if unbonding delegation has maximum number of entries
 func (k Keeper) HasMaxUnbondingDelegationEntries(ctx sdk.Context,
 	delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) bool {
@@ -769,6 +834,147 @@ func (k Keeper) Undelegate(
 	return completionTime, nil
 }
 
+// TransferUnbonding moves up to wantAmt tokens of unbonding entries from one
+// delegator to another, preserving the completion time of every entry. Entries
+// are split if necessary, and transfer stops early if the recipient reaches the
+// maximum number of unbonding entries. It returns the amount transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		remaining := entry.Balance.Sub(toXfer)
+		if remaining.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+			continue
+		}
+
+		entry.Balance = remaining
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares delegation shares of a validator
+// from one delegator to another without unbonding them. Shares which were
+// received through a redelegation that has not completed yet are not
+// transferred, since they remain subject to slashing for infractions of the
+// source validator. It returns the amount of shares transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	transferred := sdk.ZeroDec()
+	if !wantShares.IsPositive() {
+		return transferred, nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred, types.ErrNoValidatorFound
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	available := delFrom.Shares
+	for _, red := range k.GetRedelegations(ctx, fromAddr, math.MaxUint16) {
+		if red.ValidatorDstAddress != valAddr.String() {
+			continue
+		}
+		for _, entry := range red.Entries {
+			available = available.Sub(entry.SharesDst)
+		}
+	}
+	if !available.IsPositive() {
+		return transferred, nil
+	}
+
+	transferred = sdk.MinDec(available, wantShares)
+	remaining := delFrom.Shares.Sub(transferred)
+
+	// update the source delegation first, so that rewards are withdrawn
+	// before its shares are modified
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if remaining.IsZero() {
+		if err := k.RemoveDelegation(ctx, delFrom); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		delFrom.Shares = remaining
+		k.SetDelegation(ctx, delFrom)
+		if err := k.AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	// If the source is the operator of the validator and the transfer
+	// decreases the validator's self-delegation below their minimum, we jail
+	// the validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(remaining).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	var err error
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		err = k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return transferred, nil
+}
+
 // CompleteUnbonding completes the unbonding of all mature entries in the
 // retrieved unbonding delegation object and returns the total unbonding balance
 // or an error upon failure.
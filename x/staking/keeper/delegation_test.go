@@ -991,3 +991,68 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completion := time.Unix(100, 0).UTC()
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 1, completion, sdk.NewInt(5))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 2, completion, sdk.NewInt(10))
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[0]))
+
+	// transfer the first entry and part of the second one
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(8))
+	require.Equal(t, sdk.NewInt(8), transferred)
+	require.Equal(t, sdk.NewInt(7), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[0]))
+	require.Equal(t, sdk.NewInt(8), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, completion, ubd.Entries[1].CompletionTime)
+
+	// transfer more than what is left
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(7), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(100))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddrs[1], valAddrs[0], issuedShares))
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+
+	// transfer part of the delegation
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[2], valAddrs[0], sdk.NewDec(40))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), transferred)
+	require.Equal(t, sdk.NewInt(60), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+	require.Equal(t, sdk.NewInt(40), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[2]))
+
+	// the validator's tokens are not affected
+	resValidator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+
+	// transfer more than what is left, removing the source delegation
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[2], valAddrs[0], sdk.NewDec(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(60), transferred)
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.False(t, found)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -470,6 +471,143 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(c context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record, Denom: record.GetShareTokenDenom()}, nil
+}
+
+// TokenizeShareRecordsByOwner queries the tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsByOwner(c context.Context, req *types.QueryTokenizeShareRecordsByOwnerRequest) (*types.QueryTokenizeShareRecordsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var records []types.TokenizeShareRecord
+
+	store := ctx.KVStore(k.storeKey)
+	ownerStore := prefix.NewStore(store, types.GetTokenizeShareRecordsByOwnerKey(owner))
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return fmt.Errorf("tokenize share record %d not found", sdk.BigEndianToUint64(key))
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsByOwnerResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// LiquidStaked queries the amount of tokenized tokens, in total and for a validator if one is given
+func (k Querier) LiquidStaked(c context.Context, req *types.QueryLiquidStakedRequest) (*types.QueryLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryLiquidStakedResponse{
+		TotalLiquidStakedTokens: k.GetTotalLiquidStakedTokens(ctx),
+		ValidatorLiquidShares:   sdk.ZeroDec(),
+	}
+
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		res.ValidatorLiquidShares = k.GetValidatorLiquidShares(ctx, valAddr)
+	}
+
+	return res, nil
+}
+
+// ValidatorPowerAt queries the power of the bonded validators at a height from the historical archive
+func (k Querier) ValidatorPowerAt(c context.Context, req *types.QueryValidatorPowerAtRequest) (*types.QueryValidatorPowerAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.checkArchivedHeight(ctx, req.Height); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorPowerAtResponse{Validators: k.GetValidatorPowersAt(ctx, req.Height)}, nil
+}
+
+// DelegationAt queries the shares of a delegation at a height from the historical archive
+func (k Querier) DelegationAt(c context.Context, req *types.QueryDelegationAtRequest) (*types.QueryDelegationAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkArchivedHeight(ctx, req.Height); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationAtResponse{Shares: k.GetDelegationSharesAt(ctx, delAddr, valAddr, req.Height)}, nil
+}
+
+// checkArchivedHeight returns an error if the historical archive does not cover the given height
+func (k Querier) checkArchivedHeight(ctx sdk.Context, height int64) error {
+	startHeight, found := k.GetHistoricalArchiveStartHeight(ctx)
+	if !found {
+		return status.Error(codes.FailedPrecondition, "historical archive is not enabled")
+	}
+
+	if height < startHeight || height > ctx.BlockHeight() {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"height %d is outside of the archived heights [%d, %d]", height, startHeight, ctx.BlockHeight(),
+		)
+	}
+
+	return nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -769,6 +770,57 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryHistoricalArchive() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+
+	delReq := &types.QueryDelegationAtRequest{
+		DelegatorAddr: addrs[0].String(),
+		ValidatorAddr: vals[0].OperatorAddress,
+		Height:        2,
+	}
+
+	// the archive is not enabled
+	_, err := queryClient.ValidatorPowerAt(gocontext.Background(), &types.QueryValidatorPowerAtRequest{Height: 2})
+	suite.Require().Error(err)
+	_, err = queryClient.DelegationAt(gocontext.Background(), delReq)
+	suite.Require().Error(err)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(2)
+	app.StakingKeeper.TrackHistoricalArchive(ctx)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: app.StakingKeeper})
+	queryClient = types.NewQueryClient(queryHelper)
+
+	powerRes, err := queryClient.ValidatorPowerAt(gocontext.Background(), &types.QueryValidatorPowerAtRequest{Height: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(powerRes.Validators, len(app.StakingKeeper.GetLastValidators(ctx)))
+	suite.Require().Contains(powerRes.Validators, types.ValidatorPower{
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            app.StakingKeeper.GetLastValidatorPower(ctx, vals[0].GetOperator()),
+	})
+
+	delRes, err := queryClient.DelegationAt(gocontext.Background(), delReq)
+	suite.Require().NoError(err)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], vals[0].GetOperator())
+	suite.Require().True(found)
+	suite.Require().Equal(delegation.Shares, delRes.Shares)
+
+	// heights outside of the archive
+	_, err = queryClient.ValidatorPowerAt(gocontext.Background(), &types.QueryValidatorPowerAtRequest{Height: 1})
+	suite.Require().Error(err)
+	_, err = queryClient.ValidatorPowerAt(gocontext.Background(), &types.QueryValidatorPowerAtRequest{Height: 3})
+	suite.Require().Error(err)
+
+	// invalid addresses
+	_, err = queryClient.DelegationAt(gocontext.Background(), &types.QueryDelegationAtRequest{ValidatorAddr: vals[0].OperatorAddress, Height: 2})
+	suite.Require().Error(err)
+	_, err = queryClient.DelegationAt(gocontext.Background(), &types.QueryDelegationAtRequest{DelegatorAddr: addrs[0].String(), Height: 2})
+	suite.Require().Error(err)
+}
+
 func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sdk.AccAddress, []sdk.ValAddress, []types.Validator) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, app.StakingKeeper.TokensFromConsensusPower(ctx, 300))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
@@ -803,3 +855,59 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 
 	return addrs, valAddrs, vals
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+
+	record1 := types.NewTokenizeShareRecord(1, addrs[0], vals[0].GetOperator())
+	record2 := types.NewTokenizeShareRecord(2, addrs[1], vals[0].GetOperator())
+	record3 := types.NewTokenizeShareRecord(3, addrs[0], vals[1].GetOperator())
+	for _, record := range []types.TokenizeShareRecord{record1, record2, record3} {
+		app.StakingKeeper.SetTokenizeShareRecord(ctx, record)
+	}
+
+	res, err := queryClient.TokenizeShareRecord(gocontext.Background(), &types.QueryTokenizeShareRecordRequest{Id: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(record2, res.Record)
+	suite.Require().Equal(record2.GetShareTokenDenom(), res.Denom)
+
+	_, err = queryClient.TokenizeShareRecord(gocontext.Background(), &types.QueryTokenizeShareRecordRequest{Id: 4})
+	suite.Require().Error(err)
+
+	ownerRes, err := queryClient.TokenizeShareRecordsByOwner(gocontext.Background(),
+		&types.QueryTokenizeShareRecordsByOwnerRequest{Owner: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecord{record1, record3}, ownerRes.Records)
+
+	ownerRes, err = queryClient.TokenizeShareRecordsByOwner(gocontext.Background(),
+		&types.QueryTokenizeShareRecordsByOwnerRequest{Owner: addrs[0].String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecord{record1}, ownerRes.Records)
+	suite.Require().Equal(uint64(2), ownerRes.Pagination.Total)
+
+	_, err = queryClient.TokenizeShareRecordsByOwner(gocontext.Background(), &types.QueryTokenizeShareRecordsByOwnerRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryLiquidStaked() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(100))
+	app.StakingKeeper.SetValidatorLiquidShares(ctx, vals[0].GetOperator(), sdk.NewDec(60))
+
+	res, err := queryClient.LiquidStaked(gocontext.Background(), &types.QueryLiquidStakedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), res.TotalLiquidStakedTokens)
+	suite.Require().Equal(sdk.ZeroDec(), res.ValidatorLiquidShares)
+
+	res, err = queryClient.LiquidStaked(gocontext.Background(), &types.QueryLiquidStakedRequest{ValidatorAddr: vals[0].OperatorAddress})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(60), res.ValidatorLiquidShares)
+
+	res, err = queryClient.LiquidStaked(gocontext.Background(), &types.QueryLiquidStakedRequest{ValidatorAddr: vals[1].OperatorAddress})
+	suite.Require().NoError(err)
+	suite.Require().True(res.ValidatorLiquidShares.IsZero())
+
+	_, err = queryClient.LiquidStaked(gocontext.Background(), &types.QueryLiquidStakedRequest{ValidatorAddr: "invalid"})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// historicalArchiveBatchSize is the maximum number of store entries deleted or
+// archived per block while the historical archive starts.
+const historicalArchiveBatchSize = 1000
+
+// GetHistoricalArchiveStartHeight returns the height from which the historical
+// archive is complete, and false if the archive is not enabled.
+func (k Keeper) GetHistoricalArchiveStartHeight(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HistoricalArchiveStartHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// TrackHistoricalArchive starts or stops the historical archive according to
+// the HistoricalArchive param. When the archive starts, the entries of any
+// previous archive are deleted, since changes were not recorded while it was
+// stopped, and the current validator powers and delegation shares are archived
+// as the starting point of the new one. Both steps are spread over several
+// blocks, and the start height is only set once they are done.
+func (k Keeper) TrackHistoricalArchive(ctx sdk.Context) {
+	k.trackHistoricalArchive(ctx, historicalArchiveBatchSize)
+}
+
+func (k Keeper) trackHistoricalArchive(ctx sdk.Context, batchSize int) {
+	store := ctx.KVStore(k.storeKey)
+	_, started := k.GetHistoricalArchiveStartHeight(ctx)
+
+	start := store.Get(types.HistoricalArchiveCursorKey)
+
+	if !k.HistoricalArchive(ctx) {
+		// stop the archive, or abort its start
+		if started {
+			store.Delete(types.HistoricalArchiveStartHeightKey)
+		}
+		if start != nil {
+			store.Delete(types.HistoricalArchiveCursorKey)
+		}
+		return
+	}
+	if started {
+		return
+	}
+
+	if start == nil {
+		start = types.ArchivedValidatorKey
+	}
+
+	next := k.startHistoricalArchive(ctx, start, batchSize)
+	if next != nil {
+		store.Set(types.HistoricalArchiveCursorKey, next)
+		return
+	}
+
+	store.Delete(types.HistoricalArchiveCursorKey)
+	store.Set(types.HistoricalArchiveStartHeightKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// startHistoricalArchive processes at most batchSize store entries from start
+// and returns the key to continue from at the next block, or nil once the
+// archive is complete. The entries of the previous archive, whose prefixes are
+// contiguous, are deleted first; then the current validator powers and the
+// shares of every delegation are archived.
+func (k Keeper) startHistoricalArchive(ctx sdk.Context, start []byte, batchSize int) []byte {
+	store := ctx.KVStore(k.storeKey)
+
+	if start[0] != types.DelegationKey[0] {
+		// collect the batch first, as deleting writes to the store
+		keys := make([][]byte, 0, batchSize)
+		var next []byte
+		iter := store.Iterator(start, sdk.PrefixEndBytes(types.DelegationArchiveKey))
+		for ; iter.Valid(); iter.Next() {
+			if len(keys) == batchSize {
+				next = iter.Key()
+				break
+			}
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		if next != nil {
+			return next
+		}
+		batchSize -= len(keys)
+
+		// the bonded validators are bounded by MaxValidators, so their powers
+		// are archived at once
+		powers := make(map[string]int64)
+		var operators []sdk.ValAddress
+		k.IterateLastValidatorPowers(ctx, func(operator sdk.ValAddress, power int64) bool {
+			operators = append(operators, operator)
+			powers[operator.String()] = power
+			return false
+		})
+		for _, operator := range operators {
+			k.setArchivedValidatorPower(ctx, operator, powers[operator.String()])
+		}
+
+		start = types.DelegationKey
+	}
+
+	delegations := make([]types.Delegation, 0, batchSize)
+	var next []byte
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.DelegationKey))
+	for ; iter.Valid(); iter.Next() {
+		if len(delegations) == batchSize {
+			next = iter.Key()
+			break
+		}
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, iter.Value()))
+	}
+	iter.Close()
+
+	for _, delegation := range delegations {
+		k.setArchivedDelegationShares(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr(), delegation.Shares)
+	}
+
+	return next
+}
+
+// recordingHistoricalArchive returns true if changes must be archived, that is
+// once the archive has started or while the delegation shares are archived to
+// start it.
+func (k Keeper) recordingHistoricalArchive(ctx sdk.Context) bool {
+	if _, started := k.GetHistoricalArchiveStartHeight(ctx); started {
+		return true
+	}
+
+	cursor := ctx.KVStore(k.storeKey).Get(types.HistoricalArchiveCursorKey)
+	return cursor != nil && cursor[0] == types.DelegationKey[0]
+}
+
+// archiveValidatorPower records the power of a validator at the current height
+// if the historical archive is enabled. The archive is not charged to the gas
+// meter of the transaction.
+func (k Keeper) archiveValidatorPower(ctx sdk.Context, operator sdk.ValAddress, power int64) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !k.recordingHistoricalArchive(ctx) {
+		return
+	}
+
+	k.setArchivedValidatorPower(ctx, operator, power)
+}
+
+// archiveDelegationShares records the shares of a delegation at the current
+// height if the historical archive is enabled. The archive is not charged to
+// the gas meter of the transaction.
+func (k Keeper) archiveDelegationShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !k.recordingHistoricalArchive(ctx) {
+		return
+	}
+
+	k.setArchivedDelegationShares(ctx, delAddr, valAddr, shares)
+}
+
+func (k Keeper) setArchivedValidatorPower(ctx sdk.Context, operator sdk.ValAddress, power int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetArchivedValidatorKey(operator), []byte{})
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: power})
+	store.Set(types.GetValidatorPowerArchiveKey(operator, ctx.BlockHeight()), bz)
+}
+
+func (k Keeper) setArchivedDelegationShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetDelegationArchiveKey(delAddr, valAddr, ctx.BlockHeight()), bz)
+}
+
+// GetValidatorPowerAt returns the archived power of a validator at the given
+// height, which is zero if the validator was not bonded.
+func (k Keeper) GetValidatorPowerAt(ctx sdk.Context, operator sdk.ValAddress, height int64) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	// the last change at or before the height
+	iterator := store.ReverseIterator(
+		types.GetValidatorPowerArchivePrefix(operator), types.GetValidatorPowerArchiveKey(operator, height+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	intV := gogotypes.Int64Value{}
+	k.cdc.MustUnmarshal(iterator.Value(), &intV)
+
+	return intV.GetValue()
+}
+
+// GetValidatorPowersAt returns the archived power of all the validators bonded
+// at the given height, ordered by operator address.
+func (k Keeper) GetValidatorPowersAt(ctx sdk.Context, height int64) (powers []types.ValidatorPower) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ArchivedValidatorKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.ValAddress(types.AddressFromArchivedValidatorKey(iterator.Key()))
+		if power := k.GetValidatorPowerAt(ctx, operator, height); power > 0 {
+			powers = append(powers, types.ValidatorPower{ValidatorAddress: operator.String(), Power: power})
+		}
+	}
+
+	return powers
+}
+
+// GetDelegationSharesAt returns the archived shares of a delegation at the
+// given height, which are zero if the delegation did not exist.
+func (k Keeper) GetDelegationSharesAt(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, height int64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	// the last change at or before the height
+	iterator := store.ReverseIterator(
+		types.GetDelegationArchivePrefix(delAddr, valAddr), types.GetDelegationArchiveKey(delAddr, valAddr, height+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(iterator.Value(), &dp)
+
+	return dp.Dec
+}
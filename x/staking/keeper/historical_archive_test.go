@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestHistoricalArchive(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	nextBlock := func() {
+		app.StakingKeeper.BlockValidatorUpdates(tstaking.Ctx)
+		tstaking.Ctx = tstaking.Ctx.WithBlockHeight(tstaking.Ctx.BlockHeight() + 1)
+		app.StakingKeeper.TrackHistoricalArchive(tstaking.Ctx)
+	}
+
+	// height 1: the archive is disabled by default
+	tstaking.Ctx = tstaking.Ctx.WithBlockHeight(1)
+	app.StakingKeeper.TrackHistoricalArchive(tstaking.Ctx)
+	_, found := app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+	require.False(t, found)
+
+	selfBond := tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	selfShares := selfBond.ToDec()
+
+	// height 2: the archive starts from the current validator powers and
+	// delegation shares
+	params := app.StakingKeeper.GetParams(tstaking.Ctx)
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	nextBlock()
+	startHeight, found := app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+	require.True(t, found)
+	require.Equal(t, int64(2), startHeight)
+
+	// height 3: delegation
+	nextBlock()
+	tstaking.DelegateWithPower(addrs[1], valAddrs[0], 5)
+	delShares := app.StakingKeeper.TokensFromConsensusPower(ctx, 5).ToDec()
+
+	// height 4: full undelegation
+	nextBlock()
+	tstaking.Undelegate(addrs[1], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 5), true)
+
+	// height 5: no change
+	nextBlock()
+
+	for height, exp := range map[int64]struct {
+		power  int64
+		shares sdk.Dec
+	}{
+		2: {10, sdk.ZeroDec()},
+		3: {15, delShares},
+		4: {10, sdk.ZeroDec()},
+		5: {10, sdk.ZeroDec()},
+	} {
+		require.Equal(t, exp.power, app.StakingKeeper.GetValidatorPowerAt(tstaking.Ctx, valAddrs[0], height), "height %d", height)
+		require.Equal(t, exp.shares, app.StakingKeeper.GetDelegationSharesAt(tstaking.Ctx, addrs[1], valAddrs[0], height), "height %d", height)
+		require.Equal(t, selfShares, app.StakingKeeper.GetDelegationSharesAt(tstaking.Ctx, addrs[0], valAddrs[0], height), "height %d", height)
+	}
+	require.Contains(t, app.StakingKeeper.GetValidatorPowersAt(tstaking.Ctx, 3),
+		types.ValidatorPower{ValidatorAddress: valAddrs[0].String(), Power: 15})
+
+	// height 6: the archive stops
+	params.HistoricalArchive = false
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	nextBlock()
+	_, found = app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+	require.False(t, found)
+
+	// height 7: the archive restarts without the entries of the previous one
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	nextBlock()
+	startHeight, found = app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+	require.True(t, found)
+	require.Equal(t, int64(7), startHeight)
+	require.Equal(t, int64(0), app.StakingKeeper.GetValidatorPowerAt(tstaking.Ctx, valAddrs[0], 3))
+	require.True(t, app.StakingKeeper.GetDelegationSharesAt(tstaking.Ctx, addrs[1], valAddrs[0], 3).IsZero())
+	require.Equal(t, int64(10), app.StakingKeeper.GetValidatorPowerAt(tstaking.Ctx, valAddrs[0], 7))
+}
+
+func TestHistoricalArchiveBatches(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Ctx = tstaking.Ctx.WithBlockHeight(1)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	tstaking.DelegateWithPower(addrs[1], valAddrs[0], 5)
+	app.StakingKeeper.BlockValidatorUpdates(tstaking.Ctx)
+
+	// nextBlock processes a single store entry of the archive start per block
+	// and returns true once the archive has started
+	nextBlock := func() bool {
+		tstaking.Ctx = tstaking.Ctx.WithBlockHeight(tstaking.Ctx.BlockHeight() + 1)
+		keeper.TrackHistoricalArchiveWithBatchSize(app.StakingKeeper, tstaking.Ctx, 1)
+		_, found := app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+		return found
+	}
+
+	params := app.StakingKeeper.GetParams(tstaking.Ctx)
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+
+	// the delegations are archived one per block, and the changes made
+	// meanwhile are recorded
+	require.False(t, nextBlock())
+	tstaking.DelegateWithPower(addrs[2], valAddrs[0], 3)
+	blocks := 1
+	for !nextBlock() {
+		blocks++
+		require.Less(t, blocks, 100)
+	}
+	require.Greater(t, blocks, 1)
+
+	startHeight, _ := app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+	require.Equal(t, tstaking.Ctx.BlockHeight(), startHeight)
+	delegations := app.StakingKeeper.GetAllDelegations(tstaking.Ctx)
+	for _, delegation := range delegations {
+		require.Equal(t, delegation.Shares, app.StakingKeeper.GetDelegationSharesAt(tstaking.Ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr(), startHeight))
+	}
+	require.Equal(t, app.StakingKeeper.GetLastValidatorPower(tstaking.Ctx, valAddrs[0]), app.StakingKeeper.GetValidatorPowerAt(tstaking.Ctx, valAddrs[0], startHeight))
+
+	// disabling the param aborts a restart, whose cleanup of the previous
+	// entries then starts over
+	params.HistoricalArchive = false
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	require.False(t, nextBlock())
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	require.False(t, nextBlock())
+	params.HistoricalArchive = false
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	require.False(t, nextBlock())
+
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	blocks = 0
+	for !nextBlock() {
+		blocks++
+		require.Less(t, blocks, 100)
+	}
+	require.Greater(t, blocks, len(delegations))
+	require.Equal(t, int64(0), app.StakingKeeper.GetValidatorPowerAt(tstaking.Ctx, valAddrs[0], startHeight))
+	require.True(t, app.StakingKeeper.GetDelegationSharesAt(tstaking.Ctx, addrs[1], valAddrs[0], startHeight).IsZero())
+}
+
+func TestHistoricalArchiveGas(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+
+	// setDelegationGas returns the gas consumed by a delegation update
+	setDelegationGas := func() sdk.Gas {
+		gasCtx := tstaking.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		app.StakingKeeper.SetDelegation(gasCtx, types.NewDelegation(addrs[1], valAddrs[0], sdk.OneDec()))
+		app.StakingKeeper.SetLastValidatorPower(gasCtx, valAddrs[0], 10)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	disabledGas := setDelegationGas()
+
+	params := app.StakingKeeper.GetParams(tstaking.Ctx)
+	params.HistoricalArchive = true
+	app.StakingKeeper.SetParams(tstaking.Ctx, params)
+	app.StakingKeeper.TrackHistoricalArchive(tstaking.Ctx)
+	_, found := app.StakingKeeper.GetHistoricalArchiveStartHeight(tstaking.Ctx)
+	require.True(t, found)
+
+	require.Equal(t, disabledGas, setDelegationGas())
+}
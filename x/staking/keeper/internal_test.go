@@ -0,0 +1,10 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// TrackHistoricalArchiveWithBatchSize runs TrackHistoricalArchive with the
+// given number of store entries processed per block.
+// WARNING: this function should only be used in tests.
+func TrackHistoricalArchiveWithBatchSize(k Keeper, ctx sdk.Context, batchSize int) {
+	k.trackHistoricalArchive(ctx, batchSize)
+}
@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+// created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+// created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord gets the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetTokenizeShareRecordKey(id))
+	if value == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(value, &record)
+	return record, true
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and indexes it by owner.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.GetTokenizeShareRecordByOwnerIndexKey(owner, record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record and its owner
+// index.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordByOwnerIndexKey(owner, record.Id))
+}
+
+// IterateTokenizeShareRecords iterates through all tokenize share records by
+// id. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an
+// owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordsByOwnerKey(owner))
+	defer iterator.Close()
+
+	prefixLen := len(types.GetTokenizeShareRecordsByOwnerKey(owner))
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()[prefixLen:]))
+		if !found {
+			panic("tokenize share record indexed by owner not found")
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of tokenized tokens.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of tokenized tokens.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the tokenized delegator shares of a
+// validator.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized delegator shares of a validator.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// TokenizeShares moves the delegation shares worth the given amount of tokens
+// to the module account of a new tokenize share record owned by the given
+// owner, and mints the delegator one share token of the record per share.
+// Only whole shares are tokenized.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, types.TokenizeShareRecord, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrNoValidatorFound
+	}
+
+	// The delegations of vesting accounts are tracked by the accounts
+	// themselves, so moving them to a record would unlock vesting coins.
+	if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTokenizeSharesVestingAccount
+	}
+
+	// Shares received through a redelegation remain subject to slashing for
+	// infractions of the source validator, so they cannot leave the delegator.
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	shares = shares.TruncateDec()
+	if !shares.IsPositive() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTinyTokenizeSharesAmount
+	}
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	validatorLiquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) &&
+		validatorLiquidShares.GT(validator.DelegatorShares.Mul(validatorCap)) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	totalLiquidStakedTokens := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) &&
+		totalLiquidStakedTokens.ToDec().GT(k.TotalBondedTokens(ctx).ToDec().Mul(globalCap)) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	record := types.NewTokenizeShareRecord(k.GetLastTokenizeShareRecordID(ctx)+1, owner, valAddr)
+
+	transferred, err := k.TransferDelegation(ctx, delAddr, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	if transferred.LT(shares) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, transferred.String())
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, record.Id)
+	k.SetValidatorLiquidShares(ctx, valAddr, validatorLiquidShares)
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStakedTokens)
+
+	return shareToken, record, nil
+}
+
+// RedeemTokensForShares burns share tokens of a tokenize share record and
+// moves the delegation shares they represent from the module account of the
+// record to the delegator. Once all the share tokens of a record are redeemed,
+// the record is deleted and the remaining balance of its module account, if
+// any, is sent to its owner. It returns the amount of tokens the redeemed
+// shares are worth.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	id, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrNoTokenizeShareRecord, err.Error())
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found || record.GetShareTokenDenom() != shareToken.Denom {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNoTokenizeShareRecord, "denom %s", shareToken.Denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shares := shareToken.Amount.ToDec()
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	transferred, err := k.TransferDelegation(ctx, record.GetModuleAddress(), delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if transferred.LT(shares) {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, transferred.String())
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(tokens), sdk.ZeroInt()))
+
+	if k.bankKeeper.GetSupply(ctx, shareToken.Denom).IsZero() {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if balance := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()); !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, balance); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), tokens), nil
+}
+
+// slashLiquidStakedTokens decreases the total amount of tokenized tokens by the
+// share of the tokens slashed from a validator held by tokenized delegations.
+func (k Keeper) slashLiquidStakedTokens(ctx sdk.Context, validator types.Validator, tokensToBurn sdk.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	if !liquidShares.IsPositive() || !validator.DelegatorShares.IsPositive() {
+		return
+	}
+
+	slashed := tokensToBurn.ToDec().Mul(liquidShares).Quo(validator.DelegatorShares).TruncateInt()
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(slashed), sdk.ZeroInt()))
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupTokenizeShares creates a bonded validator with a 100 tokens delegation
+// from delAddrs[1], whose tokens are held by the bonded pool.
+func setupTokenizeShares(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, []sdk.ValAddress) {
+	_, app, ctx := createTestInput(t)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(100))
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.NewInt(100)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.NotBondedPoolName, coins))
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddrs[1], valAddrs[0], issuedShares))
+
+	return app, ctx, delAddrs, valAddrs
+}
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := setupTokenizeShares(t)
+
+	shareToken, record, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(40), delAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.NewTokenizeShareRecord(1, delAddrs[0], valAddrs[0]), record)
+	require.Equal(t, sdk.NewCoin(record.GetShareTokenDenom(), sdk.NewInt(40)), shareToken)
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, delAddrs[1], shareToken.Denom))
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+
+	resRecord, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, record, resRecord)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddrs[0]))
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddrs[1]))
+
+	// the shares are moved to the module account of the record
+	require.Equal(t, sdk.NewInt(60), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+	require.Equal(t, sdk.NewInt(40), app.StakingKeeper.GetDelegatorBonded(ctx, record.GetModuleAddress()))
+	require.Equal(t, sdk.NewDec(40), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	require.Equal(t, sdk.NewInt(40), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// share tokens are transferable, and any holder can redeem them
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddrs[1], delAddrs[2], sdk.NewCoins(sdk.NewCoin(shareToken.Denom, sdk.NewInt(15)))))
+	amount, err := app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[2], sdk.NewCoin(shareToken.Denom, sdk.NewInt(15)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.NewInt(15)), amount)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[2]))
+	require.Equal(t, sdk.NewDec(25), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	require.Equal(t, sdk.NewInt(25), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, sdk.NewInt(25), app.BankKeeper.GetSupply(ctx, shareToken.Denom).Amount)
+
+	// redeeming more share tokens than held fails
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[2], sdk.NewCoin(shareToken.Denom, sdk.NewInt(1)))
+	require.Error(t, err)
+
+	// redeeming unknown denoms fails
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[1], sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecord)
+
+	// redeeming the last share tokens deletes the record
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[1], sdk.NewCoin(shareToken.Denom, sdk.NewInt(25)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(85), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddrs[0]))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTokenizeSharesErrors(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := setupTokenizeShares(t)
+
+	// no delegation
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[2], valAddrs[0], sdk.NewInt(10), delAddrs[2])
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	// no validator
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[1], sdk.NewInt(10), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+
+	// more than delegated
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(101), delAddrs[1])
+	require.Error(t, err)
+
+	// redelegation to the validator in progress
+	red := types.NewRedelegation(delAddrs[1], valAddrs[1], valAddrs[0], 0, time.Unix(0, 0).Add(time.Hour), sdk.NewInt(30), sdk.NewDec(30))
+	app.StakingKeeper.SetRedelegation(ctx, red)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(10), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrRedelegationInProgress)
+	app.StakingKeeper.RemoveRedelegation(ctx, red)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(10), delAddrs[1])
+	require.NoError(t, err)
+
+	// vesting accounts
+	vestingAddr := sdk.AccAddress("vesting_____________")
+	baseAcc := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	origCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.NewInt(100)))
+	app.AccountKeeper.SetAccount(ctx, vestingtypes.NewContinuousVestingAccount(baseAcc, origCoins, 0, 1000))
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, vestingAddr, valAddrs[0], sdk.NewInt(10), vestingAddr)
+	require.ErrorIs(t, err, types.ErrTokenizeSharesVestingAccount)
+}
+
+func TestTokenizeSharesCaps(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := setupTokenizeShares(t)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(51), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(30), delAddrs[1])
+	require.NoError(t, err)
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(21), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).ToDec().
+		Quo(app.StakingKeeper.TotalBondedTokens(ctx).ToDec())
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], sdk.NewInt(1), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+}
+
+func TestSlashLiquidStakedTokens(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(tokens)
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.NotBondedPoolName, coins))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddrs[1], valAddrs[0], issuedShares))
+
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], tokens.QuoRaw(4), delAddrs[1])
+	require.NoError(t, err)
+	require.Equal(t, tokens.QuoRaw(4), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(5, 1))
+
+	// half of the tokenized tokens were slashed, while the tokenized shares
+	// are unchanged
+	require.Equal(t, tokens.QuoRaw(8), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, issuedShares.QuoInt64(4), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+}
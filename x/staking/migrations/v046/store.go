@@ -0,0 +1,20 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the liquid staking caps to their default values.
+// - Disabling the historical archive.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramSpace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	paramSpace.Set(ctx, types.KeyHistoricalArchive, types.DefaultHistoricalArchive)
+
+	return nil
+}
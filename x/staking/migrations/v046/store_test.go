@@ -0,0 +1,39 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	transientKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, transientKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, transientKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// only the params which existed prior to v0.46 are set
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyUnbondingTime, params.UnbondingTime)
+	paramSpace.Set(ctx, types.KeyMaxValidators, params.MaxValidators)
+	paramSpace.Set(ctx, types.KeyMaxEntries, params.MaxEntries)
+	paramSpace.Set(ctx, types.KeyHistoricalEntries, params.HistoricalEntries)
+	paramSpace.Set(ctx, types.KeyBondDenom, params.BondDenom)
+	require.False(t, paramSpace.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.False(t, paramSpace.Has(ctx, types.KeyHistoricalArchive))
+
+	require.NoError(t, v046staking.MigrateParams(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Equal(t, params, migrated)
+}
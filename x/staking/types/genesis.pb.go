@@ -44,6 +44,12 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at
+	// genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record
+	// created.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +134,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +199,41 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x6d, 0x92, 0xa6, 0xe9, 0xa4, 0x20, 0x34, 0xa4, 0x95, 0x1b, 0x09, 0x27, 0x44, 0x15,
+	0x8a, 0x80, 0x3a, 0x6a, 0xd8, 0x21, 0x16, 0x10, 0x21, 0xaa, 0x22, 0x16, 0x91, 0x53, 0x10, 0x62,
+	0x63, 0x4d, 0x32, 0x83, 0x63, 0xc5, 0xf1, 0x58, 0x33, 0x93, 0x52, 0x38, 0x01, 0x4b, 0x8e, 0x50,
+	0x71, 0x06, 0x0e, 0xd1, 0x65, 0xc5, 0x0a, 0xb1, 0xa8, 0x50, 0xb2, 0xe1, 0x18, 0xc8, 0x33, 0x63,
+	0x13, 0xea, 0xba, 0xab, 0xe4, 0xe9, 0xfd, 0xff, 0xf7, 0xfe, 0x91, 0xde, 0x33, 0xd8, 0x1d, 0x53,
+	0x3e, 0xa3, 0xbc, 0xcb, 0x05, 0x9a, 0x06, 0x91, 0xdf, 0x3d, 0xde, 0x1f, 0x11, 0x81, 0xf6, 0xbb,
+	0x3e, 0x89, 0x08, 0x0f, 0xb8, 0x13, 0x33, 0x2a, 0x28, 0xdc, 0x56, 0x2a, 0x47, 0xab, 0x1c, 0xad,
+	0x6a, 0xd4, 0x7d, 0xea, 0x53, 0x29, 0xe9, 0x26, 0xff, 0x94, 0xba, 0x51, 0xc4, 0x4c, 0xdd, 0x4a,
+	0xb5, 0xa3, 0x54, 0x9e, 0xb2, 0xeb, 0x01, 0xb2, 0x68, 0x7f, 0xab, 0x80, 0xcd, 0x03, 0x15, 0x60,
+	0x28, 0x90, 0x20, 0xf0, 0x29, 0xa8, 0xc4, 0x88, 0xa1, 0x19, 0xb7, 0xcc, 0x96, 0xd9, 0xa9, 0xf5,
+	0x6c, 0xe7, 0xea, 0x40, 0xce, 0x40, 0xaa, 0xfa, 0xe5, 0xb3, 0x8b, 0xa6, 0xe1, 0x6a, 0x0f, 0x7c,
+	0x07, 0x6e, 0x87, 0x88, 0x0b, 0x4f, 0x50, 0x81, 0x42, 0x2f, 0xa6, 0x1f, 0x09, 0xb3, 0x6e, 0xb4,
+	0xcc, 0xce, 0x66, 0xdf, 0x49, 0x74, 0xbf, 0x2e, 0x9a, 0xf7, 0xfd, 0x40, 0x4c, 0xe6, 0x23, 0x67,
+	0x4c, 0x67, 0x3a, 0x89, 0xfe, 0xd9, 0xe3, 0x78, 0xda, 0x15, 0x9f, 0x62, 0xc2, 0x9d, 0xc3, 0x48,
+	0xb8, 0xb7, 0x12, 0xce, 0x51, 0x82, 0x19, 0x24, 0x14, 0x88, 0xc1, 0x96, 0x24, 0x1f, 0xa3, 0x30,
+	0xc0, 0x48, 0x50, 0xa6, 0xe8, 0xdc, 0x2a, 0xb5, 0x4a, 0x9d, 0x5a, 0xef, 0x41, 0x51, 0xcc, 0xd7,
+	0x88, 0x8b, 0xb7, 0xa9, 0x47, 0xa2, 0x74, 0xe4, 0x3b, 0x61, 0xae, 0xc3, 0xe1, 0x01, 0x00, 0xd9,
+	0x00, 0x6e, 0x95, 0x25, 0xfa, 0x5e, 0x11, 0x3a, 0x33, 0x6b, 0xe2, 0x8a, 0x15, 0xbe, 0x02, 0x35,
+	0x4c, 0x42, 0xe2, 0x23, 0x11, 0xd0, 0x88, 0x5b, 0x6b, 0x92, 0xd4, 0x2e, 0x22, 0xbd, 0xc8, 0xa4,
+	0x1a, 0xb5, 0x6a, 0x86, 0x1f, 0xc0, 0xd6, 0x3c, 0x1a, 0xd1, 0x08, 0x07, 0x91, 0xef, 0xad, 0x52,
+	0x2b, 0x92, 0xfa, 0xb0, 0x88, 0xfa, 0x26, 0x35, 0xe5, 0xf0, 0xf5, 0x79, 0xbe, 0xc5, 0xe1, 0x00,
+	0xdc, 0x64, 0x64, 0x95, 0xbf, 0x2e, 0xf9, 0xbb, 0x45, 0x7c, 0x97, 0xe0, 0xcb, 0xe0, 0xff, 0x01,
+	0xb0, 0x01, 0xaa, 0xe4, 0x24, 0xa6, 0x4c, 0x10, 0x6c, 0x55, 0x5b, 0x66, 0xa7, 0xea, 0x66, 0x35,
+	0xf4, 0xc1, 0xb6, 0xa0, 0x53, 0x12, 0x05, 0x9f, 0x89, 0xc7, 0x27, 0x88, 0x11, 0x8f, 0x91, 0x31,
+	0x65, 0x98, 0x5b, 0x1b, 0xd7, 0x3f, 0xeb, 0x48, 0xbb, 0x86, 0x89, 0xc9, 0x95, 0x9e, 0xf4, 0x59,
+	0x22, 0xdf, 0xe2, 0xf0, 0x19, 0xb8, 0xab, 0x77, 0xf2, 0x8a, 0x69, 0x5e, 0x80, 0x2d, 0xd0, 0x32,
+	0x3b, 0x65, 0x77, 0x47, 0x2d, 0x5c, 0x0e, 0x70, 0x88, 0xdb, 0x13, 0x00, 0xf3, 0x6b, 0x04, 0x7b,
+	0x60, 0x1d, 0x61, 0xcc, 0x08, 0x57, 0xa7, 0xb2, 0xd1, 0xb7, 0x7e, 0x7c, 0xdf, 0xab, 0xeb, 0xd0,
+	0xcf, 0x55, 0x67, 0x28, 0x58, 0x10, 0xf9, 0x6e, 0x2a, 0x84, 0x75, 0xb0, 0xf6, 0xef, 0x28, 0x4a,
+	0xae, 0x2a, 0x9e, 0x54, 0xbf, 0x9c, 0x36, 0x8d, 0x3f, 0xa7, 0x4d, 0xa3, 0xff, 0xf2, 0x6c, 0x61,
+	0x9b, 0xe7, 0x0b, 0xdb, 0xfc, 0xbd, 0xb0, 0xcd, 0xaf, 0x4b, 0xdb, 0x38, 0x5f, 0xda, 0xc6, 0xcf,
+	0xa5, 0x6d, 0xbc, 0x7f, 0x74, 0xed, 0xdd, 0x9c, 0x64, 0x5f, 0x00, 0x79, 0x41, 0xa3, 0x8a, 0xbc,
+	0xee, 0xc7, 0x7f, 0x07, 0x00, 0xa5, 0x49, 0x04, 0x76, 0x74, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +256,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +467,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -735,6 +786,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -48,6 +48,18 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordKey             = []byte{0x61} // prefix for each key to a tokenize share record
+	TokenizeShareRecordByOwnerIndexKey = []byte{0x62} // prefix for each key to a tokenize share record, by owner
+	LastTokenizeShareRecordIDKey       = []byte{0x63} // key for the id of the last tokenize share record
+	TotalLiquidStakedTokensKey         = []byte{0x64} // key for the total amount of tokenized tokens
+	ValidatorLiquidSharesKey           = []byte{0x65} // prefix for the tokenized delegator shares of each validator
+
+	HistoricalArchiveStartHeightKey = []byte{0x71} // key for the height from which the historical archive is complete
+	ArchivedValidatorKey            = []byte{0x72} // prefix for each key to a validator with archived power
+	ValidatorPowerArchiveKey        = []byte{0x73} // prefix for the archived power changes of each validator
+	DelegationArchiveKey            = []byte{0x74} // prefix for the archived share changes of each delegation
+	HistoricalArchiveCursorKey      = []byte{0x75} // key for the next key to process while the historical archive starts
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -347,3 +359,70 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey creates the key for the tokenize share record with
+// the given id.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordsByOwnerKey returns a key prefix for indexing the
+// tokenize share records of an owner.
+func GetTokenizeShareRecordsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerIndexKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordByOwnerIndexKey creates the index key for the tokenize
+// share record with the given id and owner.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordByOwnerIndexKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorLiquidSharesKey creates the key for the tokenized delegator
+// shares of a validator.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetArchivedValidatorKey creates the index key for a validator with archived
+// power.
+// VALUE: none (key rearrangement used)
+func GetArchivedValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(ArchivedValidatorKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// AddressFromArchivedValidatorKey creates the validator operator address from
+// ArchivedValidatorKey
+func AddressFromArchivedValidatorKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetValidatorPowerArchivePrefix returns a key prefix for the archived power
+// changes of a validator.
+func GetValidatorPowerArchivePrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorPowerArchiveKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorPowerArchiveKey creates the key for the power of a validator
+// archived at a height.
+// VALUE: gogotypes.Int64Value
+func GetValidatorPowerArchiveKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorPowerArchivePrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetDelegationArchivePrefix returns a key prefix for the archived share
+// changes of a delegation.
+func GetDelegationArchivePrefix(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(DelegationArchiveKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationArchiveKey creates the key for the shares of a delegation
+// archived at a height.
+// VALUE: sdk.Dec
+func GetDelegationArchiveKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetDelegationArchivePrefix(delAddr, valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
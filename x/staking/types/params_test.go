@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -21,3 +22,19 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateLiquidStakingCaps(t *testing.T) {
+	p := types.DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	p.ValidatorLiquidStakingCap = sdk.ZeroDec()
+	require.NoError(t, p.Validate())
+
+	p.GlobalLiquidStakingCap = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+
+	p.GlobalLiquidStakingCap = sdk.OneDec()
+	p.ValidatorLiquidStakingCap = sdk.NewDec(-1)
+	require.Error(t, p.Validate())
+}
@@ -844,6 +844,17 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens which may be tokenized. A cap of 1 disables the check.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator which may be tokenized. A cap of 1 disables the
+	// check.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// historical_archive enables the archive of the validator power and
+	// delegation share changes of every height, which serves the historical
+	// queries.
+	HistoricalArchive bool `protobuf:"varint,8,opt,name=historical_archive,json=historicalArchive,proto3" json:"historical_archive,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -913,6 +924,13 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetHistoricalArchive() bool {
+	if m != nil {
+		return m.HistoricalArchive
+	}
+	return false
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1109,6 +1127,139 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation which was tokenized into a bank
+// denom. The delegation is held by the module account of the record, and the
+// owner of the record may withdraw its rewards.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account allowed to withdraw the rewards of the
+	// tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized
+	// delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized
+	// delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// ValidatorPower defines the consensus power of a validator.
+type ValidatorPower struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
+}
+func (m *ValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+func (m *ValidatorPower) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1131,6 +1282,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ValidatorPower)(nil), "cosmos.staking.v1beta1.ValidatorPower")
 }
 
 func init() {
@@ -1138,110 +1291,120 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xf7, 0x73, 0x5c, 0xc7, 0xfe, 0x9c, 0xc4, 0xc9, 0x6c, 0x5a, 0xbc, 0x16, 0xd8, 0xc6, 0x94,
-	0x76, 0x8b, 0xba, 0x0e, 0x1b, 0xa4, 0x4a, 0x44, 0x48, 0x68, 0x1d, 0xbb, 0x6c, 0x58, 0xba, 0xb8,
-	0x76, 0x36, 0x88, 0x3f, 0xe2, 0x69, 0xfc, 0xde, 0xc4, 0x19, 0xe2, 0x37, 0xcf, 0x7a, 0x33, 0x5e,
-	0xe2, 0x03, 0x12, 0x12, 0x97, 0x92, 0x53, 0x8f, 0xbd, 0x44, 0x8a, 0x54, 0x8e, 0x3d, 0x56, 0x5c,
-	0x38, 0x70, 0x2d, 0x3d, 0xad, 0x7a, 0xa2, 0x80, 0x02, 0xda, 0xbd, 0x20, 0x4e, 0x1c, 0xb8, 0x82,
-	0xd0, 0xfc, 0x79, 0x7f, 0xd6, 0x8e, 0xd3, 0xa4, 0x4a, 0xa5, 0x4a, 0xbd, 0xec, 0xbe, 0x99, 0xf9,
-	0xbe, 0xdf, 0x7c, 0xdf, 0xef, 0xfb, 0x93, 0x6f, 0x0c, 0x2f, 0x3a, 0x3e, 0xf7, 0x7c, 0xbe, 0xc1,
-	0x05, 0x3e, 0xa4, 0x6c, 0xb0, 0xf1, 0xe8, 0x4e, 0x9f, 0x08, 0x7c, 0x27, 0x5c, 0x37, 0x46, 0x81,
-	0x2f, 0x7c, 0xf4, 0x82, 0x96, 0x6a, 0x84, 0xbb, 0x46, 0xaa, 0xbc, 0x3e, 0xf0, 0x07, 0xbe, 0x12,
-	0xd9, 0x90, 0x5f, 0x5a, 0xba, 0x7c, 0x73, 0xe0, 0xfb, 0x83, 0x21, 0xd9, 0x50, 0xab, 0xfe, 0x78,
-	0x7f, 0x03, 0xb3, 0x89, 0x39, 0xaa, 0x4c, 0x1f, 0xb9, 0xe3, 0x00, 0x0b, 0xea, 0x33, 0x73, 0x5e,
-	0x9d, 0x3e, 0x17, 0xd4, 0x23, 0x5c, 0x60, 0x6f, 0x14, 0x62, 0x6b, 0x4b, 0x6c, 0x7d, 0xa9, 0x31,
-	0xcb, 0x60, 0x1b, 0x57, 0xfa, 0x98, 0x93, 0xc8, 0x0f, 0xc7, 0xa7, 0x21, 0xf6, 0x97, 0x05, 0x61,
-	0x2e, 0x09, 0x3c, 0xca, 0xc4, 0x86, 0x98, 0x8c, 0x08, 0xd7, 0xff, 0xea, 0xd3, 0xfa, 0x6f, 0x2d,
-	0x58, 0xb9, 0x47, 0xb9, 0xf0, 0x03, 0xea, 0xe0, 0xe1, 0x0e, 0xdb, 0xf7, 0xd1, 0x6b, 0x90, 0x3d,
-	0x20, 0xd8, 0x25, 0x41, 0xc9, 0xaa, 0x59, 0xb7, 0x0a, 0x9b, 0xa5, 0x46, 0x8c, 0xd0, 0xd0, 0xba,
-	0xf7, 0xd4, 0x79, 0x33, 0xf3, 0xc1, 0x59, 0x35, 0xd5, 0x35, 0xd2, 0xe8, 0xbb, 0x90, 0x7d, 0x84,
-	0x87, 0x9c, 0x88, 0x52, 0xba, 0xb6, 0x70, 0xab, 0xb0, 0xf9, 0xd5, 0xc6, 0xf9, 0xf4, 0x35, 0xf6,
-	0xf0, 0x90, 0xba, 0x58, 0xf8, 0x11, 0x80, 0x56, 0xab, 0xbf, 0x97, 0x86, 0xe2, 0xb6, 0xef, 0x79,
-	0x94, 0x73, 0xea, 0xb3, 0x2e, 0x16, 0x84, 0xa3, 0x0e, 0x64, 0x02, 0x2c, 0x88, 0x32, 0x25, 0xdf,
-	0xfc, 0x8e, 0x94, 0xff, 0xcb, 0x59, 0xf5, 0xa5, 0x01, 0x15, 0x07, 0xe3, 0x7e, 0xc3, 0xf1, 0x3d,
-	0x43, 0x86, 0xf9, 0xef, 0x36, 0x77, 0x0f, 0x8d, 0x7f, 0x2d, 0xe2, 0x7c, 0xf4, 0xfe, 0x6d, 0x30,
-	0x36, 0xb4, 0x88, 0xd3, 0x55, 0x48, 0xe8, 0x47, 0x90, 0xf3, 0xf0, 0x91, 0xad, 0x50, 0xd3, 0xd7,
-	0x80, 0xba, 0xe8, 0xe1, 0x23, 0x69, 0x2b, 0x72, 0xa1, 0x28, 0x81, 0x9d, 0x03, 0xcc, 0x06, 0x44,
-	0xe3, 0x2f, 0x5c, 0x03, 0xfe, 0xb2, 0x87, 0x8f, 0xb6, 0x15, 0xa6, 0xbc, 0x65, 0x2b, 0xf7, 0xce,
-	0x69, 0x35, 0xf5, 0xcf, 0xd3, 0xaa, 0x55, 0xff, 0x83, 0x05, 0x10, 0xd3, 0x85, 0x7e, 0x06, 0xab,
-	0x4e, 0xb4, 0x52, 0xd7, 0x73, 0x13, 0xc0, 0x97, 0xe7, 0x05, 0x62, 0x8a, 0xec, 0x66, 0x4e, 0x1a,
-	0xfa, 0xf8, 0xac, 0x6a, 0x75, 0x8b, 0xce, 0x54, 0x1c, 0xda, 0x50, 0x18, 0x8f, 0x5c, 0x2c, 0x88,
-	0x2d, 0x53, 0x53, 0x11, 0x57, 0xd8, 0x2c, 0x37, 0x74, 0xde, 0x36, 0xc2, 0xbc, 0x6d, 0xec, 0x86,
-	0x79, 0xab, 0xb1, 0xde, 0xfe, 0x7b, 0xd5, 0xea, 0x82, 0x56, 0x94, 0x47, 0x09, 0xeb, 0xdf, 0xb3,
-	0xa0, 0xd0, 0x22, 0xdc, 0x09, 0xe8, 0x48, 0x16, 0x02, 0x2a, 0xc1, 0xa2, 0xe7, 0x33, 0x7a, 0x68,
-	0xd2, 0x2e, 0xdf, 0x0d, 0x97, 0xa8, 0x0c, 0x39, 0xea, 0x12, 0x26, 0xa8, 0x98, 0xe8, 0x80, 0x75,
-	0xa3, 0xb5, 0xd4, 0xfa, 0x25, 0xe9, 0x73, 0x1a, 0x72, 0xdd, 0x0d, 0x97, 0xe8, 0x15, 0x58, 0xe5,
-	0xc4, 0x19, 0x07, 0x54, 0x4c, 0x6c, 0xc7, 0x67, 0x02, 0x3b, 0xa2, 0x94, 0x51, 0x22, 0xc5, 0x70,
-	0x7f, 0x5b, 0x6f, 0x4b, 0x10, 0x97, 0x08, 0x4c, 0x87, 0xbc, 0xf4, 0x9c, 0x06, 0x31, 0xcb, 0x84,
-	0xb9, 0x7f, 0xca, 0x42, 0x3e, 0xca, 0x5b, 0xb4, 0x0d, 0xab, 0xfe, 0x88, 0x04, 0xf2, 0xdb, 0xc6,
-	0xae, 0x1b, 0x10, 0xce, 0x4d, 0x86, 0x96, 0x3e, 0x7a, 0xff, 0xf6, 0xba, 0xa1, 0xfb, 0xae, 0x3e,
-	0xe9, 0x89, 0x80, 0xb2, 0x41, 0xb7, 0x18, 0x6a, 0x98, 0x6d, 0xf4, 0x63, 0x19, 0x30, 0xc6, 0x09,
-	0xe3, 0x63, 0x6e, 0x8f, 0xc6, 0xfd, 0x43, 0x32, 0x31, 0xbc, 0xae, 0xcf, 0xf0, 0x7a, 0x97, 0x4d,
-	0x9a, 0xa5, 0x0f, 0x63, 0x68, 0x27, 0x98, 0x8c, 0x84, 0xdf, 0xe8, 0x8c, 0xfb, 0xf7, 0xc9, 0x44,
-	0x46, 0xcb, 0xe0, 0x74, 0x14, 0x0c, 0x7a, 0x01, 0xb2, 0xbf, 0xc0, 0x74, 0x48, 0x5c, 0xc5, 0x4a,
-	0xae, 0x6b, 0x56, 0x68, 0x0b, 0xb2, 0x5c, 0x60, 0x31, 0xe6, 0x8a, 0x8a, 0x95, 0xcd, 0xfa, 0xbc,
-	0xcc, 0x68, 0xfa, 0xcc, 0xed, 0x29, 0xc9, 0xae, 0xd1, 0x40, 0xbb, 0x90, 0x15, 0xfe, 0x21, 0x61,
-	0x86, 0xa4, 0x2b, 0x65, 0xf5, 0x0e, 0x13, 0x89, 0xac, 0xde, 0x61, 0xa2, 0x6b, 0xb0, 0xd0, 0x00,
-	0x56, 0x5d, 0x32, 0x24, 0x03, 0x45, 0x25, 0x3f, 0xc0, 0x01, 0xe1, 0xa5, 0xec, 0x35, 0x54, 0x4d,
-	0x31, 0x42, 0xed, 0x29, 0x50, 0x74, 0x1f, 0x0a, 0x6e, 0x9c, 0x6e, 0xa5, 0x45, 0x45, 0xf4, 0xd7,
-	0xe6, 0xf9, 0x9f, 0xc8, 0x4c, 0xd3, 0xa4, 0x92, 0xda, 0x32, 0xb9, 0xc6, 0xac, 0xef, 0x33, 0x97,
-	0xb2, 0x81, 0x7d, 0x40, 0xe8, 0xe0, 0x40, 0x94, 0x72, 0x35, 0xeb, 0xd6, 0x42, 0xb7, 0x18, 0xed,
-	0xdf, 0x53, 0xdb, 0xe8, 0x3e, 0xac, 0xc4, 0xa2, 0xaa, 0x76, 0xf2, 0x57, 0xa8, 0x9d, 0xe5, 0x48,
-	0x57, 0x9e, 0xa2, 0x7b, 0x00, 0x71, 0x61, 0x96, 0x40, 0x01, 0xd5, 0x3f, 0xb9, 0xba, 0x8d, 0x0b,
-	0x09, 0x5d, 0x34, 0x84, 0x1b, 0x1e, 0x65, 0x36, 0x27, 0xc3, 0x7d, 0xdb, 0x50, 0x25, 0x21, 0x0b,
-	0xd7, 0x10, 0xda, 0x35, 0x8f, 0xb2, 0x1e, 0x19, 0xee, 0xb7, 0x22, 0xd8, 0xad, 0xa5, 0xb7, 0x4e,
-	0xab, 0x29, 0x53, 0x4b, 0xa9, 0x7a, 0x07, 0x96, 0xf6, 0xf0, 0xd0, 0x94, 0x01, 0xe1, 0xe8, 0x35,
-	0xc8, 0xe3, 0x70, 0x51, 0xb2, 0x6a, 0x0b, 0x17, 0x96, 0x51, 0x2c, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x59, 0xf5, 0xdf, 0x59, 0x90, 0x6d, 0xed, 0x75, 0x30, 0x0d, 0x50, 0x1b, 0xd6, 0xe2, 0x84,
-	0xba, 0x6c, 0x6d, 0xc6, 0x39, 0x18, 0x16, 0x67, 0x1b, 0xd6, 0x1e, 0x85, 0xe5, 0x1e, 0xc1, 0xa4,
-	0x3f, 0x09, 0x26, 0x52, 0x31, 0xfb, 0x53, 0x8e, 0xb7, 0x61, 0x51, 0x5b, 0xc9, 0xd1, 0x16, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xc2, 0x66, 0x65, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0x52,
-	0xff, 0xaf, 0x05, 0xd0, 0xda, 0xdb, 0xdb, 0x0d, 0xe8, 0x68, 0x48, 0xc4, 0x75, 0x79, 0xfc, 0x03,
-	0x78, 0x3e, 0xf6, 0x98, 0x07, 0xce, 0xa5, 0xbd, 0xbe, 0x11, 0xa9, 0xf5, 0x02, 0xe7, 0x5c, 0x34,
-	0x97, 0x8b, 0x08, 0x6d, 0xe1, 0xd2, 0x68, 0x2d, 0x2e, 0xce, 0xa7, 0xb1, 0x07, 0x85, 0xd8, 0x7d,
-	0x8e, 0x5a, 0x90, 0x13, 0xe6, 0xdb, 0xb0, 0x59, 0x9f, 0xcf, 0x66, 0xa8, 0x66, 0x18, 0x8d, 0x34,
-	0xeb, 0xff, 0x93, 0xa4, 0x46, 0x19, 0xfb, 0xf9, 0x4a, 0x23, 0xd9, 0x7b, 0x4d, 0x6f, 0xbc, 0x8e,
-	0x89, 0xc2, 0x60, 0x4d, 0xb1, 0xfa, 0x9b, 0x34, 0xdc, 0x78, 0x18, 0x76, 0x9b, 0xcf, 0x2d, 0x13,
-	0x1d, 0x58, 0x24, 0x4c, 0x04, 0x54, 0x51, 0x21, 0x63, 0xfd, 0xcd, 0x79, 0xb1, 0x3e, 0xc7, 0x97,
-	0x36, 0x13, 0xc1, 0xc4, 0x44, 0x3e, 0x84, 0x99, 0x62, 0xe1, 0xaf, 0x69, 0x28, 0xcd, 0xd3, 0x44,
-	0x2f, 0x43, 0xd1, 0x09, 0x88, 0xda, 0x08, 0xbb, 0xbe, 0xa5, 0xba, 0xfe, 0x4a, 0xb8, 0x6d, 0x9a,
-	0xfe, 0x1b, 0x20, 0x07, 0x28, 0x99, 0x58, 0x52, 0xf4, 0xca, 0x13, 0xd3, 0x4a, 0xac, 0xac, 0xda,
-	0x3e, 0x81, 0x22, 0x65, 0x54, 0x50, 0x3c, 0xb4, 0xfb, 0x78, 0x88, 0x99, 0xf3, 0x69, 0x26, 0xcb,
-	0xd9, 0x46, 0xbd, 0x62, 0x40, 0x9b, 0x1a, 0x13, 0xed, 0xc1, 0x62, 0x08, 0x9f, 0xb9, 0x06, 0xf8,
-	0x10, 0x2c, 0x31, 0x45, 0x7d, 0x9c, 0x86, 0xb5, 0x2e, 0x71, 0xbf, 0x58, 0xb4, 0xfe, 0x14, 0x40,
-	0x17, 0x9c, 0xec, 0x83, 0x9f, 0x82, 0xd9, 0xd9, 0x02, 0xce, 0x6b, 0xbc, 0x16, 0x17, 0x09, 0x6e,
-	0x3f, 0x4c, 0xc3, 0x52, 0x92, 0xdb, 0x2f, 0xc0, 0xdf, 0x05, 0xb4, 0x13, 0x77, 0x83, 0x8c, 0xea,
-	0x06, 0xaf, 0xcc, 0xeb, 0x06, 0x33, 0x59, 0x77, 0x71, 0x1b, 0xf8, 0x8f, 0x05, 0xd9, 0x0e, 0x0e,
-	0xb0, 0xc7, 0xd1, 0xf7, 0x67, 0x06, 0x38, 0xfd, 0xaa, 0xba, 0x39, 0x93, 0x73, 0x2d, 0xf3, 0xa8,
-	0xd7, 0x29, 0xf7, 0xce, 0x39, 0xf3, 0xdb, 0xd7, 0x61, 0x45, 0x3e, 0x11, 0x23, 0x57, 0x34, 0x89,
-	0xcb, 0xea, 0x8d, 0x17, 0xbd, 0x2e, 0x38, 0xaa, 0x42, 0x41, 0x8a, 0xc5, 0x8d, 0x4e, 0xca, 0x80,
-	0x87, 0x8f, 0xda, 0x7a, 0x07, 0xdd, 0x06, 0x74, 0x10, 0x3d, 0xda, 0xed, 0x98, 0x02, 0x29, 0xb7,
-	0x16, 0x9f, 0x84, 0xe2, 0x5f, 0x01, 0x90, 0x56, 0xd8, 0x2e, 0x61, 0xbe, 0x67, 0xde, 0x38, 0x79,
-	0xb9, 0xd3, 0x92, 0x1b, 0x89, 0x1c, 0x7a, 0xd7, 0x02, 0x14, 0x37, 0xbd, 0x2e, 0xe1, 0x23, 0xf9,
-	0xb0, 0x90, 0x63, 0x67, 0x62, 0x46, 0xb4, 0x2e, 0x1e, 0x3b, 0x63, 0xfd, 0x70, 0xec, 0x4c, 0xe4,
-	0xe4, 0xb7, 0xe3, 0x16, 0x93, 0x36, 0x2c, 0x1a, 0x98, 0x3e, 0xe6, 0x24, 0x31, 0xba, 0xd2, 0x50,
-	0x7b, 0xa6, 0x8b, 0xa4, 0xea, 0x1f, 0x5b, 0x70, 0x73, 0x26, 0x9e, 0x91, 0xb1, 0x3f, 0x07, 0x14,
-	0x24, 0x0e, 0x15, 0x3b, 0x13, 0x63, 0xf4, 0x95, 0xd3, 0x63, 0x2d, 0x98, 0xe9, 0x56, 0x9f, 0x55,
-	0x97, 0xcc, 0xa8, 0x08, 0xfc, 0xd1, 0x82, 0xf5, 0xa4, 0x31, 0x91, 0x5b, 0x0f, 0x60, 0x29, 0x69,
-	0x8b, 0x71, 0xe8, 0xc5, 0xcb, 0x38, 0x64, 0x7c, 0x79, 0x46, 0x1f, 0xbd, 0x19, 0x97, 0x8e, 0xfe,
-	0xb9, 0xe6, 0xce, 0xa5, 0xb9, 0x09, 0x6d, 0x9a, 0x2e, 0xa1, 0x4c, 0x38, 0x47, 0x64, 0x3a, 0xbe,
-	0x3f, 0x44, 0xbf, 0x82, 0x35, 0xe6, 0x0b, 0x5b, 0xe6, 0x19, 0x71, 0x6d, 0xf3, 0x76, 0xd4, 0xfd,
-	0xe7, 0xcd, 0xab, 0x51, 0xf6, 0xaf, 0xb3, 0xea, 0x2c, 0xd4, 0x14, 0x8f, 0x45, 0xe6, 0x8b, 0xa6,
-	0x3a, 0xdf, 0xd5, 0x2f, 0xcb, 0x00, 0x96, 0x9f, 0xbd, 0x5a, 0xf7, 0xab, 0x37, 0xae, 0x7c, 0xf5,
-	0xf2, 0x45, 0xd7, 0x2e, 0xf5, 0x13, 0x77, 0x6e, 0xe5, 0x64, 0x0c, 0xff, 0x7d, 0x5a, 0xb5, 0xbe,
-	0xf1, 0x7b, 0x0b, 0x20, 0x7e, 0x44, 0xa3, 0x57, 0xe1, 0x4b, 0xcd, 0x1f, 0x3e, 0x68, 0xd9, 0xbd,
-	0xdd, 0xbb, 0xbb, 0x0f, 0x7b, 0xf6, 0xc3, 0x07, 0xbd, 0x4e, 0x7b, 0x7b, 0xe7, 0xf5, 0x9d, 0x76,
-	0x6b, 0x35, 0x55, 0x2e, 0x1e, 0x9f, 0xd4, 0x0a, 0x0f, 0x19, 0x1f, 0x11, 0x87, 0xee, 0x53, 0xe2,
-	0xa2, 0x97, 0x60, 0xfd, 0x59, 0x69, 0xb9, 0x6a, 0xb7, 0x56, 0xad, 0xf2, 0xd2, 0xf1, 0x49, 0x2d,
-	0xa7, 0xe7, 0x13, 0xe2, 0xa2, 0x5b, 0xf0, 0xfc, 0xac, 0xdc, 0xce, 0x83, 0xef, 0xad, 0xa6, 0xcb,
-	0xcb, 0xc7, 0x27, 0xb5, 0x7c, 0x34, 0xc8, 0xa0, 0x3a, 0xa0, 0xa4, 0xa4, 0xc1, 0x5b, 0x28, 0xc3,
-	0xf1, 0x49, 0x2d, 0xab, 0x69, 0x2b, 0x67, 0xde, 0x7a, 0xb7, 0x92, 0x6a, 0xbe, 0xfe, 0xc1, 0x93,
-	0x8a, 0xf5, 0xf8, 0x49, 0xc5, 0xfa, 0xc7, 0x93, 0x8a, 0xf5, 0xf6, 0xd3, 0x4a, 0xea, 0xf1, 0xd3,
-	0x4a, 0xea, 0xcf, 0x4f, 0x2b, 0xa9, 0x9f, 0xbc, 0x7a, 0x21, 0x63, 0x47, 0xd1, 0x6f, 0xa9, 0x8a,
-	0xbb, 0x7e, 0x56, 0xb5, 0xc5, 0x6f, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xff, 0x65, 0x73,
-	0x6a, 0x15, 0x00, 0x00,
+	// 1802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x52, 0x34, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0xfc, 0xa5, 0x84, 0x7c, 0x45, 0x96,
+	0x4d, 0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x88, 0x22, 0x53, 0xab, 0x4e,
+	0x5c, 0x66, 0x29, 0xab, 0xe8, 0x0f, 0x74, 0x31, 0xdc, 0x1d, 0x51, 0x53, 0x2d, 0x77, 0xd8, 0x9d,
+	0xa1, 0x25, 0x16, 0x08, 0x50, 0xa0, 0x97, 0xd4, 0xa7, 0x1c, 0x03, 0x14, 0x06, 0x0c, 0xa4, 0xc7,
+	0x1c, 0x83, 0x1e, 0xda, 0x43, 0xaf, 0x69, 0x4e, 0x46, 0x4e, 0x4d, 0x5b, 0xb8, 0x85, 0x7d, 0x29,
+	0x7a, 0xea, 0x3f, 0xd0, 0xa2, 0x98, 0x1f, 0xfb, 0xc3, 0xa4, 0x24, 0x4b, 0x05, 0x0b, 0x04, 0xc8,
+	0x85, 0xdc, 0x99, 0xf7, 0xde, 0x67, 0xe6, 0x7d, 0xe6, 0xbd, 0xb7, 0x6f, 0x16, 0x5e, 0x72, 0x19,
+	0xef, 0x33, 0xbe, 0xc1, 0x05, 0x3e, 0xa2, 0x41, 0x6f, 0xe3, 0xde, 0xcd, 0x2e, 0x11, 0xf8, 0x66,
+	0x34, 0xae, 0x0f, 0x42, 0x26, 0x18, 0xba, 0xa6, 0xb5, 0xea, 0xd1, 0xac, 0xd1, 0x5a, 0x5b, 0xe9,
+	0xb1, 0x1e, 0x53, 0x2a, 0x1b, 0xf2, 0x49, 0x6b, 0xaf, 0xad, 0xf6, 0x18, 0xeb, 0xf9, 0x64, 0x43,
+	0x8d, 0xba, 0xc3, 0x83, 0x0d, 0x1c, 0x8c, 0x8c, 0x68, 0x7d, 0x5c, 0xe4, 0x0d, 0x43, 0x2c, 0x28,
+	0x0b, 0x8c, 0xbc, 0x32, 0x2e, 0x17, 0xb4, 0x4f, 0xb8, 0xc0, 0xfd, 0x41, 0x84, 0xad, 0x77, 0xe2,
+	0xe8, 0x45, 0xcd, 0xb6, 0x0c, 0xb6, 0x71, 0xa5, 0x8b, 0x39, 0x89, 0xfd, 0x70, 0x19, 0x8d, 0xb0,
+	0x5f, 0x14, 0x24, 0xf0, 0x48, 0xd8, 0xa7, 0x81, 0xd8, 0x10, 0xa3, 0x01, 0xe1, 0xfa, 0x57, 0x4b,
+	0x6b, 0xbf, 0xb4, 0x60, 0xf1, 0x16, 0xe5, 0x82, 0x85, 0xd4, 0xc5, 0xfe, 0x6e, 0x70, 0xc0, 0xd0,
+	0xeb, 0x90, 0x3f, 0x24, 0xd8, 0x23, 0x61, 0xd9, 0xaa, 0x5a, 0xd7, 0x8b, 0x9b, 0xe5, 0x7a, 0x82,
+	0x50, 0xd7, 0xb6, 0xb7, 0x94, 0xbc, 0x91, 0xfb, 0xf8, 0x71, 0x25, 0x63, 0x1b, 0x6d, 0xf4, 0x2d,
+	0xc8, 0xdf, 0xc3, 0x3e, 0x27, 0xa2, 0x9c, 0xad, 0xce, 0x5c, 0x2f, 0x6e, 0x7e, 0xa9, 0x7e, 0x3a,
+	0x7d, 0xf5, 0x7d, 0xec, 0x53, 0x0f, 0x0b, 0x16, 0x03, 0x68, 0xb3, 0xda, 0x87, 0x59, 0x28, 0xed,
+	0xb0, 0x7e, 0x9f, 0x72, 0x4e, 0x59, 0x60, 0x63, 0x41, 0x38, 0x6a, 0x43, 0x2e, 0xc4, 0x82, 0xa8,
+	0xad, 0xcc, 0x35, 0xbe, 0x29, 0xf5, 0xff, 0xf4, 0xb8, 0xf2, 0x72, 0x8f, 0x8a, 0xc3, 0x61, 0xb7,
+	0xee, 0xb2, 0xbe, 0x21, 0xc3, 0xfc, 0xdd, 0xe0, 0xde, 0x91, 0xf1, 0xaf, 0x49, 0xdc, 0x4f, 0x3f,
+	0xba, 0x01, 0x66, 0x0f, 0x4d, 0xe2, 0xda, 0x0a, 0x09, 0x7d, 0x0f, 0x0a, 0x7d, 0x7c, 0xe2, 0x28,
+	0xd4, 0xec, 0x14, 0x50, 0x67, 0xfb, 0xf8, 0x44, 0xee, 0x15, 0x79, 0x50, 0x92, 0xc0, 0xee, 0x21,
+	0x0e, 0x7a, 0x44, 0xe3, 0xcf, 0x4c, 0x01, 0x7f, 0xa1, 0x8f, 0x4f, 0x76, 0x14, 0xa6, 0x5c, 0x65,
+	0xab, 0xf0, 0xfe, 0xc3, 0x4a, 0xe6, 0xef, 0x0f, 0x2b, 0x56, 0xed, 0x77, 0x16, 0x40, 0x42, 0x17,
+	0xfa, 0x11, 0x2c, 0xb9, 0xf1, 0x48, 0x2d, 0xcf, 0xcd, 0x01, 0xbe, 0x72, 0xd6, 0x41, 0x8c, 0x91,
+	0xdd, 0x28, 0xc8, 0x8d, 0x3e, 0x7a, 0x5c, 0xb1, 0xec, 0x92, 0x3b, 0x76, 0x0e, 0x2d, 0x28, 0x0e,
+	0x07, 0x1e, 0x16, 0xc4, 0x91, 0xa1, 0xa9, 0x88, 0x2b, 0x6e, 0xae, 0xd5, 0x75, 0xdc, 0xd6, 0xa3,
+	0xb8, 0xad, 0xef, 0x45, 0x71, 0xab, 0xb1, 0xde, 0xfb, 0x6b, 0xc5, 0xb2, 0x41, 0x1b, 0x4a, 0x51,
+	0x6a, 0xf7, 0x1f, 0x5a, 0x50, 0x6c, 0x12, 0xee, 0x86, 0x74, 0x20, 0x13, 0x01, 0x95, 0x61, 0xb6,
+	0xcf, 0x02, 0x7a, 0x64, 0xc2, 0x6e, 0xce, 0x8e, 0x86, 0x68, 0x0d, 0x0a, 0xd4, 0x23, 0x81, 0xa0,
+	0x62, 0xa4, 0x0f, 0xcc, 0x8e, 0xc7, 0xd2, 0xea, 0x98, 0x74, 0x39, 0x8d, 0xb8, 0xb6, 0xa3, 0x21,
+	0x7a, 0x15, 0x96, 0x38, 0x71, 0x87, 0x21, 0x15, 0x23, 0xc7, 0x65, 0x81, 0xc0, 0xae, 0x28, 0xe7,
+	0x94, 0x4a, 0x29, 0x9a, 0xdf, 0xd1, 0xd3, 0x12, 0xc4, 0x23, 0x02, 0x53, 0x9f, 0x97, 0xaf, 0x68,
+	0x10, 0x33, 0x4c, 0x6d, 0xf7, 0x0f, 0x79, 0x98, 0x8b, 0xe3, 0x16, 0xed, 0xc0, 0x12, 0x1b, 0x90,
+	0x50, 0x3e, 0x3b, 0xd8, 0xf3, 0x42, 0xc2, 0xb9, 0x89, 0xd0, 0xf2, 0xa7, 0x1f, 0xdd, 0x58, 0x31,
+	0x74, 0x6f, 0x6b, 0x49, 0x47, 0x84, 0x34, 0xe8, 0xd9, 0xa5, 0xc8, 0xc2, 0x4c, 0xa3, 0xef, 0xcb,
+	0x03, 0x0b, 0x38, 0x09, 0xf8, 0x90, 0x3b, 0x83, 0x61, 0xf7, 0x88, 0x8c, 0x0c, 0xaf, 0x2b, 0x13,
+	0xbc, 0x6e, 0x07, 0xa3, 0x46, 0xf9, 0x93, 0x04, 0xda, 0x0d, 0x47, 0x03, 0xc1, 0xea, 0xed, 0x61,
+	0xf7, 0x36, 0x19, 0xd9, 0xa5, 0x18, 0xa7, 0xad, 0x60, 0xd0, 0x35, 0xc8, 0xff, 0x04, 0x53, 0x9f,
+	0x78, 0x8a, 0x95, 0x82, 0x6d, 0x46, 0x68, 0x0b, 0xf2, 0x5c, 0x60, 0x31, 0xe4, 0x8a, 0x8a, 0xc5,
+	0xcd, 0xda, 0x59, 0x91, 0xd1, 0x60, 0x81, 0xd7, 0x51, 0x9a, 0xb6, 0xb1, 0x40, 0x7b, 0x90, 0x17,
+	0xec, 0x88, 0x04, 0x86, 0xa4, 0x4b, 0x45, 0xf5, 0x6e, 0x20, 0x52, 0x51, 0xbd, 0x1b, 0x08, 0xdb,
+	0x60, 0xa1, 0x1e, 0x2c, 0x79, 0xc4, 0x27, 0x3d, 0x45, 0x25, 0x3f, 0xc4, 0x21, 0xe1, 0xe5, 0xfc,
+	0x14, 0xb2, 0xa6, 0x14, 0xa3, 0x76, 0x14, 0x28, 0xba, 0x0d, 0x45, 0x2f, 0x09, 0xb7, 0xf2, 0xac,
+	0x22, 0xfa, 0xcb, 0x67, 0xf9, 0x9f, 0x8a, 0x4c, 0x53, 0xa4, 0xd2, 0xd6, 0x32, 0xb8, 0x86, 0x41,
+	0x97, 0x05, 0x1e, 0x0d, 0x7a, 0xce, 0x21, 0xa1, 0xbd, 0x43, 0x51, 0x2e, 0x54, 0xad, 0xeb, 0x33,
+	0x76, 0x29, 0x9e, 0xbf, 0xa5, 0xa6, 0xd1, 0x6d, 0x58, 0x4c, 0x54, 0x55, 0xee, 0xcc, 0x5d, 0x22,
+	0x77, 0x16, 0x62, 0x5b, 0x29, 0x45, 0xb7, 0x00, 0x92, 0xc4, 0x2c, 0x83, 0x02, 0xaa, 0x3d, 0x3f,
+	0xbb, 0x8d, 0x0b, 0x29, 0x5b, 0xe4, 0xc3, 0xd5, 0x3e, 0x0d, 0x1c, 0x4e, 0xfc, 0x03, 0xc7, 0x50,
+	0x25, 0x21, 0x8b, 0x53, 0x38, 0xda, 0xe5, 0x3e, 0x0d, 0x3a, 0xc4, 0x3f, 0x68, 0xc6, 0xb0, 0x5b,
+	0xf3, 0xef, 0x3e, 0xac, 0x64, 0x4c, 0x2e, 0x65, 0x6a, 0x6d, 0x98, 0xdf, 0xc7, 0xbe, 0x49, 0x03,
+	0xc2, 0xd1, 0xeb, 0x30, 0x87, 0xa3, 0x41, 0xd9, 0xaa, 0xce, 0x9c, 0x9b, 0x46, 0x89, 0xaa, 0xce,
+	0xce, 0x9f, 0xff, 0xa5, 0x6a, 0xd5, 0x7e, 0x6d, 0x41, 0xbe, 0xb9, 0xdf, 0xc6, 0x34, 0x44, 0x2d,
+	0x58, 0x4e, 0x02, 0xea, 0xa2, 0xb9, 0x99, 0xc4, 0x60, 0x94, 0x9c, 0x2d, 0x58, 0xbe, 0x17, 0xa5,
+	0x7b, 0x0c, 0x93, 0x7d, 0x1e, 0x4c, 0x6c, 0x62, 0xe6, 0xc7, 0x1c, 0x6f, 0xc1, 0xac, 0xde, 0x25,
+	0x47, 0x5b, 0x70, 0x65, 0x20, 0x1f, 0x94, 0xbf, 0xc5, 0xcd, 0xf5, 0x33, 0x03, 0x51, 0xe9, 0x9b,
+	0x03, 0xd4, 0x26, 0xb5, 0x7f, 0x59, 0x00, 0xcd, 0xfd, 0xfd, 0xbd, 0x90, 0x0e, 0x7c, 0x22, 0xa6,
+	0xe5, 0xf1, 0x9b, 0xf0, 0x42, 0xe2, 0x31, 0x0f, 0xdd, 0x0b, 0x7b, 0x7d, 0x35, 0x36, 0xeb, 0x84,
+	0xee, 0xa9, 0x68, 0x1e, 0x17, 0x31, 0xda, 0xcc, 0x85, 0xd1, 0x9a, 0x5c, 0x9c, 0x4e, 0x63, 0x07,
+	0x8a, 0x89, 0xfb, 0x1c, 0x35, 0xa1, 0x20, 0xcc, 0xb3, 0x61, 0xb3, 0x76, 0x36, 0x9b, 0x91, 0x99,
+	0x61, 0x34, 0xb6, 0xac, 0xfd, 0x5b, 0x92, 0x1a, 0x47, 0xec, 0xe7, 0x2b, 0x8c, 0x64, 0xed, 0x35,
+	0xb5, 0x71, 0x1a, 0x1d, 0x85, 0xc1, 0x1a, 0x63, 0xf5, 0x17, 0x59, 0xb8, 0x7a, 0x37, 0xaa, 0x36,
+	0x9f, 0x5b, 0x26, 0xda, 0x30, 0x4b, 0x02, 0x11, 0x52, 0x45, 0x85, 0x3c, 0xeb, 0xaf, 0x9d, 0x75,
+	0xd6, 0xa7, 0xf8, 0xd2, 0x0a, 0x44, 0x38, 0x32, 0x27, 0x1f, 0xc1, 0x8c, 0xb1, 0xf0, 0xe7, 0x2c,
+	0x94, 0xcf, 0xb2, 0x44, 0xaf, 0x40, 0xc9, 0x0d, 0x89, 0x9a, 0x88, 0xaa, 0xbe, 0xa5, 0xaa, 0xfe,
+	0x62, 0x34, 0x6d, 0x8a, 0xfe, 0x5b, 0x20, 0x1b, 0x28, 0x19, 0x58, 0x52, 0xf5, 0xd2, 0x1d, 0xd3,
+	0x62, 0x62, 0x2c, 0xc5, 0x88, 0x40, 0x89, 0x06, 0x54, 0x50, 0xec, 0x3b, 0x5d, 0xec, 0xe3, 0xc0,
+	0xfd, 0x6f, 0x3a, 0xcb, 0xc9, 0x42, 0xbd, 0x68, 0x40, 0x1b, 0x1a, 0x13, 0xed, 0xc3, 0x6c, 0x04,
+	0x9f, 0x9b, 0x02, 0x7c, 0x04, 0x96, 0xea, 0xa2, 0x3e, 0xcb, 0xc2, 0xb2, 0x4d, 0xbc, 0x2f, 0x16,
+	0xad, 0x3f, 0x04, 0xd0, 0x09, 0x27, 0xeb, 0x60, 0x39, 0x37, 0x85, 0x04, 0x9e, 0xd3, 0x78, 0x4d,
+	0x2e, 0x52, 0xdc, 0x7e, 0x92, 0x85, 0xf9, 0x34, 0xb7, 0x5f, 0x80, 0xf7, 0x02, 0xda, 0x4d, 0xaa,
+	0x41, 0x4e, 0x55, 0x83, 0x57, 0xcf, 0xaa, 0x06, 0x13, 0x51, 0x77, 0x7e, 0x19, 0xf8, 0x55, 0x0e,
+	0xf2, 0x6d, 0x1c, 0xe2, 0x3e, 0x47, 0xdf, 0x99, 0x68, 0xe0, 0xf4, 0xad, 0x6a, 0x75, 0x22, 0xe6,
+	0x9a, 0xe6, 0x52, 0xaf, 0x43, 0xee, 0xfd, 0x53, 0xfa, 0xb7, 0xaf, 0xc0, 0xa2, 0xbc, 0x22, 0xc6,
+	0xae, 0x68, 0x12, 0x17, 0xd4, 0x1d, 0x2f, 0xbe, 0x5d, 0x70, 0x54, 0x81, 0xa2, 0x54, 0x4b, 0x0a,
+	0x9d, 0xd4, 0x81, 0x3e, 0x3e, 0x69, 0xe9, 0x19, 0x74, 0x03, 0xd0, 0x61, 0x7c, 0x69, 0x77, 0x12,
+	0x0a, 0xa4, 0xde, 0x72, 0x22, 0x89, 0xd4, 0xff, 0x1f, 0x40, 0xee, 0xc2, 0xf1, 0x48, 0xc0, 0xfa,
+	0xe6, 0x8e, 0x33, 0x27, 0x67, 0x9a, 0x72, 0x02, 0x1d, 0xc3, 0x6a, 0xcf, 0x67, 0x5d, 0xec, 0x3b,
+	0x3e, 0xfd, 0xe9, 0x90, 0x7a, 0x8e, 0x21, 0xcf, 0x71, 0xf1, 0x60, 0x2a, 0xcd, 0xf8, 0x35, 0x0d,
+	0xff, 0xa6, 0x42, 0xef, 0x68, 0xf0, 0x1d, 0x3c, 0x40, 0xef, 0xc0, 0x8b, 0x49, 0x30, 0x9c, 0xb2,
+	0xf6, 0xec, 0x14, 0xd6, 0x5e, 0x8d, 0x57, 0x98, 0x58, 0xfe, 0x59, 0x16, 0x71, 0xe8, 0x1e, 0xd2,
+	0x7b, 0x44, 0xf5, 0xf1, 0x85, 0x34, 0x8b, 0xdb, 0x5a, 0x90, 0x4a, 0xb5, 0x0f, 0x2c, 0x40, 0xc9,
+	0xbb, 0xc1, 0x26, 0x7c, 0xc0, 0x02, 0xae, 0xba, 0xf3, 0x54, 0x2b, 0x6d, 0x9d, 0xdf, 0x9d, 0x27,
+	0xf6, 0x51, 0x77, 0x9e, 0x4a, 0xdd, 0x6f, 0x24, 0x95, 0x38, 0x6b, 0x82, 0xcd, 0xc0, 0xc8, 0xaf,
+	0x3c, 0xa9, 0x0e, 0x9f, 0x46, 0xd6, 0x13, 0xc5, 0x36, 0x53, 0xfb, 0xcc, 0x82, 0xd5, 0x89, 0xb0,
+	0x8f, 0x37, 0xfb, 0x63, 0x40, 0x61, 0x4a, 0xa8, 0x82, 0x68, 0x64, 0x36, 0x7d, 0xe9, 0x2c, 0x5a,
+	0x0e, 0xc7, 0x05, 0xff, 0xb3, 0x97, 0x49, 0x4e, 0x9d, 0xc0, 0xef, 0x2d, 0x58, 0x49, 0x6f, 0x26,
+	0x76, 0xeb, 0x0e, 0xcc, 0xa7, 0xf7, 0x62, 0x1c, 0x7a, 0xe9, 0x22, 0x0e, 0x19, 0x5f, 0x9e, 0xb1,
+	0x47, 0x6f, 0x27, 0x15, 0x46, 0x7f, 0xd5, 0xba, 0x79, 0x61, 0x6e, 0xa2, 0x3d, 0x8d, 0x57, 0x9a,
+	0x5c, 0xd4, 0x6e, 0xe5, 0xda, 0x8c, 0xf9, 0xe8, 0x1d, 0x58, 0x0e, 0x98, 0x70, 0x64, 0x3a, 0x12,
+	0xcf, 0x31, 0x57, 0x6c, 0x5d, 0xa6, 0xdf, 0xbe, 0x1c, 0x65, 0xff, 0x78, 0x5c, 0x99, 0x84, 0x1a,
+	0xe3, 0xb1, 0x14, 0x30, 0xd1, 0x50, 0xf2, 0x3d, 0x25, 0x46, 0x21, 0x2c, 0x3c, 0xbb, 0xb4, 0x2e,
+	0xeb, 0x6f, 0x5d, 0x7a, 0xe9, 0x85, 0xf3, 0x96, 0x9d, 0xef, 0xa6, 0xd6, 0xdc, 0x2a, 0xc8, 0x33,
+	0xfc, 0xa7, 0x3c, 0xc7, 0xdf, 0x5a, 0x70, 0x55, 0x4d, 0xd2, 0x9f, 0x11, 0x75, 0x51, 0xb7, 0x89,
+	0xcb, 0x42, 0x0f, 0x2d, 0x42, 0x96, 0x7a, 0x8a, 0x85, 0x9c, 0x9d, 0xa5, 0x1e, 0xaa, 0xc3, 0x15,
+	0x76, 0x1c, 0x90, 0xf0, 0xb9, 0x2f, 0x1d, 0xad, 0xa6, 0x0a, 0x2d, 0xf3, 0x86, 0x3e, 0x71, 0xb0,
+	0xeb, 0xb2, 0x61, 0x20, 0xcc, 0xe7, 0xa1, 0x05, 0x3d, 0xbb, 0xad, 0x27, 0xe5, 0xcd, 0x33, 0x2e,
+	0x0f, 0xe5, 0xdc, 0x73, 0xa0, 0x13, 0x55, 0x13, 0x84, 0x1c, 0x16, 0xe3, 0xa2, 0xdd, 0x66, 0xc7,
+	0x24, 0x3c, 0xbd, 0xc9, 0xb5, 0x2e, 0xdd, 0xe4, 0xae, 0xc0, 0x95, 0x01, 0x3b, 0x36, 0xde, 0xce,
+	0xd8, 0x7a, 0xa0, 0x17, 0xfd, 0xea, 0x6f, 0x2c, 0x80, 0xe4, 0xeb, 0x0c, 0x7a, 0x0d, 0xfe, 0xaf,
+	0xf1, 0xdd, 0x3b, 0x4d, 0xa7, 0xb3, 0xb7, 0xbd, 0x77, 0xb7, 0xe3, 0xdc, 0xbd, 0xd3, 0x69, 0xb7,
+	0x76, 0x76, 0xdf, 0xd8, 0x6d, 0x35, 0x97, 0x32, 0x6b, 0xa5, 0xfb, 0x0f, 0xaa, 0xc5, 0xbb, 0x01,
+	0x1f, 0x10, 0x97, 0x1e, 0x50, 0xe2, 0xa1, 0x97, 0x61, 0xe5, 0x59, 0x6d, 0x39, 0x6a, 0x35, 0x97,
+	0xac, 0xb5, 0xf9, 0xfb, 0x0f, 0xaa, 0x05, 0xdd, 0xf8, 0x12, 0x0f, 0x5d, 0x87, 0x17, 0x26, 0xf5,
+	0x76, 0xef, 0x7c, 0x7b, 0x29, 0xbb, 0xb6, 0x70, 0xff, 0x41, 0x75, 0x2e, 0xee, 0x90, 0x51, 0x0d,
+	0x50, 0x5a, 0xd3, 0xe0, 0xcd, 0xac, 0xc1, 0xfd, 0x07, 0xd5, 0xbc, 0x0e, 0xb4, 0xb5, 0xdc, 0xbb,
+	0x1f, 0xac, 0x67, 0x1a, 0x6f, 0x7c, 0xfc, 0x64, 0xdd, 0x7a, 0xf4, 0x64, 0xdd, 0xfa, 0xdb, 0x93,
+	0x75, 0xeb, 0xbd, 0xa7, 0xeb, 0x99, 0x47, 0x4f, 0xd7, 0x33, 0x7f, 0x7c, 0xba, 0x9e, 0xf9, 0xc1,
+	0x6b, 0xe7, 0xc6, 0xd8, 0x49, 0xfc, 0x91, 0x5e, 0x45, 0x5b, 0x37, 0xaf, 0xde, 0xb7, 0x5f, 0xff,
+	0xcf, 0x00, 0xac, 0x40, 0xbb, 0x0d, 0xc3, 0x17, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1250,608 +1413,651 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9615 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x70, 0x24, 0xc7,
-		0x79, 0x1f, 0x66, 0x5f, 0xd8, 0xfd, 0xb0, 0x00, 0x16, 0x0d, 0xdc, 0x71, 0x6f, 0x49, 0x02, 0xe0,
-		0xf2, 0x71, 0xc7, 0x23, 0x89, 0x23, 0x8f, 0xbc, 0xd7, 0x9e, 0x24, 0x0a, 0x0b, 0xec, 0xe1, 0xc0,
-		0xc3, 0x8b, 0x03, 0xe0, 0xf8, 0x90, 0x9d, 0xad, 0xc1, 0x6c, 0x63, 0x31, 0xc4, 0xec, 0xcc, 0x68,
-		0x66, 0xf6, 0x78, 0x38, 0x45, 0x29, 0xca, 0x4a, 0x1c, 0x8a, 0xa9, 0x24, 0x72, 0x94, 0x8a, 0x25,
-		0x59, 0xa7, 0x50, 0x96, 0x12, 0x39, 0xb2, 0x9c, 0x48, 0x16, 0xa5, 0xf8, 0x91, 0x72, 0xa4, 0x54,
-		0x39, 0x96, 0xf4, 0x47, 0x4a, 0x72, 0x52, 0xb1, 0xe5, 0x38, 0x94, 0x42, 0xa9, 0x6c, 0x85, 0x51,
-		0x62, 0x25, 0x61, 0xca, 0x49, 0xa9, 0x94, 0x4a, 0xf5, 0x6b, 0x1e, 0xfb, 0x9a, 0x05, 0x88, 0x93,
-		0xe9, 0xe8, 0x2f, 0x6c, 0x77, 0x7f, 0xdf, 0xaf, 0xbf, 0xfe, 0xfa, 0xeb, 0xaf, 0xbf, 0x7e, 0x0d,
-		0xe0, 0xf3, 0x17, 0x61, 0xba, 0x6e, 0x9a, 0x75, 0x1d, 0x9f, 0xb2, 0x6c, 0xd3, 0x35, 0xb7, 0x9a,
-		0xdb, 0xa7, 0x6a, 0xd8, 0x51, 0x6d, 0xcd, 0x72, 0x4d, 0x7b, 0x86, 0xe6, 0xa1, 0x51, 0x46, 0x31,
-		0x23, 0x28, 0x8a, 0xcb, 0x30, 0x76, 0x49, 0xd3, 0xf1, 0xbc, 0x47, 0xb8, 0x8e, 0x5d, 0x74, 0x1e,
-		0x12, 0xdb, 0x9a, 0x8e, 0xf3, 0xd2, 0x74, 0xfc, 0xc4, 0xd0, 0xe9, 0x7b, 0x66, 0x5a, 0x98, 0x66,
-		0xc2, 0x1c, 0x6b, 0x24, 0x5b, 0xa6, 0x1c, 0xc5, 0xef, 0x25, 0x60, 0xbc, 0x43, 0x29, 0x42, 0x90,
-		0x30, 0x94, 0x06, 0x41, 0x94, 0x4e, 0x64, 0x64, 0xfa, 0x1b, 0xe5, 0x61, 0xd0, 0x52, 0xd4, 0x5d,
-		0xa5, 0x8e, 0xf3, 0x31, 0x9a, 0x2d, 0x92, 0x68, 0x12, 0xa0, 0x86, 0x2d, 0x6c, 0xd4, 0xb0, 0xa1,
-		0xee, 0xe5, 0xe3, 0xd3, 0xf1, 0x13, 0x19, 0x39, 0x90, 0x83, 0x1e, 0x80, 0x31, 0xab, 0xb9, 0xa5,
-		0x6b, 0x6a, 0x35, 0x40, 0x06, 0xd3, 0xf1, 0x13, 0x49, 0x39, 0xc7, 0x0a, 0xe6, 0x7d, 0xe2, 0xe3,
-		0x30, 0xfa, 0x3c, 0x56, 0x76, 0x83, 0xa4, 0x43, 0x94, 0x74, 0x84, 0x64, 0x07, 0x08, 0xe7, 0x20,
-		0xdb, 0xc0, 0x8e, 0xa3, 0xd4, 0x71, 0xd5, 0xdd, 0xb3, 0x70, 0x3e, 0x41, 0x5b, 0x3f, 0xdd, 0xd6,
-		0xfa, 0xd6, 0x96, 0x0f, 0x71, 0xae, 0x8d, 0x3d, 0x0b, 0xa3, 0x59, 0xc8, 0x60, 0xa3, 0xd9, 0x60,
-		0x08, 0xc9, 0x2e, 0xfa, 0xab, 0x18, 0xcd, 0x46, 0x2b, 0x4a, 0x9a, 0xb0, 0x71, 0x88, 0x41, 0x07,
-		0xdb, 0xd7, 0x34, 0x15, 0xe7, 0x53, 0x14, 0xe0, 0x78, 0x1b, 0xc0, 0x3a, 0x2b, 0x6f, 0xc5, 0x10,
-		0x7c, 0x68, 0x0e, 0x32, 0xf8, 0xba, 0x8b, 0x0d, 0x47, 0x33, 0x8d, 0xfc, 0x20, 0x05, 0xb9, 0xb7,
-		0x43, 0x2f, 0x62, 0xbd, 0xd6, 0x0a, 0xe1, 0xf3, 0xa1, 0xb3, 0x30, 0x68, 0x5a, 0xae, 0x66, 0x1a,
-		0x4e, 0x3e, 0x3d, 0x2d, 0x9d, 0x18, 0x3a, 0x7d, 0x47, 0x47, 0x43, 0x58, 0x65, 0x34, 0xb2, 0x20,
-		0x46, 0x8b, 0x90, 0x73, 0xcc, 0xa6, 0xad, 0xe2, 0xaa, 0x6a, 0xd6, 0x70, 0x55, 0x33, 0xb6, 0xcd,
-		0x7c, 0x86, 0x02, 0x4c, 0xb5, 0x37, 0x84, 0x12, 0xce, 0x99, 0x35, 0xbc, 0x68, 0x6c, 0x9b, 0xf2,
-		0x88, 0x13, 0x4a, 0xa3, 0xa3, 0x90, 0x72, 0xf6, 0x0c, 0x57, 0xb9, 0x9e, 0xcf, 0x52, 0x0b, 0xe1,
-		0xa9, 0xe2, 0x6f, 0xa6, 0x60, 0xb4, 0x1f, 0x13, 0xbb, 0x08, 0xc9, 0x6d, 0xd2, 0xca, 0x7c, 0x6c,
-		0x3f, 0x3a, 0x60, 0x3c, 0x61, 0x25, 0xa6, 0x0e, 0xa8, 0xc4, 0x59, 0x18, 0x32, 0xb0, 0xe3, 0xe2,
-		0x1a, 0xb3, 0x88, 0x78, 0x9f, 0x36, 0x05, 0x8c, 0xa9, 0xdd, 0xa4, 0x12, 0x07, 0x32, 0xa9, 0xa7,
-		0x61, 0xd4, 0x13, 0xa9, 0x6a, 0x2b, 0x46, 0x5d, 0xd8, 0xe6, 0xa9, 0x28, 0x49, 0x66, 0x2a, 0x82,
-		0x4f, 0x26, 0x6c, 0xf2, 0x08, 0x0e, 0xa5, 0xd1, 0x3c, 0x80, 0x69, 0x60, 0x73, 0xbb, 0x5a, 0xc3,
-		0xaa, 0x9e, 0x4f, 0x77, 0xd1, 0xd2, 0x2a, 0x21, 0x69, 0xd3, 0x92, 0xc9, 0x72, 0x55, 0x1d, 0x5d,
-		0xf0, 0x4d, 0x6d, 0xb0, 0x8b, 0xa5, 0x2c, 0xb3, 0x41, 0xd6, 0x66, 0x6d, 0x9b, 0x30, 0x62, 0x63,
-		0x62, 0xf7, 0xb8, 0xc6, 0x5b, 0x96, 0xa1, 0x42, 0xcc, 0x44, 0xb6, 0x4c, 0xe6, 0x6c, 0xac, 0x61,
-		0xc3, 0x76, 0x30, 0x89, 0xee, 0x06, 0x2f, 0xa3, 0x4a, 0xcd, 0x0a, 0xa8, 0x17, 0xca, 0x8a, 0xcc,
-		0x15, 0xa5, 0x81, 0x0b, 0x37, 0x60, 0x24, 0xac, 0x1e, 0x34, 0x01, 0x49, 0xc7, 0x55, 0x6c, 0x97,
-		0x5a, 0x61, 0x52, 0x66, 0x09, 0x94, 0x83, 0x38, 0x36, 0x6a, 0xd4, 0xcb, 0x25, 0x65, 0xf2, 0x13,
-		0xbd, 0xd3, 0x6f, 0x70, 0x9c, 0x36, 0xf8, 0xbe, 0xf6, 0x1e, 0x0d, 0x21, 0xb7, 0xb6, 0xbb, 0x70,
-		0x0e, 0x86, 0x43, 0x0d, 0xe8, 0xb7, 0xea, 0xe2, 0x5f, 0x85, 0x23, 0x1d, 0xa1, 0xd1, 0xd3, 0x30,
-		0xd1, 0x34, 0x34, 0xc3, 0xc5, 0xb6, 0x65, 0x63, 0x62, 0xb1, 0xac, 0xaa, 0xfc, 0x9f, 0x0e, 0x76,
-		0xb1, 0xb9, 0xcd, 0x20, 0x35, 0x43, 0x91, 0xc7, 0x9b, 0xed, 0x99, 0x27, 0x33, 0xe9, 0xef, 0x0f,
-		0xe6, 0x5e, 0x78, 0xe1, 0x85, 0x17, 0x62, 0xc5, 0xaf, 0xa4, 0x60, 0xa2, 0xd3, 0x98, 0xe9, 0x38,
-		0x7c, 0x8f, 0x42, 0xca, 0x68, 0x36, 0xb6, 0xb0, 0x4d, 0x95, 0x94, 0x94, 0x79, 0x0a, 0xcd, 0x42,
-		0x52, 0x57, 0xb6, 0xb0, 0x9e, 0x4f, 0x4c, 0x4b, 0x27, 0x46, 0x4e, 0x3f, 0xd0, 0xd7, 0xa8, 0x9c,
-		0x59, 0x22, 0x2c, 0x32, 0xe3, 0x44, 0xef, 0x80, 0x04, 0x77, 0xd1, 0x04, 0xe1, 0x64, 0x7f, 0x08,
-		0x64, 0x2c, 0xc9, 0x94, 0x0f, 0xdd, 0x0e, 0x19, 0xf2, 0x97, 0xd9, 0x46, 0x8a, 0xca, 0x9c, 0x26,
-		0x19, 0xc4, 0x2e, 0x50, 0x01, 0xd2, 0x74, 0x98, 0xd4, 0xb0, 0x98, 0xda, 0xbc, 0x34, 0x31, 0xac,
-		0x1a, 0xde, 0x56, 0x9a, 0xba, 0x5b, 0xbd, 0xa6, 0xe8, 0x4d, 0x4c, 0x0d, 0x3e, 0x23, 0x67, 0x79,
-		0xe6, 0x55, 0x92, 0x87, 0xa6, 0x60, 0x88, 0x8d, 0x2a, 0xcd, 0xa8, 0xe1, 0xeb, 0xd4, 0x7b, 0x26,
-		0x65, 0x36, 0xd0, 0x16, 0x49, 0x0e, 0xa9, 0xfe, 0x39, 0xc7, 0x34, 0x84, 0x69, 0xd2, 0x2a, 0x48,
-		0x06, 0xad, 0xfe, 0x5c, 0xab, 0xe3, 0xbe, 0xb3, 0x73, 0xf3, 0xda, 0xc6, 0xd2, 0x71, 0x18, 0xa5,
-		0x14, 0x8f, 0xf2, 0xae, 0x57, 0xf4, 0xfc, 0xd8, 0xb4, 0x74, 0x22, 0x2d, 0x8f, 0xb0, 0xec, 0x55,
-		0x9e, 0x5b, 0xfc, 0x52, 0x0c, 0x12, 0xd4, 0xb1, 0x8c, 0xc2, 0xd0, 0xc6, 0x33, 0x6b, 0x95, 0xea,
-		0xfc, 0xea, 0x66, 0x79, 0xa9, 0x92, 0x93, 0xd0, 0x08, 0x00, 0xcd, 0xb8, 0xb4, 0xb4, 0x3a, 0xbb,
-		0x91, 0x8b, 0x79, 0xe9, 0xc5, 0x95, 0x8d, 0xb3, 0x8f, 0xe5, 0xe2, 0x1e, 0xc3, 0x26, 0xcb, 0x48,
-		0x04, 0x09, 0x1e, 0x3d, 0x9d, 0x4b, 0xa2, 0x1c, 0x64, 0x19, 0xc0, 0xe2, 0xd3, 0x95, 0xf9, 0xb3,
-		0x8f, 0xe5, 0x52, 0xe1, 0x9c, 0x47, 0x4f, 0xe7, 0x06, 0xd1, 0x30, 0x64, 0x68, 0x4e, 0x79, 0x75,
-		0x75, 0x29, 0x97, 0xf6, 0x30, 0xd7, 0x37, 0xe4, 0xc5, 0x95, 0x85, 0x5c, 0xc6, 0xc3, 0x5c, 0x90,
-		0x57, 0x37, 0xd7, 0x72, 0xe0, 0x21, 0x2c, 0x57, 0xd6, 0xd7, 0x67, 0x17, 0x2a, 0xb9, 0x21, 0x8f,
-		0xa2, 0xfc, 0xcc, 0x46, 0x65, 0x3d, 0x97, 0x0d, 0x89, 0xf5, 0xe8, 0xe9, 0xdc, 0xb0, 0x57, 0x45,
-		0x65, 0x65, 0x73, 0x39, 0x37, 0x82, 0xc6, 0x60, 0x98, 0x55, 0x21, 0x84, 0x18, 0x6d, 0xc9, 0x3a,
-		0xfb, 0x58, 0x2e, 0xe7, 0x0b, 0xc2, 0x50, 0xc6, 0x42, 0x19, 0x67, 0x1f, 0xcb, 0xa1, 0xe2, 0x1c,
-		0x24, 0xa9, 0x19, 0x22, 0x04, 0x23, 0x4b, 0xb3, 0xe5, 0xca, 0x52, 0x75, 0x75, 0x6d, 0x63, 0x71,
-		0x75, 0x65, 0x76, 0x29, 0x27, 0xf9, 0x79, 0x72, 0xe5, 0xc9, 0xcd, 0x45, 0xb9, 0x32, 0x9f, 0x8b,
-		0x05, 0xf3, 0xd6, 0x2a, 0xb3, 0x1b, 0x95, 0xf9, 0x5c, 0xbc, 0xa8, 0xc2, 0x44, 0x27, 0x87, 0xda,
-		0x71, 0x08, 0x05, 0x6c, 0x21, 0xd6, 0xc5, 0x16, 0x28, 0x56, 0xab, 0x2d, 0x14, 0xbf, 0x1b, 0x83,
-		0xf1, 0x0e, 0x93, 0x4a, 0xc7, 0x4a, 0x1e, 0x87, 0x24, 0xb3, 0x65, 0x36, 0xcd, 0xde, 0xdf, 0x71,
-		0x76, 0xa2, 0x96, 0xdd, 0x36, 0xd5, 0x52, 0xbe, 0x60, 0xa8, 0x11, 0xef, 0x12, 0x6a, 0x10, 0x88,
-		0x36, 0x83, 0xfd, 0xd9, 0x36, 0xe7, 0xcf, 0xe6, 0xc7, 0xb3, 0xfd, 0xcc, 0x8f, 0x34, 0x6f, 0x7f,
-		0x93, 0x40, 0xb2, 0xc3, 0x24, 0x70, 0x11, 0xc6, 0xda, 0x80, 0xfa, 0x76, 0xc6, 0xef, 0x97, 0x20,
-		0xdf, 0x4d, 0x39, 0x11, 0x2e, 0x31, 0x16, 0x72, 0x89, 0x17, 0x5b, 0x35, 0x78, 0x57, 0xf7, 0x4e,
-		0x68, 0xeb, 0xeb, 0x4f, 0x4b, 0x70, 0xb4, 0x73, 0x48, 0xd9, 0x51, 0x86, 0x77, 0x40, 0xaa, 0x81,
-		0xdd, 0x1d, 0x53, 0x84, 0x55, 0xf7, 0x75, 0x98, 0xac, 0x49, 0x71, 0x6b, 0x67, 0x73, 0xae, 0xe0,
-		0x6c, 0x1f, 0xef, 0x16, 0x17, 0x32, 0x69, 0xda, 0x24, 0xfd, 0x40, 0x0c, 0x8e, 0x74, 0x04, 0xef,
-		0x28, 0xe8, 0x9d, 0x00, 0x9a, 0x61, 0x35, 0x5d, 0x16, 0x3a, 0x31, 0x4f, 0x9c, 0xa1, 0x39, 0xd4,
-		0x79, 0x11, 0x2f, 0xdb, 0x74, 0xbd, 0xf2, 0x38, 0x2d, 0x07, 0x96, 0x45, 0x09, 0xce, 0xfb, 0x82,
-		0x26, 0xa8, 0xa0, 0x93, 0x5d, 0x5a, 0xda, 0x66, 0x98, 0x0f, 0x43, 0x4e, 0xd5, 0x35, 0x6c, 0xb8,
-		0x55, 0xc7, 0xb5, 0xb1, 0xd2, 0xd0, 0x8c, 0x3a, 0x9d, 0x6a, 0xd2, 0xa5, 0xe4, 0xb6, 0xa2, 0x3b,
-		0x58, 0x1e, 0x65, 0xc5, 0xeb, 0xa2, 0x94, 0x70, 0x50, 0x03, 0xb2, 0x03, 0x1c, 0xa9, 0x10, 0x07,
-		0x2b, 0xf6, 0x38, 0x8a, 0xbf, 0x90, 0x81, 0xa1, 0x40, 0x00, 0x8e, 0xee, 0x82, 0xec, 0x73, 0xca,
-		0x35, 0xa5, 0x2a, 0x16, 0x55, 0x4c, 0x13, 0x43, 0x24, 0x6f, 0x8d, 0x2f, 0xac, 0x1e, 0x86, 0x09,
-		0x4a, 0x62, 0x36, 0x5d, 0x6c, 0x57, 0x55, 0x5d, 0x71, 0x1c, 0xaa, 0xb4, 0x34, 0x25, 0x45, 0xa4,
-		0x6c, 0x95, 0x14, 0xcd, 0x89, 0x12, 0x74, 0x06, 0xc6, 0x29, 0x47, 0xa3, 0xa9, 0xbb, 0x9a, 0xa5,
-		0xe3, 0x2a, 0x59, 0xe6, 0x39, 0x74, 0xca, 0xf1, 0x24, 0x1b, 0x23, 0x14, 0xcb, 0x9c, 0x80, 0x48,
-		0xe4, 0xa0, 0x79, 0xb8, 0x93, 0xb2, 0xd5, 0xb1, 0x81, 0x6d, 0xc5, 0xc5, 0x55, 0xfc, 0xee, 0xa6,
-		0xa2, 0x3b, 0x55, 0xc5, 0xa8, 0x55, 0x77, 0x14, 0x67, 0x27, 0x3f, 0x41, 0x00, 0xca, 0xb1, 0xbc,
-		0x24, 0x1f, 0x23, 0x84, 0x0b, 0x9c, 0xae, 0x42, 0xc9, 0x66, 0x8d, 0xda, 0x65, 0xc5, 0xd9, 0x41,
-		0x25, 0x38, 0x4a, 0x51, 0x1c, 0xd7, 0xd6, 0x8c, 0x7a, 0x55, 0xdd, 0xc1, 0xea, 0x6e, 0xb5, 0xe9,
-		0x6e, 0x9f, 0xcf, 0xdf, 0x1e, 0xac, 0x9f, 0x4a, 0xb8, 0x4e, 0x69, 0xe6, 0x08, 0xc9, 0xa6, 0xbb,
-		0x7d, 0x1e, 0xad, 0x43, 0x96, 0x74, 0x46, 0x43, 0xbb, 0x81, 0xab, 0xdb, 0xa6, 0x4d, 0xe7, 0xd0,
-		0x91, 0x0e, 0xae, 0x29, 0xa0, 0xc1, 0x99, 0x55, 0xce, 0xb0, 0x6c, 0xd6, 0x70, 0x29, 0xb9, 0xbe,
-		0x56, 0xa9, 0xcc, 0xcb, 0x43, 0x02, 0xe5, 0x92, 0x69, 0x13, 0x83, 0xaa, 0x9b, 0x9e, 0x82, 0x87,
-		0x98, 0x41, 0xd5, 0x4d, 0xa1, 0xde, 0x33, 0x30, 0xae, 0xaa, 0xac, 0xcd, 0x9a, 0x5a, 0xe5, 0x8b,
-		0x31, 0x27, 0x9f, 0x0b, 0x29, 0x4b, 0x55, 0x17, 0x18, 0x01, 0xb7, 0x71, 0x07, 0x5d, 0x80, 0x23,
-		0xbe, 0xb2, 0x82, 0x8c, 0x63, 0x6d, 0xad, 0x6c, 0x65, 0x3d, 0x03, 0xe3, 0xd6, 0x5e, 0x3b, 0x23,
-		0x0a, 0xd5, 0x68, 0xed, 0xb5, 0xb2, 0x9d, 0x83, 0x09, 0x6b, 0xc7, 0x6a, 0xe7, 0x3b, 0x19, 0xe4,
-		0x43, 0xd6, 0x8e, 0xd5, 0xca, 0x78, 0x2f, 0x5d, 0x99, 0xdb, 0x58, 0x55, 0x5c, 0x5c, 0xcb, 0xdf,
-		0x16, 0x24, 0x0f, 0x14, 0xa0, 0x19, 0xc8, 0xa9, 0x6a, 0x15, 0x1b, 0xca, 0x96, 0x8e, 0xab, 0x8a,
-		0x8d, 0x0d, 0xc5, 0xc9, 0x4f, 0x51, 0xe2, 0x84, 0x6b, 0x37, 0xb1, 0x3c, 0xa2, 0xaa, 0x15, 0x5a,
-		0x38, 0x4b, 0xcb, 0xd0, 0x49, 0x18, 0x33, 0xb7, 0x9e, 0x53, 0x99, 0x45, 0x56, 0x2d, 0x1b, 0x6f,
-		0x6b, 0xd7, 0xf3, 0xf7, 0x50, 0xf5, 0x8e, 0x92, 0x02, 0x6a, 0x8f, 0x6b, 0x34, 0x1b, 0xdd, 0x0f,
-		0x39, 0xd5, 0xd9, 0x51, 0x6c, 0x8b, 0xba, 0x64, 0xc7, 0x52, 0x54, 0x9c, 0xbf, 0x97, 0x91, 0xb2,
-		0xfc, 0x15, 0x91, 0x4d, 0x46, 0x84, 0xf3, 0xbc, 0xb6, 0xed, 0x0a, 0xc4, 0xe3, 0x6c, 0x44, 0xd0,
-		0x3c, 0x8e, 0x76, 0x02, 0x72, 0x44, 0x13, 0xa1, 0x8a, 0x4f, 0x50, 0xb2, 0x11, 0x6b, 0xc7, 0x0a,
-		0xd6, 0x7b, 0x37, 0x0c, 0x13, 0x4a, 0xbf, 0xd2, 0xfb, 0x59, 0xe0, 0x66, 0xed, 0x04, 0x6a, 0x7c,
-		0x0c, 0x8e, 0x12, 0xa2, 0x06, 0x76, 0x95, 0x9a, 0xe2, 0x2a, 0x01, 0xea, 0x07, 0x29, 0x35, 0x51,
-		0xfb, 0x32, 0x2f, 0x0c, 0xc9, 0x69, 0x37, 0xb7, 0xf6, 0x3c, 0xc3, 0x7a, 0x88, 0xc9, 0x49, 0xf2,
-		0x84, 0x69, 0xdd, 0xb2, 0xe0, 0xbc, 0x58, 0x82, 0x6c, 0xd0, 0xee, 0x51, 0x06, 0x98, 0xe5, 0xe7,
-		0x24, 0x12, 0x04, 0xcd, 0xad, 0xce, 0x93, 0xf0, 0xe5, 0xd9, 0x4a, 0x2e, 0x46, 0xc2, 0xa8, 0xa5,
-		0xc5, 0x8d, 0x4a, 0x55, 0xde, 0x5c, 0xd9, 0x58, 0x5c, 0xae, 0xe4, 0xe2, 0x81, 0xc0, 0xfe, 0x89,
-		0x44, 0xfa, 0xbe, 0xdc, 0xf1, 0xe2, 0x37, 0x63, 0x30, 0x12, 0x5e, 0xa9, 0xa1, 0xb7, 0xc1, 0x6d,
-		0x62, 0x5b, 0xc5, 0xc1, 0x6e, 0xf5, 0x79, 0xcd, 0xa6, 0x03, 0xb2, 0xa1, 0xb0, 0xc9, 0xd1, 0xb3,
-		0x9f, 0x09, 0x4e, 0xb5, 0x8e, 0xdd, 0xa7, 0x34, 0x9b, 0x0c, 0xb7, 0x86, 0xe2, 0xa2, 0x25, 0x98,
-		0x32, 0xcc, 0xaa, 0xe3, 0x2a, 0x46, 0x4d, 0xb1, 0x6b, 0x55, 0x7f, 0x43, 0xab, 0xaa, 0xa8, 0x2a,
-		0x76, 0x1c, 0x93, 0x4d, 0x84, 0x1e, 0xca, 0x1d, 0x86, 0xb9, 0xce, 0x89, 0xfd, 0x19, 0x62, 0x96,
-		0x93, 0xb6, 0x98, 0x6f, 0xbc, 0x9b, 0xf9, 0xde, 0x0e, 0x99, 0x86, 0x62, 0x55, 0xb1, 0xe1, 0xda,
-		0x7b, 0x34, 0x3e, 0x4f, 0xcb, 0xe9, 0x86, 0x62, 0x55, 0x48, 0xfa, 0x27, 0xb2, 0x4c, 0x7a, 0x22,
-		0x91, 0x4e, 0xe7, 0x32, 0x4f, 0x24, 0xd2, 0x99, 0x1c, 0x14, 0x5f, 0x8b, 0x43, 0x36, 0x18, 0xaf,
-		0x93, 0xe5, 0x8f, 0x4a, 0x67, 0x2c, 0x89, 0xfa, 0xb4, 0xbb, 0x7b, 0x46, 0xf7, 0x33, 0x73, 0x64,
-		0x2a, 0x2b, 0xa5, 0x58, 0x70, 0x2c, 0x33, 0x4e, 0x12, 0x46, 0x10, 0x63, 0xc3, 0x2c, 0x18, 0x49,
-		0xcb, 0x3c, 0x85, 0x16, 0x20, 0xf5, 0x9c, 0x43, 0xb1, 0x53, 0x14, 0xfb, 0x9e, 0xde, 0xd8, 0x4f,
-		0xac, 0x53, 0xf0, 0xcc, 0x13, 0xeb, 0xd5, 0x95, 0x55, 0x79, 0x79, 0x76, 0x49, 0xe6, 0xec, 0xe8,
-		0x18, 0x24, 0x74, 0xe5, 0xc6, 0x5e, 0x78, 0xd2, 0xa3, 0x59, 0xfd, 0x76, 0xc2, 0x31, 0x48, 0x3c,
-		0x8f, 0x95, 0xdd, 0xf0, 0x54, 0x43, 0xb3, 0x6e, 0xe1, 0x60, 0x38, 0x05, 0x49, 0xaa, 0x2f, 0x04,
-		0xc0, 0x35, 0x96, 0x1b, 0x40, 0x69, 0x48, 0xcc, 0xad, 0xca, 0x64, 0x40, 0xe4, 0x20, 0xcb, 0x72,
-		0xab, 0x6b, 0x8b, 0x95, 0xb9, 0x4a, 0x2e, 0x56, 0x3c, 0x03, 0x29, 0xa6, 0x04, 0x32, 0x58, 0x3c,
-		0x35, 0xe4, 0x06, 0x78, 0x92, 0x63, 0x48, 0xa2, 0x74, 0x73, 0xb9, 0x5c, 0x91, 0x73, 0xb1, 0x70,
-		0x57, 0x27, 0x72, 0xc9, 0xa2, 0x03, 0xd9, 0x60, 0x1c, 0xfe, 0x93, 0x59, 0x8c, 0x7f, 0x59, 0x82,
-		0xa1, 0x40, 0x5c, 0x4d, 0x02, 0x22, 0x45, 0xd7, 0xcd, 0xe7, 0xab, 0x8a, 0xae, 0x29, 0x0e, 0x37,
-		0x0d, 0xa0, 0x59, 0xb3, 0x24, 0xa7, 0xdf, 0xae, 0xfb, 0x09, 0x0d, 0x91, 0x64, 0x2e, 0x55, 0xfc,
-		0xb8, 0x04, 0xb9, 0xd6, 0xc0, 0xb6, 0x45, 0x4c, 0xe9, 0x2f, 0x52, 0xcc, 0xe2, 0xc7, 0x24, 0x18,
-		0x09, 0x47, 0xb3, 0x2d, 0xe2, 0xdd, 0xf5, 0x17, 0x2a, 0xde, 0x77, 0x62, 0x30, 0x1c, 0x8a, 0x61,
-		0xfb, 0x95, 0xee, 0xdd, 0x30, 0xa6, 0xd5, 0x70, 0xc3, 0x32, 0x5d, 0x6c, 0xa8, 0x7b, 0x55, 0x1d,
-		0x5f, 0xc3, 0x7a, 0xbe, 0x48, 0x9d, 0xc6, 0xa9, 0xde, 0x51, 0xf2, 0xcc, 0xa2, 0xcf, 0xb7, 0x44,
-		0xd8, 0x4a, 0xe3, 0x8b, 0xf3, 0x95, 0xe5, 0xb5, 0xd5, 0x8d, 0xca, 0xca, 0xdc, 0x33, 0xd5, 0xcd,
-		0x95, 0x2b, 0x2b, 0xab, 0x4f, 0xad, 0xc8, 0x39, 0xad, 0x85, 0xec, 0x16, 0x0e, 0xfb, 0x35, 0xc8,
-		0xb5, 0x0a, 0x85, 0x6e, 0x83, 0x4e, 0x62, 0xe5, 0x06, 0xd0, 0x38, 0x8c, 0xae, 0xac, 0x56, 0xd7,
-		0x17, 0xe7, 0x2b, 0xd5, 0xca, 0xa5, 0x4b, 0x95, 0xb9, 0x8d, 0x75, 0xb6, 0xef, 0xe1, 0x51, 0x6f,
-		0x84, 0x06, 0x78, 0xf1, 0xa3, 0x71, 0x18, 0xef, 0x20, 0x09, 0x9a, 0xe5, 0x2b, 0x16, 0xb6, 0x88,
-		0x7a, 0xa8, 0x1f, 0xe9, 0x67, 0x48, 0xcc, 0xb0, 0xa6, 0xd8, 0x2e, 0x5f, 0xe0, 0xdc, 0x0f, 0x44,
-		0x4b, 0x86, 0xab, 0x6d, 0x6b, 0xd8, 0xe6, 0xfb, 0x49, 0x6c, 0x19, 0x33, 0xea, 0xe7, 0xb3, 0x2d,
-		0xa5, 0x07, 0x01, 0x59, 0xa6, 0xa3, 0xb9, 0xda, 0x35, 0x5c, 0xd5, 0x0c, 0xb1, 0xf9, 0x44, 0x96,
-		0x35, 0x09, 0x39, 0x27, 0x4a, 0x16, 0x0d, 0xd7, 0xa3, 0x36, 0x70, 0x5d, 0x69, 0xa1, 0x26, 0xce,
-		0x3c, 0x2e, 0xe7, 0x44, 0x89, 0x47, 0x7d, 0x17, 0x64, 0x6b, 0x66, 0x93, 0xc4, 0x7a, 0x8c, 0x8e,
-		0xcc, 0x1d, 0x92, 0x3c, 0xc4, 0xf2, 0x3c, 0x12, 0x1e, 0xc5, 0xfb, 0xbb, 0x5e, 0x59, 0x79, 0x88,
-		0xe5, 0x31, 0x92, 0xe3, 0x30, 0xaa, 0xd4, 0xeb, 0x36, 0x01, 0x17, 0x40, 0x6c, 0x5d, 0x32, 0xe2,
-		0x65, 0x53, 0xc2, 0xc2, 0x13, 0x90, 0x16, 0x7a, 0x20, 0x53, 0x35, 0xd1, 0x44, 0xd5, 0x62, 0x8b,
-		0xed, 0xd8, 0x89, 0x8c, 0x9c, 0x36, 0x44, 0xe1, 0x5d, 0x90, 0xd5, 0x9c, 0xaa, 0xbf, 0x89, 0x1f,
-		0x9b, 0x8e, 0x9d, 0x48, 0xcb, 0x43, 0x9a, 0xe3, 0x6d, 0x80, 0x16, 0x3f, 0x1d, 0x83, 0x91, 0xf0,
-		0x21, 0x04, 0x9a, 0x87, 0xb4, 0x6e, 0xaa, 0x0a, 0x35, 0x2d, 0x76, 0x02, 0x76, 0x22, 0xe2, 0xdc,
-		0x62, 0x66, 0x89, 0xd3, 0xcb, 0x1e, 0x67, 0xe1, 0xdf, 0x48, 0x90, 0x16, 0xd9, 0xe8, 0x28, 0x24,
-		0x2c, 0xc5, 0xdd, 0xa1, 0x70, 0xc9, 0x72, 0x2c, 0x27, 0xc9, 0x34, 0x4d, 0xf2, 0x1d, 0x4b, 0x31,
-		0xa8, 0x09, 0xf0, 0x7c, 0x92, 0x26, 0xfd, 0xaa, 0x63, 0xa5, 0x46, 0x17, 0x3d, 0x66, 0xa3, 0x81,
-		0x0d, 0xd7, 0x11, 0xfd, 0xca, 0xf3, 0xe7, 0x78, 0x36, 0x7a, 0x00, 0xc6, 0x5c, 0x5b, 0xd1, 0xf4,
-		0x10, 0x6d, 0x82, 0xd2, 0xe6, 0x44, 0x81, 0x47, 0x5c, 0x82, 0x63, 0x02, 0xb7, 0x86, 0x5d, 0x45,
-		0xdd, 0xc1, 0x35, 0x9f, 0x29, 0x45, 0x37, 0x37, 0x6e, 0xe3, 0x04, 0xf3, 0xbc, 0x5c, 0xf0, 0x16,
-		0xbf, 0x29, 0xc1, 0x98, 0x58, 0xa6, 0xd5, 0x3c, 0x65, 0x2d, 0x03, 0x28, 0x86, 0x61, 0xba, 0x41,
-		0x75, 0xb5, 0x9b, 0x72, 0x1b, 0xdf, 0xcc, 0xac, 0xc7, 0x24, 0x07, 0x00, 0x0a, 0x0d, 0x00, 0xbf,
-		0xa4, 0xab, 0xda, 0xa6, 0x60, 0x88, 0x9f, 0x30, 0xd1, 0x63, 0x4a, 0xb6, 0xb0, 0x07, 0x96, 0x45,
-		0xd6, 0x73, 0x68, 0x02, 0x92, 0x5b, 0xb8, 0xae, 0x19, 0x7c, 0xdf, 0x98, 0x25, 0xc4, 0xf6, 0x4b,
-		0xc2, 0xdb, 0x7e, 0x29, 0xff, 0x35, 0x18, 0x57, 0xcd, 0x46, 0xab, 0xb8, 0xe5, 0x5c, 0xcb, 0xe6,
-		0x82, 0x73, 0x59, 0x7a, 0xf6, 0x21, 0x4e, 0x54, 0x37, 0x75, 0xc5, 0xa8, 0xcf, 0x98, 0x76, 0xdd,
-		0x3f, 0x66, 0x25, 0x11, 0x8f, 0x13, 0x38, 0x6c, 0xb5, 0xb6, 0xfe, 0xb7, 0x24, 0xfd, 0x72, 0x2c,
-		0xbe, 0xb0, 0x56, 0xfe, 0x4c, 0xac, 0xb0, 0xc0, 0x18, 0xd7, 0x84, 0x32, 0x64, 0xbc, 0xad, 0x63,
-		0x95, 0x34, 0x10, 0x5e, 0x7f, 0x00, 0x26, 0xea, 0x66, 0xdd, 0xa4, 0x48, 0xa7, 0xc8, 0x2f, 0x7e,
-		0x4e, 0x9b, 0xf1, 0x72, 0x0b, 0x91, 0x87, 0xba, 0xa5, 0x15, 0x18, 0xe7, 0xc4, 0x55, 0x7a, 0x50,
-		0xc4, 0x96, 0x31, 0xa8, 0xe7, 0x1e, 0x5a, 0xfe, 0xf3, 0xdf, 0xa3, 0xd3, 0xb7, 0x3c, 0xc6, 0x59,
-		0x49, 0x19, 0x5b, 0xe9, 0x94, 0x64, 0x38, 0x12, 0xc2, 0x63, 0x83, 0x14, 0xdb, 0x11, 0x88, 0xbf,
-		0xcb, 0x11, 0xc7, 0x03, 0x88, 0xeb, 0x9c, 0xb5, 0x34, 0x07, 0xc3, 0xfb, 0xc1, 0xfa, 0xd7, 0x1c,
-		0x2b, 0x8b, 0x83, 0x20, 0x0b, 0x30, 0x4a, 0x41, 0xd4, 0xa6, 0xe3, 0x9a, 0x0d, 0xea, 0x01, 0x7b,
-		0xc3, 0xfc, 0xde, 0xf7, 0xd8, 0xa8, 0x19, 0x21, 0x6c, 0x73, 0x1e, 0x57, 0xa9, 0x04, 0xf4, 0x6c,
-		0xac, 0x86, 0x55, 0x3d, 0x02, 0xe1, 0xab, 0x5c, 0x10, 0x8f, 0xbe, 0x74, 0x15, 0x26, 0xc8, 0x6f,
-		0xea, 0xa0, 0x82, 0x92, 0x44, 0x6f, 0xb8, 0xe5, 0xbf, 0xf9, 0x7e, 0x36, 0x30, 0xc7, 0x3d, 0x80,
-		0x80, 0x4c, 0x81, 0x5e, 0xac, 0x63, 0xd7, 0xc5, 0xb6, 0x53, 0x55, 0xf4, 0x4e, 0xe2, 0x05, 0x76,
-		0x2c, 0xf2, 0x1f, 0xf9, 0x41, 0xb8, 0x17, 0x17, 0x18, 0xe7, 0xac, 0xae, 0x97, 0x36, 0xe1, 0xb6,
-		0x0e, 0x56, 0xd1, 0x07, 0xe6, 0x47, 0x39, 0xe6, 0x44, 0x9b, 0x65, 0x10, 0xd8, 0x35, 0x10, 0xf9,
-		0x5e, 0x5f, 0xf6, 0x81, 0xf9, 0x4b, 0x1c, 0x13, 0x71, 0x5e, 0xd1, 0xa5, 0x04, 0xf1, 0x09, 0x18,
-		0xbb, 0x86, 0xed, 0x2d, 0xd3, 0xe1, 0xbb, 0x44, 0x7d, 0xc0, 0x7d, 0x8c, 0xc3, 0x8d, 0x72, 0x46,
-		0xba, 0x6d, 0x44, 0xb0, 0x2e, 0x40, 0x7a, 0x5b, 0x51, 0x71, 0x1f, 0x10, 0x37, 0x39, 0xc4, 0x20,
-		0xa1, 0x27, 0xac, 0xb3, 0x90, 0xad, 0x9b, 0x7c, 0x8e, 0x8a, 0x66, 0xff, 0x38, 0x67, 0x1f, 0x12,
-		0x3c, 0x1c, 0xc2, 0x32, 0xad, 0xa6, 0x4e, 0x26, 0xb0, 0x68, 0x88, 0x7f, 0x28, 0x20, 0x04, 0x0f,
-		0x87, 0xd8, 0x87, 0x5a, 0x5f, 0x16, 0x10, 0x4e, 0x40, 0x9f, 0x8f, 0xc3, 0x90, 0x69, 0xe8, 0x7b,
-		0xa6, 0xd1, 0x8f, 0x10, 0x9f, 0xe0, 0x08, 0xc0, 0x59, 0x08, 0xc0, 0x45, 0xc8, 0xf4, 0xdb, 0x11,
-		0xff, 0xe8, 0x07, 0x62, 0x78, 0x88, 0x1e, 0x58, 0x80, 0x51, 0xe1, 0xa0, 0x34, 0xd3, 0xe8, 0x03,
-		0xe2, 0x1f, 0x73, 0x88, 0x91, 0x00, 0x1b, 0x6f, 0x86, 0x8b, 0x1d, 0xb7, 0x8e, 0xfb, 0x01, 0xf9,
-		0xb4, 0x68, 0x06, 0x67, 0xe1, 0xaa, 0xdc, 0xc2, 0x86, 0xba, 0xd3, 0x1f, 0xc2, 0xaf, 0x08, 0x55,
-		0x0a, 0x1e, 0x02, 0x31, 0x07, 0xc3, 0x0d, 0xc5, 0x76, 0x76, 0x14, 0xbd, 0xaf, 0xee, 0xf8, 0x27,
-		0x1c, 0x23, 0xeb, 0x31, 0x71, 0x8d, 0x34, 0x8d, 0xfd, 0xc0, 0x7c, 0x46, 0x68, 0x24, 0xc0, 0xc6,
-		0x87, 0x9e, 0xe3, 0xd2, 0x2d, 0xb5, 0xfd, 0xa0, 0xfd, 0xaa, 0x18, 0x7a, 0x8c, 0x77, 0x39, 0x88,
-		0x78, 0x11, 0x32, 0x8e, 0x76, 0xa3, 0x2f, 0x98, 0xcf, 0x8a, 0x9e, 0xa6, 0x0c, 0x84, 0xf9, 0x19,
-		0x38, 0xd6, 0x71, 0x9a, 0xe8, 0x03, 0xec, 0xd7, 0x38, 0xd8, 0xd1, 0x0e, 0x53, 0x05, 0x77, 0x09,
-		0xfb, 0x85, 0xfc, 0xa7, 0xc2, 0x25, 0xe0, 0x16, 0xac, 0x35, 0xb2, 0x6a, 0x70, 0x94, 0xed, 0xfd,
-		0x69, 0xed, 0x9f, 0x09, 0xad, 0x31, 0xde, 0x90, 0xd6, 0x36, 0xe0, 0x28, 0x47, 0xdc, 0x5f, 0xbf,
-		0x7e, 0x4e, 0x38, 0x56, 0xc6, 0xbd, 0x19, 0xee, 0xdd, 0x77, 0x41, 0xc1, 0x53, 0xa7, 0x08, 0x4f,
-		0x9d, 0x6a, 0x43, 0xb1, 0xfa, 0x40, 0xfe, 0x3c, 0x47, 0x16, 0x1e, 0xdf, 0x8b, 0x6f, 0x9d, 0x65,
-		0xc5, 0x22, 0xe0, 0x4f, 0x43, 0x5e, 0x80, 0x37, 0x0d, 0x1b, 0xab, 0x66, 0xdd, 0xd0, 0x6e, 0xe0,
-		0x5a, 0x1f, 0xd0, 0xbf, 0xde, 0xd2, 0x55, 0x9b, 0x01, 0x76, 0x82, 0xbc, 0x08, 0x39, 0x2f, 0x56,
-		0xa9, 0x6a, 0x0d, 0xcb, 0xb4, 0xdd, 0x08, 0xc4, 0x2f, 0x88, 0x9e, 0xf2, 0xf8, 0x16, 0x29, 0x5b,
-		0xa9, 0x02, 0xec, 0x9c, 0xb9, 0x5f, 0x93, 0x7c, 0x85, 0x03, 0x0d, 0xfb, 0x5c, 0xdc, 0x71, 0xa8,
-		0x66, 0xc3, 0x52, 0xec, 0x7e, 0xfc, 0xdf, 0x17, 0x85, 0xe3, 0xe0, 0x2c, 0xdc, 0x71, 0x90, 0x88,
-		0x8e, 0xcc, 0xf6, 0x7d, 0x20, 0x7c, 0x49, 0x38, 0x0e, 0xc1, 0xc3, 0x21, 0x44, 0xc0, 0xd0, 0x07,
-		0xc4, 0x3f, 0x17, 0x10, 0x82, 0x87, 0x40, 0x3c, 0xe9, 0x4f, 0xb4, 0x36, 0xae, 0x6b, 0x8e, 0x6b,
-		0xb3, 0xa0, 0xb8, 0x37, 0xd4, 0x6f, 0xfc, 0x20, 0x1c, 0x84, 0xc9, 0x01, 0x56, 0xe2, 0x89, 0xf8,
-		0x26, 0x2b, 0x5d, 0x33, 0x45, 0x0b, 0xf6, 0x9b, 0xc2, 0x13, 0x05, 0xd8, 0x88, 0x6c, 0x81, 0x08,
-		0x91, 0xa8, 0x5d, 0x25, 0x2b, 0x85, 0x3e, 0xe0, 0x7e, 0xab, 0x45, 0xb8, 0x75, 0xc1, 0x4b, 0x30,
-		0x03, 0xf1, 0x4f, 0xd3, 0xd8, 0xc5, 0x7b, 0x7d, 0x59, 0xe7, 0x6f, 0xb7, 0xc4, 0x3f, 0x9b, 0x8c,
-		0x93, 0xf9, 0x90, 0xd1, 0x96, 0x78, 0x0a, 0x45, 0xdd, 0x2a, 0xca, 0xbf, 0xef, 0x0d, 0xde, 0xde,
-		0x70, 0x38, 0x55, 0x5a, 0x22, 0x46, 0x1e, 0x0e, 0x7a, 0xa2, 0xc1, 0xde, 0xff, 0x86, 0x67, 0xe7,
-		0xa1, 0x98, 0xa7, 0x74, 0x09, 0x86, 0x43, 0x01, 0x4f, 0x34, 0xd4, 0x5f, 0xe7, 0x50, 0xd9, 0x60,
-		0xbc, 0x53, 0x3a, 0x03, 0x09, 0x12, 0xbc, 0x44, 0xb3, 0xff, 0x0d, 0xce, 0x4e, 0xc9, 0x4b, 0x6f,
-		0x87, 0xb4, 0x08, 0x5a, 0xa2, 0x59, 0x7f, 0x9e, 0xb3, 0x7a, 0x2c, 0x84, 0x5d, 0x04, 0x2c, 0xd1,
-		0xec, 0x7f, 0x53, 0xb0, 0x0b, 0x16, 0xc2, 0xde, 0xbf, 0x0a, 0xbf, 0xfc, 0xb7, 0x12, 0x7c, 0xd2,
-		0x11, 0xba, 0xbb, 0x08, 0x83, 0x3c, 0x52, 0x89, 0xe6, 0xfe, 0x00, 0xaf, 0x5c, 0x70, 0x94, 0xce,
-		0x41, 0xb2, 0x4f, 0x85, 0xff, 0x6d, 0xce, 0xca, 0xe8, 0x4b, 0x73, 0x30, 0x14, 0x88, 0x4e, 0xa2,
-		0xd9, 0xff, 0x0e, 0x67, 0x0f, 0x72, 0x11, 0xd1, 0x79, 0x74, 0x12, 0x0d, 0xf0, 0x77, 0x85, 0xe8,
-		0x9c, 0x83, 0xa8, 0x4d, 0x04, 0x26, 0xd1, 0xdc, 0x1f, 0x14, 0x5a, 0x17, 0x2c, 0xa5, 0xc7, 0x21,
-		0xe3, 0x4d, 0x36, 0xd1, 0xfc, 0xbf, 0xc0, 0xf9, 0x7d, 0x1e, 0xa2, 0x81, 0xc0, 0x64, 0x17, 0x0d,
-		0xf1, 0xf7, 0x84, 0x06, 0x02, 0x5c, 0x64, 0x18, 0xb5, 0x06, 0x30, 0xd1, 0x48, 0x1f, 0x12, 0xc3,
-		0xa8, 0x25, 0x7e, 0x21, 0xbd, 0x49, 0x7d, 0x7e, 0x34, 0xc4, 0xdf, 0x17, 0xbd, 0x49, 0xe9, 0x89,
-		0x18, 0xad, 0x11, 0x41, 0x34, 0xc6, 0x2f, 0x0a, 0x31, 0x5a, 0x02, 0x82, 0xd2, 0x1a, 0xa0, 0xf6,
-		0x68, 0x20, 0x1a, 0xef, 0xc3, 0x1c, 0x6f, 0xac, 0x2d, 0x18, 0x28, 0x3d, 0x05, 0x47, 0x3b, 0x47,
-		0x02, 0xd1, 0xa8, 0x1f, 0x79, 0xa3, 0x65, 0xed, 0x16, 0x0c, 0x04, 0x4a, 0x1b, 0xfe, 0x94, 0x12,
-		0x8c, 0x02, 0xa2, 0x61, 0x3f, 0xfa, 0x46, 0xd8, 0x71, 0x07, 0x83, 0x80, 0xd2, 0x2c, 0x80, 0x3f,
-		0x01, 0x47, 0x63, 0x7d, 0x8c, 0x63, 0x05, 0x98, 0xc8, 0xd0, 0xe0, 0xf3, 0x6f, 0x34, 0xff, 0x4d,
-		0x31, 0x34, 0x38, 0x07, 0x19, 0x1a, 0x62, 0xea, 0x8d, 0xe6, 0xfe, 0xb8, 0x18, 0x1a, 0x82, 0x85,
-		0x58, 0x76, 0x60, 0x76, 0x8b, 0x46, 0xf8, 0x84, 0xb0, 0xec, 0x00, 0x57, 0x69, 0x05, 0xc6, 0xda,
-		0x26, 0xc4, 0x68, 0xa8, 0x5f, 0xe6, 0x50, 0xb9, 0xd6, 0xf9, 0x30, 0x38, 0x79, 0xf1, 0xc9, 0x30,
-		0x1a, 0xed, 0x93, 0x2d, 0x93, 0x17, 0x9f, 0x0b, 0x4b, 0x17, 0x21, 0x6d, 0x34, 0x75, 0x9d, 0x0c,
-		0x1e, 0xd4, 0xfb, 0x26, 0x60, 0xfe, 0x3f, 0xff, 0x88, 0x6b, 0x47, 0x30, 0x94, 0xce, 0x40, 0x12,
-		0x37, 0xb6, 0x70, 0x2d, 0x8a, 0xf3, 0xf5, 0x1f, 0x09, 0x87, 0x49, 0xa8, 0x4b, 0x8f, 0x03, 0xb0,
-		0xad, 0x11, 0x7a, 0x18, 0x18, 0xc1, 0xfb, 0x5f, 0x7e, 0xc4, 0xaf, 0xde, 0xf8, 0x2c, 0x3e, 0x00,
-		0xbb, 0xc8, 0xd3, 0x1b, 0xe0, 0x07, 0x61, 0x00, 0xda, 0x23, 0x17, 0x60, 0xf0, 0x39, 0xc7, 0x34,
-		0x5c, 0xa5, 0x1e, 0xc5, 0xfd, 0x5f, 0x39, 0xb7, 0xa0, 0x27, 0x0a, 0x6b, 0x98, 0x36, 0x76, 0x95,
-		0xba, 0x13, 0xc5, 0xfb, 0xdf, 0x38, 0xaf, 0xc7, 0x40, 0x98, 0x55, 0xc5, 0x71, 0xfb, 0x69, 0xf7,
-		0x9f, 0x09, 0x66, 0xc1, 0x40, 0x84, 0x26, 0xbf, 0x77, 0xf1, 0x5e, 0x14, 0xef, 0x0f, 0x85, 0xd0,
-		0x9c, 0xbe, 0xf4, 0x76, 0xc8, 0x90, 0x9f, 0xec, 0x3e, 0x5d, 0x04, 0xf3, 0x7f, 0xe7, 0xcc, 0x3e,
-		0x07, 0xa9, 0xd9, 0x71, 0x6b, 0xae, 0x16, 0xad, 0xec, 0xff, 0xc1, 0x7b, 0x5a, 0xd0, 0x97, 0x66,
-		0x61, 0xc8, 0x71, 0x6b, 0xb5, 0x26, 0x8f, 0x4f, 0x23, 0xd8, 0xff, 0xe7, 0x8f, 0xbc, 0x2d, 0x0b,
-		0x8f, 0x87, 0xf4, 0xf6, 0xf3, 0xbb, 0xae, 0x65, 0xd2, 0x03, 0x8f, 0x28, 0x84, 0x37, 0x38, 0x42,
-		0x80, 0xa5, 0x34, 0x07, 0x59, 0xd2, 0x16, 0x1b, 0x5b, 0x98, 0x9e, 0x4e, 0x45, 0x40, 0xfc, 0x2f,
-		0xae, 0x80, 0x10, 0x53, 0xf9, 0x67, 0xbf, 0xfa, 0xda, 0xa4, 0xf4, 0x8d, 0xd7, 0x26, 0xa5, 0xef,
-		0xbc, 0x36, 0x29, 0x7d, 0xf0, 0xbb, 0x93, 0x03, 0xdf, 0xf8, 0xee, 0xe4, 0xc0, 0x1f, 0x7e, 0x77,
-		0x72, 0xa0, 0xf3, 0x2e, 0x31, 0x2c, 0x98, 0x0b, 0x26, 0xdb, 0x1f, 0x7e, 0xb6, 0x58, 0xd7, 0xdc,
-		0x9d, 0xe6, 0xd6, 0x8c, 0x6a, 0x36, 0xe8, 0x36, 0xae, 0xbf, 0x5b, 0xeb, 0x2d, 0x72, 0xe0, 0xf5,
-		0x18, 0x1c, 0x53, 0x4d, 0xa7, 0x61, 0x3a, 0x55, 0xb6, 0xdf, 0xcb, 0x12, 0x7c, 0xc7, 0x37, 0x1b,
-		0x2c, 0xea, 0x63, 0xd3, 0x77, 0x03, 0x26, 0xb4, 0x86, 0xa5, 0x63, 0xba, 0x39, 0x5f, 0xa5, 0x5a,
-		0xe8, 0x2f, 0x18, 0xfc, 0xda, 0xbf, 0x4f, 0xb2, 0x4d, 0x48, 0x9f, 0x7d, 0x51, 0x70, 0x97, 0x96,
-		0x60, 0x4c, 0x51, 0x55, 0x6c, 0x85, 0x20, 0x23, 0x94, 0x29, 0x00, 0x73, 0x9c, 0xd3, 0x47, 0x3b,
-		0x07, 0x29, 0x47, 0x55, 0x74, 0x25, 0xb2, 0x4b, 0xbf, 0xce, 0x21, 0x38, 0x79, 0xf9, 0x7c, 0xb7,
-		0x9e, 0x78, 0x76, 0x32, 0xa0, 0x68, 0xa6, 0x31, 0xfe, 0xe7, 0x21, 0x86, 0x9c, 0x62, 0x37, 0x95,
-		0xe1, 0x0f, 0xe2, 0x30, 0xc9, 0xcb, 0xb7, 0x14, 0x07, 0x9f, 0xba, 0xf6, 0xc8, 0x16, 0x76, 0x95,
-		0x47, 0x4e, 0xa9, 0xa6, 0x66, 0x70, 0x8d, 0x8f, 0x73, 0xfd, 0x93, 0xf2, 0x19, 0x5e, 0x5e, 0xe8,
-		0xb8, 0x1d, 0x5f, 0xe8, 0xde, 0x6f, 0xc5, 0x4d, 0x48, 0xcc, 0x99, 0x9a, 0x81, 0x26, 0x20, 0x59,
-		0xc3, 0x86, 0xd9, 0xe0, 0xd7, 0xee, 0x58, 0x02, 0x3d, 0x02, 0x29, 0xa5, 0x61, 0x36, 0x0d, 0x97,
-		0x1d, 0x52, 0x94, 0x8f, 0x7d, 0xf5, 0xd5, 0xa9, 0x81, 0x3f, 0x7a, 0x75, 0x2a, 0xbe, 0x68, 0xb8,
-		0xbf, 0xff, 0xca, 0x43, 0xc0, 0xa1, 0x16, 0x0d, 0x57, 0xe6, 0x84, 0xa5, 0xc4, 0xf7, 0x5f, 0x9e,
-		0x92, 0x8a, 0x4f, 0xc3, 0xe0, 0x3c, 0x56, 0x0f, 0x82, 0x3c, 0x8f, 0xd5, 0x00, 0xf2, 0x3c, 0x56,
-		0x5b, 0x90, 0xcf, 0x41, 0x7a, 0xd1, 0x70, 0xd9, 0xa5, 0xc9, 0x07, 0x20, 0xae, 0x19, 0xec, 0x1e,
-		0x4e, 0x4f, 0xd9, 0x08, 0x15, 0x61, 0x9c, 0xc7, 0xaa, 0xc7, 0x58, 0xc3, 0x6a, 0x2b, 0x63, 0x7b,
-		0xd5, 0x84, 0xaa, 0x3c, 0xff, 0x87, 0xff, 0x69, 0x72, 0xe0, 0x85, 0xd7, 0x26, 0x07, 0xba, 0xf6,
-		0x6a, 0xb1, 0x6b, 0xaf, 0x3a, 0xb5, 0x5d, 0x76, 0xbc, 0xe2, 0xf5, 0xec, 0x9f, 0xa6, 0xa0, 0xc8,
-		0x69, 0x1c, 0x57, 0xd9, 0xd5, 0x8c, 0xba, 0xd7, 0xb9, 0x4a, 0xd3, 0xdd, 0xb9, 0xc1, 0x7b, 0xf7,
-		0x28, 0x97, 0x82, 0xd3, 0x1c, 0xb8, 0x83, 0x0b, 0x11, 0x66, 0x54, 0xfc, 0x93, 0x38, 0xa0, 0x75,
-		0x57, 0xd9, 0xc5, 0xb3, 0x4d, 0x77, 0xc7, 0xb4, 0xb5, 0x1b, 0xcc, 0x0d, 0x62, 0x80, 0x86, 0x72,
-		0xbd, 0xea, 0x9a, 0xbb, 0xd8, 0x70, 0xa8, 0xa2, 0x86, 0x4e, 0x1f, 0x9b, 0xe9, 0x60, 0x72, 0x33,
-		0xa4, 0x93, 0xcb, 0x0f, 0x7c, 0xe6, 0xdb, 0x53, 0xc7, 0xa3, 0xb5, 0x40, 0x89, 0x49, 0x5c, 0x7e,
-		0x7d, 0x83, 0x02, 0xa3, 0xab, 0xc0, 0xee, 0x67, 0x54, 0x75, 0xcd, 0x71, 0xf9, 0x15, 0xef, 0x33,
-		0x33, 0x9d, 0xdb, 0x3e, 0xd3, 0x2e, 0xe6, 0xcc, 0x55, 0x45, 0xd7, 0x6a, 0x8a, 0x6b, 0xda, 0xce,
-		0xe5, 0x01, 0x39, 0x43, 0xa1, 0x96, 0x34, 0xc7, 0x45, 0x1b, 0x90, 0xa9, 0x61, 0x63, 0x8f, 0xc1,
-		0xc6, 0xdf, 0x1c, 0x6c, 0x9a, 0x20, 0x51, 0xd4, 0xa7, 0x01, 0x29, 0x41, 0x3a, 0xf1, 0xa6, 0x89,
-		0x5d, 0xcd, 0xec, 0x02, 0x1f, 0x42, 0xa6, 0x4f, 0x30, 0xc6, 0x94, 0xd6, 0xac, 0xc2, 0x3b, 0x01,
-		0xfc, 0x3a, 0xd1, 0x69, 0x18, 0x54, 0x6a, 0x35, 0x1b, 0x3b, 0x0e, 0x3d, 0x3b, 0xcc, 0x94, 0xf3,
-		0xbf, 0xff, 0xca, 0x43, 0x13, 0x1c, 0x7f, 0x96, 0x95, 0xb0, 0xe5, 0xb8, 0x2c, 0x08, 0x4b, 0x63,
-		0x5f, 0x7f, 0xe5, 0xa1, 0xe1, 0x50, 0x5d, 0xe5, 0x2c, 0xc0, 0x35, 0x0f, 0xf4, 0xe4, 0xc7, 0x25,
-		0x18, 0x6b, 0x93, 0x05, 0x15, 0x61, 0x72, 0x76, 0x73, 0xe3, 0xf2, 0xaa, 0xbc, 0xf8, 0xec, 0xec,
-		0xc6, 0xe2, 0xea, 0x4a, 0x95, 0xbd, 0x23, 0x58, 0x59, 0x5f, 0xab, 0xcc, 0x2d, 0x5e, 0x5a, 0xac,
-		0xcc, 0xe7, 0x06, 0xd0, 0x14, 0xdc, 0xde, 0x81, 0x66, 0xbe, 0xb2, 0x54, 0x59, 0x98, 0xdd, 0xa8,
-		0xe4, 0x24, 0x74, 0x17, 0xdc, 0xd9, 0x11, 0xc4, 0x23, 0x89, 0x75, 0x21, 0x91, 0x2b, 0x1e, 0x49,
-		0xbc, 0x7c, 0xa9, 0xeb, 0xf8, 0x7a, 0xb0, 0xa7, 0x65, 0x5d, 0xf7, 0x06, 0x52, 0x78, 0xa4, 0xbd,
-		0x2f, 0x06, 0xc7, 0x5a, 0xe7, 0x21, 0xc5, 0xd8, 0xeb, 0xf2, 0x94, 0xb4, 0xf3, 0xc8, 0x2a, 0x5e,
-		0x86, 0xf8, 0xac, 0xb1, 0x87, 0x8e, 0xb1, 0x20, 0xbd, 0xda, 0xb4, 0x75, 0xee, 0xc7, 0x06, 0x49,
-		0x7a, 0xd3, 0xd6, 0x89, 0x7f, 0x13, 0xaf, 0x07, 0xa4, 0x13, 0x59, 0xfe, 0x24, 0xa0, 0x94, 0xfb,
-		0xf0, 0xcb, 0x53, 0x03, 0x9f, 0x7b, 0x79, 0x6a, 0xe0, 0x87, 0x9f, 0x98, 0x1a, 0x78, 0xe1, 0x8f,
-		0xa7, 0x07, 0xca, 0xbb, 0xad, 0xcd, 0xfb, 0x72, 0xe4, 0x14, 0x9d, 0x9e, 0x35, 0xf6, 0xa8, 0xc3,
-		0x5a, 0x93, 0x9e, 0x4d, 0xd2, 0xc6, 0x89, 0x53, 0xd9, 0xc9, 0xd6, 0x53, 0xd9, 0xa7, 0xb0, 0xae,
-		0x5f, 0x31, 0xcc, 0xe7, 0x69, 0xaf, 0xfa, 0x3a, 0xf8, 0x50, 0x0c, 0x26, 0xdb, 0xe6, 0x62, 0x1e,
-		0xb6, 0x74, 0x7b, 0x53, 0x5b, 0x82, 0xf4, 0xbc, 0x88, 0x86, 0xf2, 0x30, 0xe8, 0x60, 0xd5, 0x34,
-		0x6a, 0xcc, 0x07, 0xc4, 0x65, 0x91, 0x24, 0xcd, 0x36, 0x14, 0xc3, 0x74, 0xf8, 0x45, 0x7e, 0x96,
-		0x28, 0xff, 0x92, 0xb4, 0xbf, 0x20, 0x64, 0x58, 0xd4, 0x24, 0x9a, 0xf9, 0x48, 0xe4, 0x39, 0xf5,
-		0x2e, 0x69, 0xa5, 0xd7, 0x88, 0xd0, 0x59, 0x75, 0xbf, 0x5a, 0xf9, 0xc5, 0x18, 0x4c, 0xb5, 0x6a,
-		0x85, 0xc4, 0x82, 0x8e, 0xab, 0x34, 0xac, 0x6e, 0x6a, 0xb9, 0x08, 0x99, 0x0d, 0x41, 0xb3, 0x6f,
-		0xbd, 0xdc, 0xdc, 0xa7, 0x5e, 0x46, 0xbc, 0xaa, 0x84, 0x62, 0x4e, 0xf7, 0xa9, 0x18, 0xaf, 0x1d,
-		0x07, 0xd2, 0xcc, 0x67, 0x12, 0x70, 0x27, 0x7d, 0xe9, 0x65, 0x37, 0x34, 0xc3, 0x3d, 0xa5, 0xda,
-		0x7b, 0x96, 0x4b, 0xa3, 0x41, 0x73, 0x9b, 0xeb, 0x65, 0xcc, 0x2f, 0x9e, 0x61, 0xc5, 0x5d, 0x46,
-		0xce, 0x36, 0x24, 0xd7, 0x08, 0x1f, 0xd1, 0x88, 0x6b, 0xba, 0x8a, 0xce, 0x35, 0xc5, 0x12, 0x24,
-		0x97, 0xbd, 0x0e, 0x8b, 0xb1, 0x5c, 0x4d, 0x3c, 0x0c, 0xd3, 0xb1, 0xb2, 0xcd, 0x2e, 0xd9, 0xc7,
-		0xe9, 0x80, 0x4a, 0x93, 0x0c, 0x7a, 0x9f, 0x7e, 0x02, 0x92, 0x4a, 0x93, 0xdd, 0x0f, 0x89, 0x93,
-		0x91, 0x46, 0x13, 0xc5, 0x2b, 0x30, 0xc8, 0x4f, 0xa9, 0x51, 0x0e, 0xe2, 0xbb, 0x78, 0x8f, 0xd6,
-		0x93, 0x95, 0xc9, 0x4f, 0x34, 0x03, 0x49, 0x2a, 0x3c, 0x9f, 0x5a, 0xf2, 0x33, 0x6d, 0xd2, 0xcf,
-		0x50, 0x21, 0x65, 0x46, 0x56, 0x7c, 0x02, 0xd2, 0xf3, 0x66, 0x43, 0x33, 0xcc, 0x30, 0x5a, 0x86,
-		0xa1, 0x51, 0x99, 0xad, 0x26, 0x8f, 0x59, 0x64, 0x96, 0x40, 0x47, 0x21, 0xc5, 0x1e, 0x5d, 0xf0,
-		0x3b, 0x2e, 0x3c, 0x55, 0x9c, 0x83, 0x41, 0x8a, 0xbd, 0x6a, 0x21, 0xc4, 0x9f, 0xeb, 0xf1, 0xd7,
-		0x1d, 0x74, 0x75, 0xc5, 0xe1, 0x63, 0xbe, 0xb0, 0x08, 0x12, 0x35, 0xc5, 0x55, 0x78, 0xbb, 0xe9,
-		0xef, 0xe2, 0x3b, 0x20, 0xcd, 0x41, 0xc8, 0xb4, 0x10, 0x37, 0x2d, 0x87, 0xdf, 0x52, 0x29, 0x74,
-		0x6b, 0xca, 0xaa, 0x55, 0x4e, 0x90, 0x88, 0x46, 0x26, 0xc4, 0x65, 0xb9, 0xab, 0x53, 0x3d, 0x1f,
-		0x70, 0xaa, 0x81, 0x2e, 0x0f, 0xfc, 0x64, 0x5d, 0xda, 0x66, 0x0e, 0x9e, 0xb1, 0x7c, 0x22, 0x06,
-		0x93, 0x81, 0xd2, 0x6b, 0xd8, 0x76, 0x34, 0xd3, 0xe0, 0x33, 0x3d, 0xb3, 0x16, 0x14, 0x10, 0x92,
-		0x97, 0x77, 0x31, 0x97, 0xb7, 0x43, 0x7c, 0xd6, 0xb2, 0x50, 0x01, 0xd2, 0x34, 0xad, 0x9a, 0xcc,
-		0x5e, 0x12, 0xb2, 0x97, 0x26, 0x65, 0x8e, 0xb9, 0xed, 0x3e, 0xaf, 0xd8, 0xde, 0xbb, 0x44, 0x91,
-		0x2e, 0x5e, 0x80, 0xcc, 0x9c, 0x69, 0x38, 0xd8, 0x70, 0x9a, 0x74, 0x0c, 0x6e, 0xe9, 0xa6, 0xba,
-		0xcb, 0x11, 0x58, 0x82, 0x28, 0x5c, 0xb1, 0x2c, 0xca, 0x99, 0x90, 0xc9, 0x4f, 0x16, 0x51, 0x96,
-		0xd7, 0xbb, 0xaa, 0xe8, 0xc2, 0xfe, 0x55, 0xc4, 0x1b, 0xe9, 0xe9, 0xe8, 0xc7, 0x12, 0xdc, 0xd1,
-		0x3e, 0xa0, 0x76, 0xf1, 0x9e, 0xb3, 0xdf, 0xf1, 0xf4, 0x34, 0x64, 0xd6, 0xe8, 0xc7, 0x01, 0xae,
-		0xe0, 0x3d, 0x54, 0x80, 0x41, 0x5c, 0x3b, 0x7d, 0xe6, 0xcc, 0x23, 0x17, 0x98, 0xb5, 0x5f, 0x1e,
-		0x90, 0x45, 0x06, 0x9a, 0x84, 0x8c, 0x83, 0x55, 0xeb, 0xf4, 0x99, 0xb3, 0xbb, 0x8f, 0x30, 0xf3,
-		0x22, 0xb1, 0x91, 0x97, 0x55, 0x4a, 0x93, 0x56, 0x7f, 0xff, 0x13, 0x53, 0x52, 0x39, 0x09, 0x71,
-		0xa7, 0xd9, 0xb8, 0xa5, 0x36, 0xf2, 0xd1, 0x24, 0x4c, 0x07, 0x39, 0xa9, 0xa7, 0xf2, 0xa2, 0x12,
-		0xae, 0x83, 0x5c, 0x40, 0x07, 0x94, 0xa2, 0x4b, 0x98, 0xdb, 0x53, 0x93, 0xc5, 0x5f, 0x97, 0x20,
-		0xeb, 0x05, 0x51, 0xeb, 0xd8, 0x45, 0x17, 0x83, 0xf1, 0x0f, 0x1f, 0x36, 0xb7, 0xcf, 0xb4, 0xd6,
-		0xe5, 0x07, 0x7b, 0x72, 0x80, 0x1c, 0x9d, 0xa3, 0x86, 0x68, 0x99, 0x0e, 0x7f, 0xab, 0x16, 0xc1,
-		0xea, 0x11, 0xa3, 0x07, 0x01, 0x51, 0x0f, 0x57, 0xbd, 0x66, 0xba, 0x9a, 0x51, 0xaf, 0x5a, 0xe6,
-		0xf3, 0xfc, 0x05, 0x70, 0x5c, 0xce, 0xd1, 0x92, 0xab, 0xb4, 0x60, 0x8d, 0xe4, 0x13, 0xa1, 0x33,
-		0x1e, 0x0a, 0x99, 0x56, 0xfc, 0xc0, 0x8f, 0x38, 0x01, 0x91, 0x44, 0x17, 0x61, 0xd0, 0x6a, 0x6e,
-		0x55, 0x85, 0xc7, 0x18, 0x3a, 0x7d, 0x47, 0xa7, 0xf1, 0x2f, 0xec, 0x83, 0x7b, 0x80, 0x94, 0xd5,
-		0xdc, 0x22, 0xd6, 0x72, 0x17, 0x64, 0x3b, 0x08, 0x33, 0x74, 0xcd, 0x97, 0x83, 0x7e, 0x93, 0x82,
-		0xb7, 0xa0, 0x6a, 0xd9, 0x9a, 0x69, 0x6b, 0xee, 0x1e, 0x8d, 0x6c, 0xe3, 0x72, 0x4e, 0x14, 0xac,
-		0xf1, 0xfc, 0xe2, 0x2e, 0x8c, 0xae, 0xd3, 0xe5, 0xb7, 0x2f, 0xf9, 0x19, 0x5f, 0x3e, 0x29, 0x5a,
-		0xbe, 0xae, 0x92, 0xc5, 0xda, 0x24, 0x2b, 0x3f, 0xd9, 0xd5, 0x3a, 0xcf, 0xed, 0xdf, 0x3a, 0xc3,
-		0x11, 0xe2, 0x9f, 0x1d, 0x0b, 0x0d, 0x4e, 0x66, 0x9c, 0x41, 0xf7, 0xd5, 0xaf, 0x61, 0x46, 0x45,
-		0x13, 0x85, 0xde, 0x93, 0x6a, 0x21, 0xc2, 0x8d, 0x16, 0x22, 0x87, 0x50, 0xf1, 0x02, 0x0c, 0xaf,
-		0x29, 0xb6, 0xbb, 0x8e, 0xdd, 0xcb, 0x58, 0xa9, 0x61, 0x3b, 0x3c, 0xeb, 0x0e, 0x8b, 0x59, 0x17,
-		0x41, 0x82, 0x4e, 0xad, 0x6c, 0xd6, 0xa1, 0xbf, 0x8b, 0x3b, 0x90, 0xa0, 0xd7, 0x4d, 0xbd, 0x19,
-		0x99, 0x73, 0xb0, 0x19, 0x99, 0xf8, 0xd2, 0x3d, 0x17, 0x3b, 0x22, 0xbc, 0xa5, 0x09, 0xf4, 0x98,
-		0x98, 0x57, 0xe3, 0xbd, 0xe7, 0x55, 0x6e, 0x88, 0x7c, 0x76, 0xd5, 0x61, 0xb0, 0x4c, 0x5c, 0xf1,
-		0xe2, 0xbc, 0x27, 0x88, 0xe4, 0x0b, 0x82, 0x96, 0x61, 0xd4, 0x52, 0x6c, 0x97, 0xbe, 0xb3, 0xd9,
-		0xa1, 0xad, 0xe0, 0xb6, 0x3e, 0xd5, 0x3e, 0xf2, 0x42, 0x8d, 0xe5, 0xb5, 0x0c, 0x5b, 0xc1, 0xcc,
-		0xe2, 0x9f, 0x24, 0x20, 0xc5, 0x95, 0xf1, 0x76, 0x18, 0xe4, 0x6a, 0xe5, 0xd6, 0x79, 0xe7, 0x4c,
-		0xfb, 0xc4, 0x34, 0xe3, 0x4d, 0x20, 0x1c, 0x4f, 0xf0, 0xa0, 0xfb, 0x20, 0xad, 0xee, 0x28, 0x9a,
-		0x51, 0xd5, 0x6a, 0x7c, 0xbb, 0x62, 0xe8, 0xb5, 0x57, 0xa7, 0x06, 0xe7, 0x48, 0xde, 0xe2, 0xbc,
-		0x3c, 0x48, 0x0b, 0x17, 0x6b, 0x24, 0x12, 0xd8, 0xc1, 0x5a, 0x7d, 0xc7, 0xe5, 0x23, 0x8c, 0xa7,
-		0xd0, 0x79, 0x48, 0x10, 0x83, 0xe0, 0xaf, 0x30, 0x0b, 0x6d, 0x9b, 0x49, 0x5e, 0xb0, 0x57, 0x4e,
-		0x93, 0x8a, 0x3f, 0xf8, 0xed, 0x29, 0x49, 0xa6, 0x1c, 0x68, 0x0e, 0x86, 0x75, 0xc5, 0x71, 0xab,
-		0x74, 0x06, 0x23, 0xd5, 0x27, 0xf9, 0x4a, 0xbc, 0x4d, 0x21, 0x5c, 0xb1, 0x5c, 0xf4, 0x21, 0xc2,
-		0xc5, 0xb2, 0x6a, 0xe8, 0x04, 0xe4, 0x28, 0x88, 0x6a, 0x36, 0x1a, 0x9a, 0xcb, 0x62, 0xab, 0x14,
-		0xd5, 0xfb, 0x08, 0xc9, 0x9f, 0xa3, 0xd9, 0x34, 0xc2, 0xba, 0x1d, 0x32, 0xf4, 0xdd, 0x17, 0x25,
-		0x61, 0x77, 0x9c, 0xd3, 0x24, 0x83, 0x16, 0x1e, 0x87, 0x51, 0xdf, 0x3f, 0x32, 0x92, 0x34, 0x43,
-		0xf1, 0xb3, 0x29, 0xe1, 0xc3, 0x30, 0x61, 0xe0, 0xeb, 0xf4, 0xd6, 0x75, 0x88, 0x3a, 0x43, 0xa9,
-		0x11, 0x29, 0xbb, 0x1a, 0xe6, 0xb8, 0x17, 0x46, 0x54, 0xa1, 0x7c, 0x46, 0x0b, 0x94, 0x76, 0xd8,
-		0xcb, 0xa5, 0x64, 0xc7, 0x20, 0xad, 0x58, 0x16, 0x23, 0x18, 0xe2, 0xfe, 0xd1, 0xb2, 0x68, 0xd1,
-		0x49, 0x18, 0xa3, 0x6d, 0xb4, 0xb1, 0xd3, 0xd4, 0x5d, 0x0e, 0x92, 0xa5, 0x34, 0xa3, 0xa4, 0x40,
-		0x66, 0xf9, 0x94, 0xf6, 0x6e, 0x18, 0xc6, 0xd7, 0xb4, 0x1a, 0x36, 0x54, 0xcc, 0xe8, 0x86, 0x29,
-		0x5d, 0x56, 0x64, 0x52, 0xa2, 0xfb, 0xc1, 0xf3, 0x7b, 0x55, 0xe1, 0x93, 0x47, 0x18, 0x9e, 0xc8,
-		0xe7, 0x2b, 0xf1, 0x62, 0x1e, 0x12, 0xf3, 0x8a, 0xab, 0x90, 0x00, 0xc3, 0xbd, 0xce, 0x26, 0x9a,
-		0xac, 0x4c, 0x7e, 0x16, 0xbf, 0x1f, 0x83, 0xc4, 0x55, 0xd3, 0xc5, 0xe8, 0xd1, 0x40, 0x00, 0x38,
-		0xd2, 0xc9, 0x9e, 0xd7, 0xb5, 0xba, 0x81, 0x6b, 0xcb, 0x4e, 0x3d, 0xf0, 0x91, 0x06, 0xdf, 0x9c,
-		0x62, 0x21, 0x73, 0x9a, 0x80, 0xa4, 0x6d, 0x36, 0x8d, 0x9a, 0xb8, 0x1e, 0x4c, 0x13, 0xa8, 0x02,
-		0x69, 0xcf, 0x4a, 0x12, 0x51, 0x56, 0x32, 0x4a, 0xac, 0x84, 0xd8, 0x30, 0xcf, 0x90, 0x07, 0xb7,
-		0xb8, 0xb1, 0x94, 0x21, 0xe3, 0x39, 0x2f, 0x6e, 0x6d, 0xfd, 0x19, 0xac, 0xcf, 0x46, 0x26, 0x13,
-		0xaf, 0xef, 0x3d, 0xe5, 0x31, 0x8b, 0xcb, 0x79, 0x05, 0x5c, 0x7b, 0x21, 0xb3, 0xe2, 0x1f, 0x8c,
-		0x18, 0xa4, 0xed, 0xf2, 0xcd, 0x8a, 0x7d, 0x34, 0xe2, 0x0e, 0xc8, 0x38, 0x5a, 0xdd, 0x50, 0xdc,
-		0xa6, 0x8d, 0xb9, 0xe5, 0xf9, 0x19, 0xc5, 0x2f, 0x4b, 0x90, 0x62, 0x96, 0x1c, 0xd0, 0x9b, 0xd4,
-		0x59, 0x6f, 0xb1, 0x6e, 0x7a, 0x8b, 0x1f, 0x5c, 0x6f, 0xb3, 0x00, 0x9e, 0x30, 0x0e, 0x7f, 0xc7,
-		0xdf, 0x21, 0x62, 0x60, 0x22, 0xae, 0x6b, 0x75, 0x3e, 0x50, 0x03, 0x4c, 0xc5, 0xff, 0x28, 0x91,
-		0x20, 0x96, 0x97, 0xa3, 0x59, 0x18, 0x16, 0x72, 0x55, 0xb7, 0x75, 0xa5, 0xce, 0x6d, 0xe7, 0xce,
-		0xae, 0xc2, 0x5d, 0xd2, 0x95, 0xba, 0x3c, 0xc4, 0xe5, 0x21, 0x89, 0xce, 0xfd, 0x10, 0xeb, 0xd2,
-		0x0f, 0xa1, 0x8e, 0x8f, 0x1f, 0xac, 0xe3, 0x43, 0x5d, 0x94, 0x68, 0xed, 0xa2, 0x2f, 0xc4, 0xe8,
-		0x62, 0xc6, 0x32, 0x1d, 0x45, 0xff, 0x49, 0x8c, 0x88, 0xdb, 0x21, 0x63, 0x99, 0x7a, 0x95, 0x95,
-		0xb0, 0x6b, 0xf3, 0x69, 0xcb, 0xd4, 0xe5, 0xb6, 0x6e, 0x4f, 0x1e, 0xd2, 0x70, 0x49, 0x1d, 0x82,
-		0xd6, 0x06, 0x5b, 0xb5, 0x66, 0x43, 0x96, 0xa9, 0x82, 0xcf, 0x65, 0x0f, 0x13, 0x1d, 0xd0, 0xc9,
-		0x51, 0x6a, 0x9f, 0x7b, 0x99, 0xd8, 0x8c, 0x52, 0xe6, 0x74, 0x84, 0x83, 0xb9, 0xfe, 0x4e, 0xab,
-		0xe0, 0xa0, 0x59, 0xca, 0x9c, 0xae, 0xf8, 0x0f, 0x24, 0x80, 0x25, 0xa2, 0x59, 0xda, 0x5e, 0x32,
-		0x0b, 0x39, 0x54, 0x84, 0x6a, 0xa8, 0xe6, 0xc9, 0x6e, 0x9d, 0xc6, 0xeb, 0xcf, 0x3a, 0x41, 0xb9,
-		0xe7, 0x60, 0xd8, 0x37, 0x46, 0x07, 0x0b, 0x61, 0x26, 0x7b, 0x44, 0xd5, 0xeb, 0xd8, 0x95, 0xb3,
-		0xd7, 0x02, 0xa9, 0xe2, 0xbf, 0x92, 0x20, 0x43, 0x65, 0x5a, 0xc6, 0xae, 0x12, 0xea, 0x43, 0xe9,
-		0xe0, 0x7d, 0x78, 0x27, 0x00, 0x83, 0x71, 0xb4, 0x1b, 0x98, 0x5b, 0x56, 0x86, 0xe6, 0xac, 0x6b,
-		0x37, 0x30, 0x3a, 0xeb, 0x29, 0x3c, 0xde, 0x5b, 0xe1, 0x22, 0xea, 0xe6, 0x6a, 0xbf, 0x0d, 0x06,
-		0xe9, 0x77, 0xaf, 0xae, 0x3b, 0x3c, 0x90, 0x4e, 0x19, 0xcd, 0xc6, 0xc6, 0x75, 0xa7, 0xf8, 0x1c,
-		0x0c, 0x6e, 0x5c, 0x67, 0x7b, 0x23, 0xb7, 0x43, 0xc6, 0x36, 0x4d, 0x3e, 0x27, 0xb3, 0x58, 0x28,
-		0x4d, 0x32, 0xe8, 0x14, 0x24, 0xf6, 0x03, 0x62, 0xfe, 0x7e, 0x80, 0xbf, 0xa1, 0x11, 0xef, 0x6b,
-		0x43, 0xe3, 0xe4, 0x1f, 0x48, 0x30, 0x14, 0xf0, 0x0f, 0xe8, 0x11, 0x38, 0x52, 0x5e, 0x5a, 0x9d,
-		0xbb, 0x52, 0x5d, 0x9c, 0xaf, 0x5e, 0x5a, 0x9a, 0x5d, 0xf0, 0x1f, 0x86, 0x15, 0x8e, 0xbe, 0x74,
-		0x73, 0x1a, 0x05, 0x68, 0x37, 0x0d, 0xba, 0xa3, 0x84, 0x4e, 0xc1, 0x44, 0x98, 0x65, 0xb6, 0xbc,
-		0x5e, 0x59, 0xd9, 0xc8, 0x49, 0x85, 0x23, 0x2f, 0xdd, 0x9c, 0x1e, 0x0b, 0x70, 0xcc, 0x6e, 0x39,
-		0xd8, 0x70, 0xdb, 0x19, 0xe6, 0x56, 0x97, 0x97, 0x17, 0x37, 0x72, 0xb1, 0x36, 0x06, 0xee, 0xb0,
-		0xef, 0x87, 0xb1, 0x30, 0xc3, 0xca, 0xe2, 0x52, 0x2e, 0x5e, 0x40, 0x2f, 0xdd, 0x9c, 0x1e, 0x09,
-		0x50, 0xaf, 0x68, 0x7a, 0x21, 0xfd, 0xe2, 0x27, 0x27, 0x07, 0x7e, 0xe5, 0x53, 0x93, 0x12, 0x69,
-		0xd9, 0x70, 0xc8, 0x47, 0xa0, 0x07, 0xe1, 0xb6, 0xf5, 0xc5, 0x85, 0x95, 0xca, 0x7c, 0x75, 0x79,
-		0x7d, 0x41, 0xec, 0x41, 0x8b, 0xd6, 0x8d, 0xbe, 0x74, 0x73, 0x7a, 0x88, 0x37, 0xa9, 0x1b, 0xf5,
-		0x9a, 0x5c, 0xb9, 0xba, 0xba, 0x51, 0xc9, 0x49, 0x8c, 0x7a, 0xcd, 0xc6, 0xd7, 0x4c, 0x97, 0x7d,
-		0x18, 0xef, 0x61, 0x38, 0xd6, 0x81, 0xda, 0x6b, 0xd8, 0xd8, 0x4b, 0x37, 0xa7, 0x87, 0xd7, 0x6c,
-		0xcc, 0xc6, 0x0f, 0xe5, 0x98, 0x81, 0x7c, 0x3b, 0xc7, 0xea, 0xda, 0xea, 0xfa, 0xec, 0x52, 0x6e,
-		0xba, 0x90, 0x7b, 0xe9, 0xe6, 0x74, 0x56, 0x38, 0x43, 0x7a, 0x04, 0xe0, 0xb5, 0xec, 0x56, 0xae,
-		0x78, 0x5e, 0x3f, 0x09, 0xf7, 0x74, 0x39, 0x7d, 0x12, 0xe7, 0x16, 0x07, 0x3a, 0x7f, 0xea, 0xba,
-		0xcf, 0x5e, 0x88, 0xd8, 0x7e, 0x8e, 0x5e, 0x3a, 0x1d, 0xfc, 0x6c, 0xab, 0xd0, 0x73, 0x71, 0x57,
-		0xfc, 0x80, 0x04, 0x23, 0x97, 0x35, 0xc7, 0x35, 0x6d, 0x4d, 0x55, 0x74, 0xfa, 0x1c, 0xec, 0x6c,
-		0xbf, 0xbe, 0xb5, 0x65, 0xa8, 0x3f, 0x0e, 0xa9, 0x6b, 0x8a, 0xce, 0x9c, 0x5a, 0x9c, 0x7e, 0xbd,
-		0xa6, 0xcb, 0x61, 0x90, 0xe7, 0xda, 0x04, 0x00, 0x63, 0x2b, 0x7e, 0x36, 0x06, 0xa3, 0x74, 0x30,
-		0x38, 0xec, 0xbb, 0x66, 0x64, 0x8d, 0xb5, 0x06, 0x09, 0x5b, 0x71, 0xf9, 0xa6, 0x61, 0xf9, 0x6d,
-		0xfc, 0x94, 0xf2, 0xbe, 0x3e, 0x4e, 0xd9, 0xda, 0x0f, 0x32, 0x29, 0x12, 0x7a, 0x0a, 0xd2, 0x0d,
-		0xe5, 0x7a, 0x95, 0xa2, 0xc6, 0x0e, 0x01, 0x75, 0xb0, 0xa1, 0x5c, 0x27, 0xb2, 0xa2, 0x1a, 0x8c,
-		0x12, 0x60, 0x75, 0x47, 0x31, 0xea, 0x98, 0xe1, 0xc7, 0x0f, 0x01, 0x7f, 0xb8, 0xa1, 0x5c, 0x9f,
-		0xa3, 0x98, 0xa4, 0x96, 0x52, 0xfa, 0xc3, 0x2f, 0x4f, 0x0d, 0xd0, 0x43, 0xe0, 0xdf, 0x96, 0x00,
-		0x7c, 0x75, 0xa1, 0x9f, 0x81, 0x9c, 0xea, 0xa5, 0x68, 0xf5, 0xe2, 0xc8, 0xf2, 0x78, 0xb7, 0x8e,
-		0x68, 0x51, 0x36, 0x9b, 0x98, 0xbf, 0xf1, 0xea, 0x94, 0x24, 0x8f, 0xaa, 0x2d, 0xfd, 0x50, 0x81,
-		0xa1, 0xa6, 0x55, 0x53, 0x5c, 0x5c, 0xa5, 0x8b, 0xb8, 0xd8, 0x3e, 0x26, 0x79, 0x60, 0x8c, 0xa4,
-		0x28, 0x20, 0xfd, 0x67, 0x25, 0x18, 0x9a, 0x0f, 0xdc, 0xc7, 0xcc, 0xc3, 0x60, 0xc3, 0x34, 0xb4,
-		0x5d, 0x6e, 0x76, 0x19, 0x59, 0x24, 0x51, 0x01, 0xd2, 0xec, 0x21, 0xac, 0xbb, 0x27, 0x76, 0x3c,
-		0x45, 0x9a, 0x70, 0x3d, 0x8f, 0xb7, 0x1c, 0x4d, 0xe8, 0x5a, 0x16, 0x49, 0xb2, 0x74, 0x71, 0xb0,
-		0xda, 0xb4, 0x35, 0x77, 0xaf, 0xaa, 0x9a, 0x86, 0xab, 0xa8, 0x2e, 0x7f, 0x52, 0x39, 0x2a, 0xf2,
-		0xe7, 0x58, 0x36, 0x01, 0xa9, 0x61, 0x57, 0xd1, 0x74, 0x27, 0xcf, 0xae, 0x30, 0x88, 0x64, 0x40,
-		0xdc, 0xaf, 0xa5, 0x82, 0x5b, 0x54, 0x73, 0x90, 0x33, 0x2d, 0x6c, 0x87, 0x42, 0x4a, 0x66, 0xa1,
-		0xdd, 0x0f, 0x29, 0x47, 0x05, 0x87, 0x88, 0x35, 0x9f, 0x21, 0x1d, 0x26, 0xd6, 0x7b, 0x56, 0x73,
-		0xcb, 0xdf, 0xd6, 0x9a, 0x68, 0xd3, 0xeb, 0xac, 0xb1, 0x57, 0xce, 0x7f, 0xdd, 0x87, 0xf6, 0xf7,
-		0x92, 0xae, 0xe0, 0x3d, 0xd2, 0x5b, 0x1c, 0x67, 0x8d, 0xc2, 0x90, 0x10, 0xf1, 0x39, 0x45, 0xd3,
-		0xc5, 0xfb, 0x7e, 0x99, 0xa7, 0x50, 0x09, 0x52, 0x8e, 0xab, 0xb8, 0x4d, 0x87, 0x9f, 0xd7, 0x16,
-		0xbb, 0x59, 0x46, 0xd9, 0x34, 0x6a, 0xeb, 0x94, 0x52, 0xe6, 0x1c, 0x68, 0x03, 0x52, 0xfc, 0x20,
-		0x3c, 0xb9, 0x6f, 0xab, 0xee, 0x70, 0x53, 0x82, 0x61, 0xa1, 0x3a, 0xe4, 0x6a, 0x58, 0xc7, 0x75,
-		0x16, 0x10, 0xed, 0x28, 0x64, 0xdd, 0x90, 0x3a, 0x84, 0x51, 0x33, 0xea, 0xa1, 0xae, 0x53, 0x50,
-		0x74, 0x25, 0x74, 0xfd, 0x97, 0x7f, 0xa2, 0xf2, 0xee, 0x6e, 0xed, 0x0f, 0x58, 0xa6, 0xd8, 0x4c,
-		0x08, 0x5e, 0x1e, 0xbe, 0x1f, 0x72, 0x4d, 0x63, 0xcb, 0x34, 0xe8, 0x2b, 0x5c, 0x1e, 0x8c, 0xa7,
-		0x69, 0x78, 0x33, 0xea, 0xe5, 0x5f, 0x66, 0x51, 0xf9, 0x15, 0x18, 0xf1, 0x49, 0xe9, 0xd8, 0xc9,
-		0xec, 0x63, 0xec, 0x0c, 0x7b, 0xbc, 0xa4, 0x14, 0x5d, 0x06, 0xf0, 0x07, 0x26, 0xdd, 0x1e, 0x18,
-		0xea, 0xde, 0x87, 0xfe, 0xe8, 0x16, 0xcb, 0x2c, 0x9f, 0x17, 0xe9, 0x30, 0xde, 0xd0, 0x8c, 0xaa,
-		0x83, 0xf5, 0xed, 0x2a, 0x57, 0x15, 0x81, 0x1c, 0x3a, 0x84, 0xae, 0x1d, 0x6b, 0x68, 0xc6, 0x3a,
-		0xd6, 0xb7, 0xe7, 0x3d, 0xd8, 0x52, 0xf6, 0xc5, 0x97, 0xa7, 0x06, 0xf8, 0x58, 0x1a, 0x28, 0xae,
-		0xd1, 0x2d, 0x6a, 0x3e, 0x0c, 0xb0, 0x83, 0xce, 0x42, 0x46, 0x11, 0x89, 0xc8, 0xb3, 0x7e, 0x9f,
-		0x94, 0x8d, 0xce, 0x17, 0xfe, 0x78, 0x5a, 0x2a, 0x7e, 0x4a, 0x82, 0xd4, 0xfc, 0xd5, 0x35, 0x45,
-		0xb3, 0x51, 0x05, 0xc6, 0x7c, 0x83, 0xea, 0x77, 0x6c, 0xfa, 0x36, 0x28, 0x06, 0x67, 0xa5, 0xdb,
-		0xaa, 0xb1, 0x27, 0x4c, 0xeb, 0x7a, 0xb2, 0xa5, 0xe1, 0x15, 0x18, 0x64, 0x52, 0x3a, 0xa8, 0x04,
-		0x49, 0x8b, 0xfc, 0xe0, 0x3b, 0xf2, 0x93, 0x5d, 0x0d, 0x91, 0xd2, 0x7b, 0x3b, 0x88, 0x84, 0xa5,
-		0xf8, 0x63, 0x09, 0x60, 0xfe, 0xea, 0xd5, 0x0d, 0x5b, 0xb3, 0x74, 0xec, 0x1e, 0x56, 0x8b, 0x97,
-		0xe0, 0x48, 0x60, 0x69, 0x62, 0xab, 0x7d, 0xb7, 0x7a, 0xdc, 0x5f, 0x9c, 0xd8, 0x6a, 0x47, 0xb4,
-		0x9a, 0xe3, 0x7a, 0x68, 0xf1, 0xbe, 0xd1, 0xe6, 0x1d, 0xb7, 0xb3, 0x1a, 0xd7, 0x61, 0xc8, 0x6f,
-		0xbe, 0x83, 0xe6, 0x21, 0xed, 0xf2, 0xdf, 0x5c, 0x9b, 0xc5, 0xee, 0xda, 0x14, 0x6c, 0x5c, 0xa3,
-		0x1e, 0x67, 0xf1, 0xff, 0x12, 0xa5, 0x7a, 0x16, 0xfb, 0xd6, 0x32, 0x23, 0xe2, 0x7b, 0xb9, 0x6f,
-		0x3c, 0x8c, 0x88, 0x82, 0x63, 0xb5, 0x68, 0xf5, 0xfd, 0x31, 0x18, 0xdf, 0x14, 0xde, 0xe6, 0x2d,
-		0xab, 0x89, 0x35, 0x18, 0xc4, 0x86, 0x6b, 0x6b, 0x54, 0x15, 0xa4, 0xaf, 0x1f, 0xee, 0xd6, 0xd7,
-		0x1d, 0xda, 0x42, 0x3f, 0xfe, 0x24, 0xf6, 0xb5, 0x39, 0x4c, 0x8b, 0x16, 0xfe, 0x43, 0x0c, 0xf2,
-		0xdd, 0x38, 0xd1, 0x71, 0x18, 0x55, 0x6d, 0xcc, 0x6e, 0x3d, 0x85, 0x36, 0xd7, 0x46, 0x44, 0x36,
-		0x77, 0xfa, 0xcb, 0x40, 0x02, 0x28, 0x62, 0x58, 0xf4, 0x82, 0xd4, 0x7e, 0x23, 0xa6, 0x11, 0x9f,
-		0x99, 0xba, 0x7d, 0x0c, 0xa3, 0x9a, 0xa1, 0xb9, 0x9a, 0xa2, 0x57, 0xb7, 0x14, 0x5d, 0x31, 0xd4,
-		0x83, 0x44, 0x96, 0xed, 0x8e, 0x7a, 0x84, 0x83, 0x96, 0x19, 0x26, 0xba, 0x0a, 0x83, 0x02, 0x3e,
-		0x71, 0x08, 0xf0, 0x02, 0x2c, 0x10, 0x45, 0x7d, 0x2b, 0x06, 0x63, 0x32, 0xae, 0xfd, 0x74, 0xa9,
-		0xf5, 0x5d, 0x00, 0x6c, 0xc0, 0x11, 0x3f, 0x78, 0x00, 0xcd, 0xb6, 0x0f, 0xe0, 0x0c, 0xc3, 0x9b,
-		0x77, 0xdc, 0x80, 0x6e, 0xbf, 0x1e, 0x83, 0x6c, 0x50, 0xb7, 0x3f, 0x05, 0xf3, 0x02, 0x5a, 0xf4,
-		0xbd, 0x41, 0x82, 0x7f, 0xb6, 0xb6, 0x8b, 0x37, 0x68, 0xb3, 0xba, 0xde, 0x6e, 0xe0, 0x0d, 0x09,
-		0x52, 0x6b, 0x8a, 0xad, 0x34, 0x1c, 0xf4, 0x44, 0x5b, 0x00, 0x27, 0x76, 0xd9, 0xda, 0x3e, 0x4e,
-		0xce, 0x17, 0xf5, 0xcc, 0xe4, 0x3e, 0xdc, 0x21, 0x7e, 0xbb, 0x17, 0x46, 0xc8, 0x12, 0x31, 0x70,
-		0x20, 0x1f, 0xa3, 0xc7, 0x8c, 0x64, 0x8d, 0x17, 0xb8, 0xfa, 0x38, 0x05, 0x43, 0x84, 0xcc, 0x77,
-		0x74, 0x84, 0x06, 0x1a, 0xca, 0xf5, 0x0a, 0xcb, 0x41, 0x0f, 0x01, 0xda, 0xf1, 0x16, 0xed, 0x55,
-		0x5f, 0x05, 0x84, 0x6e, 0xcc, 0x2f, 0x11, 0xe4, 0x77, 0x02, 0x10, 0x29, 0xaa, 0xec, 0x0a, 0x32,
-		0x5b, 0xe3, 0x64, 0x48, 0xce, 0x3c, 0xc9, 0x08, 0xd8, 0xd0, 0x27, 0x25, 0x40, 0xbe, 0xd3, 0x93,
-		0xb1, 0x63, 0x91, 0x85, 0x05, 0x09, 0x3b, 0x03, 0x31, 0xa2, 0xd4, 0x3b, 0xec, 0xf4, 0xf9, 0x45,
-		0xd8, 0x19, 0xb0, 0xc9, 0x0b, 0xbe, 0x8b, 0x89, 0x45, 0x5d, 0xa7, 0xe5, 0x1d, 0xd4, 0xea, 0x45,
-		0x06, 0x8a, 0xdf, 0x92, 0xe0, 0x58, 0x5b, 0x7f, 0x7a, 0xc2, 0xfe, 0x15, 0x40, 0x76, 0xa0, 0x90,
-		0x7f, 0x01, 0x90, 0x09, 0xbd, 0x6f, 0xf3, 0x18, 0xb3, 0xdb, 0xbc, 0xd5, 0xad, 0xf2, 0x92, 0xec,
-		0x66, 0xf7, 0xbf, 0x94, 0x60, 0x22, 0x28, 0x8c, 0xd7, 0xac, 0x15, 0xc8, 0x06, 0x65, 0xe1, 0x0d,
-		0xba, 0xa7, 0x9f, 0x06, 0xf1, 0xb6, 0x84, 0xf8, 0xd1, 0x93, 0xfe, 0xd0, 0x61, 0xdb, 0x35, 0x8f,
-		0xf4, 0xad, 0x1b, 0x21, 0x53, 0xeb, 0x10, 0x4a, 0x88, 0x38, 0x22, 0xb1, 0x66, 0x9a, 0x3a, 0x7a,
-		0x2f, 0x8c, 0x19, 0xa6, 0x5b, 0x25, 0x76, 0x86, 0x6b, 0xc1, 0x4b, 0xd4, 0x99, 0xf2, 0x93, 0xfb,
-		0x53, 0xd9, 0xeb, 0xaf, 0x4e, 0xb5, 0x43, 0xb5, 0xe8, 0x71, 0xd4, 0x30, 0xdd, 0x32, 0x2d, 0xe7,
-		0xb7, 0xaa, 0x6d, 0x18, 0x0e, 0x57, 0xcd, 0xfc, 0xd5, 0xf2, 0xbe, 0xab, 0x1e, 0xee, 0x55, 0x6d,
-		0x76, 0x2b, 0x50, 0x27, 0xbb, 0x55, 0xf4, 0xc3, 0x97, 0xa7, 0xa4, 0x93, 0x5f, 0x92, 0x00, 0xfc,
-		0x45, 0x34, 0x7a, 0x10, 0x6e, 0x2b, 0xaf, 0xae, 0xcc, 0x57, 0xd7, 0x37, 0x66, 0x37, 0x36, 0xd7,
-		0xc3, 0x57, 0x8b, 0xc5, 0xae, 0xac, 0x63, 0x61, 0x55, 0xdb, 0xd6, 0x70, 0x0d, 0xdd, 0x07, 0x13,
-		0x61, 0x6a, 0x92, 0xaa, 0xcc, 0xe7, 0xa4, 0x42, 0xf6, 0xa5, 0x9b, 0xd3, 0x69, 0x16, 0x9f, 0xe0,
-		0x1a, 0x3a, 0x01, 0x47, 0xda, 0xe9, 0x16, 0x57, 0x16, 0x72, 0xb1, 0xc2, 0xf0, 0x4b, 0x37, 0xa7,
-		0x33, 0x5e, 0x20, 0x83, 0x8a, 0x80, 0x82, 0x94, 0x1c, 0x2f, 0x5e, 0x80, 0x97, 0x6e, 0x4e, 0xa7,
-		0x98, 0xda, 0x0a, 0x89, 0x17, 0x3f, 0x39, 0x39, 0x70, 0xe8, 0x17, 0x90, 0xbf, 0x36, 0xd8, 0x75,
-		0xb3, 0xb5, 0x8e, 0x0d, 0xec, 0x68, 0xce, 0x81, 0x36, 0x5b, 0xfb, 0xda, 0xc0, 0xed, 0xf5, 0xe6,
-		0xe3, 0xcf, 0x13, 0x90, 0x5d, 0x60, 0x02, 0x90, 0x3e, 0xc2, 0xe8, 0x6d, 0x90, 0xb2, 0xa8, 0xc7,
-		0xf7, 0x0e, 0x76, 0xba, 0x8c, 0x07, 0x36, 0x2f, 0x78, 0xb7, 0x8b, 0xd8, 0x2c, 0xf1, 0x34, 0xbf,
-		0x5e, 0xc0, 0x6e, 0x3d, 0xf9, 0xf7, 0x78, 0xb2, 0xe5, 0x99, 0xfd, 0x19, 0x1c, 0xbb, 0x8e, 0xb0,
-		0x41, 0x60, 0xd8, 0xa5, 0xa4, 0x1a, 0x1c, 0xa1, 0xc8, 0xfe, 0xb4, 0x49, 0xd1, 0x45, 0xfc, 0x7b,
-		0xb2, 0x9b, 0x98, 0x4b, 0x8a, 0xe3, 0xdf, 0x30, 0x60, 0xb7, 0x88, 0x98, 0xc8, 0xe3, 0x7a, 0x5b,
-		0x89, 0x83, 0x16, 0x42, 0xd7, 0xc4, 0x12, 0xfb, 0xdb, 0xc0, 0x0d, 0x5e, 0x19, 0x7b, 0x02, 0x86,
-		0x7c, 0x77, 0xe1, 0xf0, 0x7f, 0x51, 0xd2, 0xff, 0x64, 0x11, 0x64, 0x46, 0xdb, 0x70, 0xc4, 0x9f,
-		0x7a, 0x83, 0xa8, 0xec, 0x3f, 0xb9, 0x3c, 0xb0, 0x8f, 0xd0, 0x9f, 0xc3, 0x4f, 0x34, 0xdb, 0x8b,
-		0xc8, 0xa2, 0x62, 0x38, 0xe8, 0x1b, 0x9d, 0xbc, 0xf8, 0x18, 0x61, 0xff, 0xce, 0x35, 0x0c, 0xc0,
-		0xfe, 0x7b, 0x84, 0x65, 0xda, 0x2e, 0xae, 0xd1, 0x8d, 0xa1, 0xb4, 0xec, 0xa5, 0x8b, 0x3b, 0x80,
-		0xda, 0xfb, 0x26, 0xfc, 0xdc, 0x41, 0xea, 0xeb, 0xb9, 0x03, 0x9a, 0x80, 0x64, 0xf0, 0xc6, 0x18,
-		0x4b, 0x94, 0xd2, 0x2f, 0xf2, 0x89, 0xf2, 0xd0, 0xc7, 0xf2, 0xb7, 0x63, 0x70, 0x32, 0x78, 0xda,
-		0xf0, 0xee, 0x26, 0xb6, 0xf7, 0xbc, 0xa1, 0x67, 0x29, 0x75, 0xcd, 0x08, 0x5e, 0xaa, 0x3f, 0x16,
-		0x9c, 0xda, 0x29, 0xad, 0xd0, 0x60, 0xf1, 0x45, 0x09, 0x86, 0xd6, 0x94, 0x3a, 0x96, 0xf1, 0xbb,
-		0x9b, 0xd8, 0x71, 0x3b, 0x5c, 0x5a, 0x3e, 0x0a, 0x29, 0x73, 0x7b, 0x5b, 0x1c, 0x91, 0x26, 0x64,
-		0x9e, 0x22, 0x6d, 0xd6, 0xb5, 0x86, 0xc6, 0x6e, 0x17, 0x25, 0x64, 0x96, 0x20, 0x11, 0x93, 0x6a,
-		0x36, 0x0d, 0x3e, 0xfe, 0xf2, 0x09, 0xf1, 0x3d, 0x90, 0xa6, 0xc1, 0x86, 0x12, 0xca, 0xc3, 0xa0,
-		0x8d, 0xaf, 0x61, 0xdb, 0x61, 0x5f, 0x40, 0x4c, 0xcb, 0x22, 0x59, 0x7c, 0x1c, 0xb2, 0x4c, 0x12,
-		0x3e, 0xd1, 0x1e, 0x83, 0x34, 0xbd, 0xb8, 0xe3, 0xcb, 0x33, 0x48, 0xd2, 0x57, 0xd8, 0xd5, 0x67,
-		0x86, 0xcf, 0x44, 0x62, 0x89, 0x72, 0xb9, 0xab, 0x96, 0x4f, 0x44, 0x0f, 0x79, 0xa6, 0x43, 0x4f,
-		0xc3, 0xbf, 0x9b, 0x84, 0x23, 0xfc, 0x2c, 0x48, 0xb1, 0xb4, 0x53, 0x3b, 0xae, 0x2b, 0xae, 0xe2,
-		0x03, 0x8f, 0x36, 0x15, 0x4b, 0x2b, 0xee, 0x41, 0xe2, 0xb2, 0xeb, 0x5a, 0xe8, 0x24, 0x24, 0xed,
-		0xa6, 0x8e, 0xc5, 0xb6, 0x87, 0xb7, 0x6d, 0xac, 0x58, 0xda, 0x0c, 0x21, 0x90, 0x9b, 0x3a, 0x96,
-		0x19, 0x09, 0xaa, 0xc0, 0xd4, 0x76, 0x53, 0xd7, 0xf7, 0xaa, 0x35, 0x4c, 0xff, 0xa5, 0x93, 0xf7,
-		0x4f, 0x11, 0xf0, 0x75, 0x4b, 0x11, 0x9f, 0x56, 0x24, 0x8a, 0xb9, 0x83, 0x92, 0xcd, 0x53, 0x2a,
-		0xf1, 0x0f, 0x11, 0x2a, 0x82, 0xa6, 0xf8, 0x47, 0x31, 0x48, 0x0b, 0x68, 0x7a, 0x17, 0x19, 0xeb,
-		0x58, 0x75, 0x4d, 0xb1, 0x69, 0xef, 0xa5, 0x11, 0x82, 0x78, 0x9d, 0x77, 0x5e, 0xe6, 0xf2, 0x80,
-		0x4c, 0x12, 0x24, 0xcf, 0xbb, 0x21, 0x4e, 0xf2, 0xac, 0x26, 0xe9, 0xcf, 0x84, 0x65, 0x8a, 0x75,
-		0xd1, 0xe5, 0x01, 0x99, 0xa6, 0x50, 0x1e, 0x52, 0x64, 0x38, 0xb9, 0xac, 0xb7, 0x48, 0x3e, 0x4f,
-		0xa3, 0xa3, 0x90, 0xb4, 0x14, 0x57, 0x65, 0x97, 0xb7, 0x48, 0x01, 0x4b, 0xa2, 0x73, 0x90, 0x62,
-		0x2f, 0x87, 0x5b, 0xff, 0x5f, 0x0a, 0x51, 0x06, 0xfb, 0x44, 0x1b, 0x91, 0x7b, 0x4d, 0x71, 0x5d,
-		0x6c, 0x1b, 0x04, 0x90, 0x91, 0x23, 0x04, 0x89, 0x2d, 0xb3, 0xb6, 0xc7, 0xff, 0x87, 0x0b, 0xfd,
-		0xcd, 0xff, 0x69, 0x04, 0xb5, 0x87, 0x2a, 0x2d, 0x64, 0xff, 0xba, 0x2a, 0x2b, 0x32, 0xcb, 0x84,
-		0xa8, 0x02, 0xe3, 0x4a, 0xad, 0xa6, 0xb1, 0x7f, 0xa7, 0x52, 0xdd, 0xd2, 0xa8, 0x5b, 0x71, 0xe8,
-		0x3f, 0x26, 0xeb, 0xd6, 0x17, 0xc8, 0x67, 0x28, 0x73, 0xfa, 0x72, 0x06, 0x06, 0x2d, 0x26, 0x54,
-		0xf1, 0x22, 0x8c, 0xb5, 0x49, 0x4a, 0xe4, 0xdb, 0xd5, 0x8c, 0x9a, 0xb8, 0x36, 0x4f, 0x7e, 0x93,
-		0x3c, 0xfa, 0x51, 0x45, 0x76, 0x1c, 0x42, 0x7f, 0x97, 0x7f, 0xae, 0xfb, 0xeb, 0x8a, 0x91, 0xc0,
-		0xeb, 0x0a, 0xc5, 0xd2, 0xca, 0x19, 0x8a, 0xcf, 0xdf, 0x54, 0xcc, 0xb6, 0xbf, 0xa9, 0xa8, 0x63,
-		0x43, 0x4c, 0xb8, 0xa4, 0x48, 0xb1, 0x34, 0x87, 0x9a, 0xa3, 0xff, 0x91, 0x47, 0xe7, 0x62, 0xe0,
-		0x37, 0x7d, 0x62, 0x91, 0x58, 0x98, 0x5d, 0x5b, 0xf4, 0xec, 0xf8, 0x2b, 0x31, 0xb8, 0x23, 0x60,
-		0xc7, 0x01, 0xe2, 0x76, 0x73, 0x2e, 0x74, 0xb6, 0xf8, 0x3e, 0xde, 0xcf, 0x5e, 0x81, 0x04, 0xa1,
-		0x47, 0x11, 0xff, 0xd2, 0x21, 0xff, 0xb9, 0xaf, 0xff, 0x8b, 0x62, 0xf8, 0x60, 0x25, 0xd4, 0x2b,
-		0x14, 0xa4, 0xfc, 0xf3, 0xfd, 0xeb, 0x2f, 0xe7, 0x7f, 0xdf, 0xd2, 0x39, 0x3c, 0x35, 0xb6, 0xea,
-		0xf0, 0xc5, 0x0b, 0x5d, 0x1f, 0x49, 0x32, 0x67, 0xda, 0x3b, 0x6e, 0xda, 0x87, 0xa7, 0xee, 0x76,
-		0xd3, 0xbc, 0x57, 0x0f, 0xbe, 0xf9, 0x08, 0xec, 0x3a, 0x1c, 0x7d, 0x92, 0x88, 0xe5, 0x2f, 0x7c,
-		0xc5, 0x6c, 0x70, 0xd4, 0x3b, 0xa6, 0x92, 0xf8, 0x93, 0x62, 0x16, 0x45, 0x5f, 0x02, 0xf0, 0x45,
-		0xe7, 0x0b, 0xc8, 0xfb, 0x66, 0xba, 0xce, 0x32, 0x33, 0x81, 0x19, 0x46, 0x0e, 0x70, 0x16, 0x7f,
-		0x55, 0x82, 0xdb, 0xda, 0xaa, 0xe6, 0xee, 0x7f, 0xa1, 0xc3, 0x7d, 0xf9, 0x03, 0x05, 0x42, 0x0b,
-		0x1d, 0x84, 0x3d, 0x1e, 0x29, 0x2c, 0x93, 0x22, 0x24, 0xed, 0xd3, 0x70, 0x24, 0x2c, 0xac, 0x50,
-		0xd3, 0xe3, 0x30, 0x12, 0xde, 0x52, 0x8d, 0x8c, 0x1c, 0x86, 0x43, 0xfb, 0xa9, 0xc5, 0x6a, 0x6b,
-		0x0f, 0x78, 0x5a, 0xa8, 0x40, 0xc6, 0x23, 0xe5, 0xf1, 0x70, 0xdf, 0x4a, 0xf0, 0x39, 0x89, 0xa2,
-		0xa7, 0xc3, 0x35, 0x04, 0xc2, 0xae, 0xc3, 0x6a, 0xc6, 0xa1, 0x99, 0xc5, 0xf7, 0x25, 0xb8, 0xab,
-		0x87, 0xb4, 0x5c, 0x35, 0x37, 0x60, 0x22, 0xb0, 0xbb, 0x20, 0x66, 0x04, 0x61, 0x2a, 0x27, 0xa3,
-		0x23, 0x5d, 0x6f, 0xf9, 0x7c, 0x3b, 0x51, 0xd7, 0x67, 0xbe, 0x3d, 0x35, 0xde, 0x5e, 0xe6, 0xc8,
-		0xe3, 0xed, 0x7b, 0x00, 0x87, 0x68, 0x53, 0xaf, 0x48, 0x70, 0x7f, 0xb8, 0xa9, 0x1d, 0x62, 0xe6,
-		0xb7, 0x5e, 0x0f, 0x7d, 0x4b, 0x82, 0x93, 0xfd, 0x88, 0xcd, 0xbb, 0x6a, 0x0b, 0xc6, 0xfd, 0xf5,
-		0x43, 0x6b, 0x4f, 0x1d, 0x60, 0xf5, 0x80, 0x3c, 0xb4, 0x5b, 0xd0, 0x25, 0x9f, 0x92, 0xf8, 0x68,
-		0x0c, 0x5a, 0x83, 0xa7, 0xff, 0xf0, 0x4e, 0x6e, 0xb4, 0xfe, 0x43, 0xdb, 0xb8, 0x1d, 0x3a, 0x30,
-		0xb6, 0xaf, 0x0e, 0xf4, 0xd7, 0x14, 0xc5, 0x6b, 0xdc, 0x75, 0x76, 0xd8, 0x26, 0x7c, 0x17, 0x8c,
-		0x77, 0x18, 0x19, 0xdc, 0x7d, 0xec, 0x63, 0x60, 0xc8, 0xa8, 0xdd, 0xf6, 0x8b, 0xbf, 0x26, 0xc1,
-		0x14, 0xad, 0xb8, 0x43, 0xf7, 0xbc, 0x15, 0xf5, 0xd4, 0xe0, 0x9e, 0xaf, 0xa3, 0xb8, 0x5c, 0x61,
-		0x8b, 0x90, 0x62, 0x16, 0xc5, 0x75, 0x74, 0x00, 0x93, 0xe4, 0x00, 0xc5, 0x2f, 0x0a, 0x4f, 0x3b,
-		0x2f, 0x1a, 0xd4, 0x79, 0x1c, 0xbf, 0x39, 0xfd, 0x1c, 0xd2, 0x38, 0x0e, 0xa8, 0xe9, 0x9b, 0xc2,
-		0xe7, 0x76, 0x96, 0x9b, 0x2b, 0x4a, 0x3d, 0x34, 0x9f, 0xcb, 0xb7, 0x40, 0x6e, 0xa9, 0x73, 0xfd,
-		0x1d, 0xe1, 0x5c, 0xbd, 0x36, 0x45, 0x38, 0xd7, 0xb7, 0x5a, 0xa7, 0x78, 0x6e, 0x36, 0xa2, 0x01,
-		0x7f, 0x19, 0xdd, 0xec, 0xef, 0xc4, 0xe0, 0x18, 0x6d, 0x5b, 0x70, 0x13, 0xe7, 0x30, 0x3b, 0x03,
-		0x39, 0xb6, 0x5a, 0xdd, 0xa7, 0x17, 0xc9, 0x39, 0xb6, 0x7a, 0xb5, 0x65, 0xc6, 0x44, 0xb5, 0xd0,
-		0xa6, 0x1f, 0xc5, 0x89, 0x47, 0x9e, 0xde, 0x05, 0xf6, 0x95, 0x3a, 0x18, 0x47, 0xe2, 0x10, 0x8c,
-		0xe3, 0x1b, 0x12, 0x14, 0x3a, 0x29, 0x90, 0x1b, 0x83, 0x06, 0x47, 0x43, 0xc7, 0x2f, 0xad, 0xf6,
-		0xf0, 0x60, 0x3f, 0x9b, 0x6a, 0x2d, 0xc3, 0xf5, 0x88, 0x8d, 0x6f, 0x75, 0x34, 0x34, 0x15, 0xb6,
-		0xf7, 0xf6, 0x35, 0xc9, 0x5b, 0x70, 0x98, 0xbe, 0xd2, 0xe6, 0xf3, 0xff, 0x52, 0xac, 0x67, 0x3e,
-		0x2b, 0xc1, 0x64, 0x17, 0xb1, 0xdf, 0x8a, 0x13, 0xf9, 0x4e, 0x57, 0xdb, 0x38, 0xec, 0xd5, 0xd2,
-		0x63, 0x7c, 0x60, 0x85, 0xef, 0x63, 0x07, 0x16, 0xc5, 0x9d, 0x1e, 0x74, 0x15, 0x9f, 0x81, 0xdb,
-		0x3b, 0x72, 0x71, 0xd9, 0x4a, 0x90, 0xd8, 0xd1, 0x1c, 0x97, 0x8b, 0x75, 0x5f, 0x37, 0xb1, 0x5a,
-		0xb8, 0x29, 0x4f, 0x11, 0x41, 0x8e, 0x42, 0xaf, 0x99, 0xa6, 0xce, 0xc5, 0x28, 0x5e, 0x81, 0xb1,
-		0x40, 0x1e, 0xaf, 0xe4, 0x2c, 0x24, 0x2c, 0x93, 0x7f, 0xac, 0x60, 0xe8, 0xf4, 0x1d, 0x5d, 0x4f,
-		0x4e, 0x4c, 0x53, 0xe7, 0xcd, 0xa6, 0xf4, 0xc5, 0x09, 0x40, 0x0c, 0x8c, 0x1e, 0xa2, 0x88, 0x2a,
-		0xd6, 0x61, 0x3c, 0x94, 0xcb, 0x2b, 0x79, 0x53, 0x07, 0x34, 0xa7, 0x5f, 0x3f, 0x02, 0x49, 0x8a,
-		0x8a, 0x3e, 0x22, 0x85, 0xbe, 0x33, 0x34, 0xd3, 0x0d, 0xa6, 0xf3, 0xe6, 0x44, 0xe1, 0x54, 0xdf,
-		0xf4, 0x3c, 0x72, 0x3d, 0xf9, 0x73, 0xff, 0xf6, 0x7b, 0x1f, 0x8a, 0xdd, 0x83, 0x8a, 0xa7, 0xba,
-		0xec, 0x98, 0x04, 0x06, 0xd9, 0xa7, 0x43, 0x2f, 0xe1, 0x1f, 0xea, 0xaf, 0x2a, 0x21, 0xd9, 0x4c,
-		0xbf, 0xe4, 0x5c, 0xb0, 0x8b, 0x54, 0xb0, 0x33, 0xe8, 0xd1, 0x68, 0xc1, 0x4e, 0xbd, 0x27, 0x3c,
-		0x9c, 0xde, 0x8b, 0xfe, 0x9d, 0x04, 0x13, 0x9d, 0xd6, 0xc9, 0xe8, 0x7c, 0x7f, 0x52, 0xb4, 0x47,
-		0x42, 0x85, 0x0b, 0x07, 0xe0, 0xe4, 0x4d, 0x59, 0xa0, 0x4d, 0x99, 0x45, 0x8f, 0x1f, 0xa0, 0x29,
-		0xa7, 0x82, 0x07, 0x37, 0xff, 0x47, 0x82, 0x3b, 0x7b, 0x2e, 0x2e, 0xd1, 0x6c, 0x7f, 0x52, 0xf6,
-		0x08, 0xf9, 0x0a, 0xe5, 0x37, 0x03, 0xc1, 0x5b, 0xfc, 0x24, 0x6d, 0xf1, 0x15, 0xb4, 0x78, 0x90,
-		0x16, 0x77, 0x3c, 0x55, 0x43, 0xbf, 0x17, 0xbe, 0x02, 0xd9, 0xdb, 0x9c, 0xda, 0x56, 0x5f, 0x11,
-		0x03, 0xa3, 0x3d, 0x16, 0x2f, 0x3e, 0x4d, 0x9b, 0x20, 0xa3, 0xb5, 0x37, 0xd9, 0x69, 0xa7, 0xde,
-		0x13, 0x9e, 0x2c, 0xde, 0x8b, 0xfe, 0x5c, 0xea, 0x7c, 0x97, 0xf1, 0x5c, 0x4f, 0x11, 0xbb, 0xaf,
-		0x2c, 0x0b, 0xe7, 0xf7, 0xcf, 0xc8, 0x1b, 0xd9, 0xa0, 0x8d, 0xac, 0x23, 0x7c, 0xd8, 0x8d, 0xec,
-		0xd8, 0x89, 0xe8, 0x6b, 0x12, 0x4c, 0x74, 0x5a, 0x4a, 0x45, 0x0c, 0xcb, 0x1e, 0xab, 0xc6, 0x88,
-		0x61, 0xd9, 0x6b, 0xdd, 0x56, 0x7c, 0x1b, 0x6d, 0xfc, 0x59, 0xf4, 0x58, 0xb7, 0xc6, 0xf7, 0xec,
-		0x45, 0x32, 0x16, 0x7b, 0xae, 0x40, 0x22, 0xc6, 0x62, 0x3f, 0xcb, 0xaf, 0x88, 0xb1, 0xd8, 0xd7,
-		0x02, 0x28, 0x7a, 0x2c, 0x7a, 0x2d, 0xeb, 0xb3, 0x1b, 0x1d, 0xf4, 0x15, 0x09, 0x86, 0x43, 0x01,
-		0x36, 0x7a, 0xa4, 0xa7, 0xa0, 0x9d, 0x56, 0x33, 0x85, 0xd3, 0xfb, 0x61, 0xe1, 0x6d, 0x59, 0xa4,
-		0x6d, 0x99, 0x43, 0xb3, 0x07, 0x69, 0x4b, 0xf8, 0x10, 0xfc, 0x1b, 0x12, 0x8c, 0x77, 0x08, 0x4d,
-		0x23, 0x46, 0x61, 0xf7, 0x18, 0xbc, 0x70, 0x7e, 0xff, 0x8c, 0xbc, 0x55, 0x97, 0x68, 0xab, 0xde,
-		0x89, 0xde, 0x71, 0x90, 0x56, 0x05, 0xe6, 0xe7, 0x57, 0xfd, 0x0b, 0x72, 0x81, 0x7a, 0xd0, 0xd9,
-		0x7d, 0x0a, 0x26, 0x1a, 0x74, 0x6e, 0xdf, 0x7c, 0xbc, 0x3d, 0x4f, 0xd1, 0xf6, 0x3c, 0x89, 0x56,
-		0xdf, 0x5c, 0x7b, 0xda, 0xa7, 0xf5, 0x2f, 0xb4, 0xbf, 0x07, 0xec, 0x6d, 0x45, 0x1d, 0x83, 0xd5,
-		0xc2, 0xa3, 0xfb, 0xe2, 0xe1, 0x8d, 0x3a, 0x4f, 0x1b, 0x75, 0x1a, 0x3d, 0xdc, 0xad, 0x51, 0x81,
-		0x3b, 0x8f, 0x9a, 0xb1, 0x6d, 0x9e, 0x7a, 0x0f, 0x0b, 0x81, 0xdf, 0x8b, 0xde, 0x27, 0xf1, 0x3b,
-		0x67, 0x27, 0x7a, 0xd6, 0x1b, 0x88, 0x63, 0x0b, 0xf7, 0xf7, 0x41, 0xc9, 0xe5, 0xba, 0x87, 0xca,
-		0x35, 0x89, 0xee, 0xe8, 0x26, 0x17, 0x89, 0x65, 0xd1, 0x07, 0xfc, 0x2b, 0xa3, 0x27, 0x7b, 0x63,
-		0x07, 0x83, 0xdd, 0xc2, 0x03, 0x7d, 0xd1, 0x72, 0x49, 0xee, 0xa3, 0x92, 0x4c, 0xa3, 0xc9, 0xae,
-		0x92, 0xb0, 0xd0, 0xf7, 0xb0, 0x2f, 0x7e, 0xfc, 0xc6, 0x51, 0x98, 0xea, 0x52, 0xa3, 0x7b, 0x3d,
-		0xe2, 0x1c, 0xb2, 0xc7, 0xb3, 0xd8, 0xc8, 0x67, 0xaf, 0x87, 0xfd, 0xa1, 0xd7, 0xfe, 0x0e, 0x2d,
-		0x8b, 0x5f, 0x4c, 0x00, 0x5a, 0x76, 0xea, 0x73, 0x36, 0x66, 0xff, 0xaf, 0x92, 0x8f, 0xf2, 0x96,
-		0x27, 0x64, 0xd2, 0x9b, 0x7a, 0x42, 0xb6, 0x1c, 0x7a, 0xca, 0x15, 0xdb, 0xdf, 0x43, 0xcd, 0xbe,
-		0xdf, 0x73, 0xc5, 0x6f, 0xc9, 0x7b, 0xae, 0xce, 0x57, 0xcb, 0x13, 0x87, 0xf3, 0x26, 0x24, 0xb9,
-		0xef, 0x37, 0x21, 0x97, 0x20, 0xc5, 0x9f, 0x4f, 0xa6, 0x0e, 0xf4, 0x7c, 0x92, 0x73, 0xa3, 0x33,
-		0xe2, 0x23, 0xa6, 0x83, 0xfd, 0x5d, 0x4d, 0xe6, 0x5f, 0x39, 0xf5, 0xb7, 0x0a, 0xee, 0x80, 0x42,
-		0xbb, 0xd9, 0x78, 0x83, 0xf7, 0xc7, 0x31, 0xc8, 0x2d, 0x3b, 0xf5, 0x4a, 0x4d, 0x73, 0x6f, 0x91,
-		0x4d, 0x1d, 0xd2, 0x1b, 0x1b, 0x05, 0x46, 0x5b, 0x5e, 0x12, 0x73, 0x3b, 0x3a, 0x7f, 0xe0, 0x17,
-		0x0b, 0x23, 0xe1, 0xf7, 0xc4, 0x68, 0xa7, 0xb3, 0xb9, 0x26, 0xf6, 0x55, 0x4d, 0x5f, 0x4f, 0x0f,
-		0xfd, 0xde, 0x29, 0x40, 0xbe, 0x55, 0xfd, 0x5e, 0xdf, 0xbc, 0x2a, 0xc1, 0xd0, 0xb2, 0x23, 0x82,
-		0x3b, 0xfc, 0x16, 0x7b, 0xf4, 0x74, 0xce, 0xfb, 0x4e, 0x78, 0xbc, 0x3f, 0xcb, 0x14, 0x5f, 0x0b,
-		0xf7, 0x1b, 0x7f, 0x04, 0xc6, 0x03, 0xed, 0xf3, 0xda, 0xfd, 0x5b, 0x31, 0xea, 0xe9, 0xca, 0xb8,
-		0xae, 0x19, 0x5e, 0x3c, 0x88, 0x7f, 0x1a, 0x9e, 0x8e, 0xf8, 0x3a, 0x4d, 0x1c, 0x54, 0xa7, 0xbb,
-		0x74, 0xb8, 0xb7, 0xe8, 0xce, 0xdb, 0xae, 0xea, 0xf0, 0x58, 0x49, 0x3a, 0xf8, 0x63, 0xa5, 0xe2,
-		0x77, 0x24, 0x18, 0x5e, 0x76, 0xea, 0x9b, 0x46, 0xed, 0xff, 0x5b, 0x1b, 0xdd, 0x86, 0x23, 0xa1,
-		0x16, 0xde, 0x22, 0x55, 0x9e, 0xfe, 0x68, 0x02, 0xe2, 0xcb, 0x4e, 0x1d, 0xbd, 0x1b, 0x46, 0x5b,
-		0xa7, 0xf8, 0xae, 0x91, 0x5b, 0xbb, 0x5f, 0xef, 0xbe, 0xba, 0xea, 0x3e, 0x07, 0xa0, 0x5d, 0x18,
-		0x0e, 0xfb, 0xff, 0x13, 0x3d, 0x40, 0x42, 0x94, 0x85, 0x87, 0xfb, 0xa5, 0xf4, 0x2a, 0xfb, 0x19,
-		0x48, 0x7b, 0x0e, 0xed, 0xee, 0x1e, 0xdc, 0x82, 0xa8, 0x7b, 0x2c, 0xda, 0xc1, 0x75, 0x10, 0xed,
-		0xb5, 0xba, 0x8d, 0x5e, 0xda, 0x6b, 0xa1, 0xed, 0xa9, 0xbd, 0x6e, 0x43, 0x6a, 0x0b, 0x20, 0x60,
-		0xff, 0xf7, 0xf6, 0x40, 0xf0, 0xc9, 0x0a, 0x0f, 0xf5, 0x45, 0xe6, 0x9d, 0x38, 0x1d, 0x72, 0xe8,
-		0xfc, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xd7, 0x37, 0x9d, 0xd3, 0x97, 0x00, 0x00,
+		// 10293 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7d, 0x74, 0x23, 0xd7,
+		0x75, 0x1f, 0x07, 0x00, 0x41, 0xe0, 0x12, 0x24, 0xc1, 0x47, 0xee, 0x0a, 0x84, 0x76, 0x49, 0x0a,
+		0xfa, 0xd8, 0xd5, 0xca, 0x4b, 0x6a, 0x57, 0xda, 0x2f, 0xac, 0x6d, 0x09, 0x20, 0xb1, 0x5c, 0xee,
+		0x92, 0x4b, 0x6a, 0xc8, 0x5d, 0x7d, 0x38, 0x29, 0xce, 0x10, 0x78, 0x04, 0x47, 0x0b, 0xcc, 0x8c,
+		0x66, 0x06, 0xbb, 0x4b, 0xb9, 0xea, 0x51, 0xa2, 0x36, 0xb5, 0xd5, 0x2f, 0xa7, 0xe9, 0x69, 0xe2,
+		0x8f, 0x75, 0xfd, 0xd5, 0x3a, 0x75, 0x9c, 0xd6, 0xb1, 0x65, 0xb7, 0x71, 0xda, 0xd4, 0x6e, 0x8e,
+		0x1b, 0xdb, 0x7f, 0xf4, 0xd8, 0x69, 0x4f, 0x13, 0xa7, 0xa9, 0xec, 0xca, 0xae, 0xe3, 0xba, 0x6e,
+		0xe3, 0xb6, 0xea, 0x69, 0x7b, 0x7c, 0xdc, 0xd3, 0xf3, 0xbe, 0xe6, 0x03, 0x18, 0x60, 0x00, 0x8a,
+		0xeb, 0x28, 0xf1, 0x5f, 0xe4, 0xbc, 0x77, 0xef, 0x6f, 0xee, 0xbb, 0xef, 0xbe, 0xfb, 0xee, 0xbb,
+		0xef, 0xbd, 0x01, 0xfc, 0xce, 0x79, 0x98, 0xad, 0xe9, 0x7a, 0xad, 0x8e, 0xe7, 0x0d, 0x53, 0xb7,
+		0xf5, 0xad, 0xe6, 0xf6, 0x7c, 0x15, 0x5b, 0x15, 0x53, 0x35, 0x6c, 0xdd, 0x9c, 0xa3, 0x65, 0x68,
+		0x8c, 0x51, 0xcc, 0x09, 0x8a, 0xdc, 0x2a, 0x8c, 0x5f, 0x50, 0xeb, 0x78, 0xd1, 0x21, 0xdc, 0xc0,
+		0x36, 0x3a, 0x0b, 0xb1, 0x6d, 0xb5, 0x8e, 0x33, 0xd2, 0x6c, 0xf4, 0xe8, 0xf0, 0xc9, 0xfb, 0xe6,
+		0x5a, 0x98, 0xe6, 0xfc, 0x1c, 0xeb, 0xa4, 0x58, 0xa6, 0x1c, 0xb9, 0xef, 0xc4, 0x60, 0x22, 0xa0,
+		0x16, 0x21, 0x88, 0x69, 0x4a, 0x83, 0x20, 0x4a, 0x47, 0x93, 0x32, 0xfd, 0x1f, 0x65, 0x60, 0xc8,
+		0x50, 0x2a, 0xd7, 0x95, 0x1a, 0xce, 0x44, 0x68, 0xb1, 0x78, 0x44, 0xd3, 0x00, 0x55, 0x6c, 0x60,
+		0xad, 0x8a, 0xb5, 0xca, 0x6e, 0x26, 0x3a, 0x1b, 0x3d, 0x9a, 0x94, 0x3d, 0x25, 0xe8, 0x21, 0x18,
+		0x37, 0x9a, 0x5b, 0x75, 0xb5, 0x52, 0xf6, 0x90, 0xc1, 0x6c, 0xf4, 0xe8, 0xa0, 0x9c, 0x66, 0x15,
+		0x8b, 0x2e, 0xf1, 0x11, 0x18, 0xbb, 0x89, 0x95, 0xeb, 0x5e, 0xd2, 0x61, 0x4a, 0x3a, 0x4a, 0x8a,
+		0x3d, 0x84, 0x0b, 0x90, 0x6a, 0x60, 0xcb, 0x52, 0x6a, 0xb8, 0x6c, 0xef, 0x1a, 0x38, 0x13, 0xa3,
+		0xad, 0x9f, 0x6d, 0x6b, 0x7d, 0x6b, 0xcb, 0x87, 0x39, 0xd7, 0xe6, 0xae, 0x81, 0x51, 0x01, 0x92,
+		0x58, 0x6b, 0x36, 0x18, 0xc2, 0x60, 0x07, 0xfd, 0x95, 0xb4, 0x66, 0xa3, 0x15, 0x25, 0x41, 0xd8,
+		0x38, 0xc4, 0x90, 0x85, 0xcd, 0x1b, 0x6a, 0x05, 0x67, 0xe2, 0x14, 0xe0, 0x48, 0x1b, 0xc0, 0x06,
+		0xab, 0x6f, 0xc5, 0x10, 0x7c, 0x68, 0x01, 0x92, 0xf8, 0x96, 0x8d, 0x35, 0x4b, 0xd5, 0xb5, 0xcc,
+		0x10, 0x05, 0xb9, 0x3f, 0xa0, 0x17, 0x71, 0xbd, 0xda, 0x0a, 0xe1, 0xf2, 0xa1, 0xd3, 0x30, 0xa4,
+		0x1b, 0xb6, 0xaa, 0x6b, 0x56, 0x26, 0x31, 0x2b, 0x1d, 0x1d, 0x3e, 0x79, 0x28, 0xd0, 0x10, 0xd6,
+		0x18, 0x8d, 0x2c, 0x88, 0xd1, 0x32, 0xa4, 0x2d, 0xbd, 0x69, 0x56, 0x70, 0xb9, 0xa2, 0x57, 0x71,
+		0x59, 0xd5, 0xb6, 0xf5, 0x4c, 0x92, 0x02, 0xcc, 0xb4, 0x37, 0x84, 0x12, 0x2e, 0xe8, 0x55, 0xbc,
+		0xac, 0x6d, 0xeb, 0xf2, 0xa8, 0xe5, 0x7b, 0x46, 0x07, 0x21, 0x6e, 0xed, 0x6a, 0xb6, 0x72, 0x2b,
+		0x93, 0xa2, 0x16, 0xc2, 0x9f, 0x72, 0xbf, 0x19, 0x87, 0xb1, 0x5e, 0x4c, 0xec, 0x3c, 0x0c, 0x6e,
+		0x93, 0x56, 0x66, 0x22, 0xfd, 0xe8, 0x80, 0xf1, 0xf8, 0x95, 0x18, 0xdf, 0xa3, 0x12, 0x0b, 0x30,
+		0xac, 0x61, 0xcb, 0xc6, 0x55, 0x66, 0x11, 0xd1, 0x1e, 0x6d, 0x0a, 0x18, 0x53, 0xbb, 0x49, 0xc5,
+		0xf6, 0x64, 0x52, 0x4f, 0xc1, 0x98, 0x23, 0x52, 0xd9, 0x54, 0xb4, 0x9a, 0xb0, 0xcd, 0xf9, 0x30,
+		0x49, 0xe6, 0x4a, 0x82, 0x4f, 0x26, 0x6c, 0xf2, 0x28, 0xf6, 0x3d, 0xa3, 0x45, 0x00, 0x5d, 0xc3,
+		0xfa, 0x76, 0xb9, 0x8a, 0x2b, 0xf5, 0x4c, 0xa2, 0x83, 0x96, 0xd6, 0x08, 0x49, 0x9b, 0x96, 0x74,
+		0x56, 0x5a, 0xa9, 0xa3, 0x73, 0xae, 0xa9, 0x0d, 0x75, 0xb0, 0x94, 0x55, 0x36, 0xc8, 0xda, 0xac,
+		0xed, 0x2a, 0x8c, 0x9a, 0x98, 0xd8, 0x3d, 0xae, 0xf2, 0x96, 0x25, 0xa9, 0x10, 0x73, 0xa1, 0x2d,
+		0x93, 0x39, 0x1b, 0x6b, 0xd8, 0x88, 0xe9, 0x7d, 0x44, 0xf7, 0x82, 0x53, 0x50, 0xa6, 0x66, 0x05,
+		0xd4, 0x0b, 0xa5, 0x44, 0xe1, 0x15, 0xa5, 0x81, 0xb3, 0xcf, 0xc3, 0xa8, 0x5f, 0x3d, 0x68, 0x12,
+		0x06, 0x2d, 0x5b, 0x31, 0x6d, 0x6a, 0x85, 0x83, 0x32, 0x7b, 0x40, 0x69, 0x88, 0x62, 0xad, 0x4a,
+		0xbd, 0xdc, 0xa0, 0x4c, 0xfe, 0x45, 0x8f, 0xbb, 0x0d, 0x8e, 0xd2, 0x06, 0x3f, 0xd0, 0xde, 0xa3,
+		0x3e, 0xe4, 0xd6, 0x76, 0x67, 0xcf, 0xc0, 0x88, 0xaf, 0x01, 0xbd, 0xbe, 0x3a, 0xf7, 0x17, 0xe1,
+		0x40, 0x20, 0x34, 0x7a, 0x0a, 0x26, 0x9b, 0x9a, 0xaa, 0xd9, 0xd8, 0x34, 0x4c, 0x4c, 0x2c, 0x96,
+		0xbd, 0x2a, 0xf3, 0xc7, 0x43, 0x1d, 0x6c, 0xee, 0xaa, 0x97, 0x9a, 0xa1, 0xc8, 0x13, 0xcd, 0xf6,
+		0xc2, 0x63, 0xc9, 0xc4, 0xf7, 0x86, 0xd2, 0x2f, 0xbe, 0xf8, 0xe2, 0x8b, 0x91, 0xdc, 0x17, 0xe3,
+		0x30, 0x19, 0x34, 0x66, 0x02, 0x87, 0xef, 0x41, 0x88, 0x6b, 0xcd, 0xc6, 0x16, 0x36, 0xa9, 0x92,
+		0x06, 0x65, 0xfe, 0x84, 0x0a, 0x30, 0x58, 0x57, 0xb6, 0x70, 0x3d, 0x13, 0x9b, 0x95, 0x8e, 0x8e,
+		0x9e, 0x7c, 0xa8, 0xa7, 0x51, 0x39, 0xb7, 0x42, 0x58, 0x64, 0xc6, 0x89, 0xde, 0x0e, 0x31, 0xee,
+		0xa2, 0x09, 0xc2, 0xb1, 0xde, 0x10, 0xc8, 0x58, 0x92, 0x29, 0x1f, 0xba, 0x1b, 0x92, 0xe4, 0x2f,
+		0xb3, 0x8d, 0x38, 0x95, 0x39, 0x41, 0x0a, 0x88, 0x5d, 0xa0, 0x2c, 0x24, 0xe8, 0x30, 0xa9, 0x62,
+		0x31, 0xb5, 0x39, 0xcf, 0xc4, 0xb0, 0xaa, 0x78, 0x5b, 0x69, 0xd6, 0xed, 0xf2, 0x0d, 0xa5, 0xde,
+		0xc4, 0xd4, 0xe0, 0x93, 0x72, 0x8a, 0x17, 0x5e, 0x23, 0x65, 0x68, 0x06, 0x86, 0xd9, 0xa8, 0x52,
+		0xb5, 0x2a, 0xbe, 0x45, 0xbd, 0xe7, 0xa0, 0xcc, 0x06, 0xda, 0x32, 0x29, 0x21, 0xaf, 0x7f, 0xd6,
+		0xd2, 0x35, 0x61, 0x9a, 0xf4, 0x15, 0xa4, 0x80, 0xbe, 0xfe, 0x4c, 0xab, 0xe3, 0x3e, 0x1c, 0xdc,
+		0xbc, 0xb6, 0xb1, 0x74, 0x04, 0xc6, 0x28, 0xc5, 0x23, 0xbc, 0xeb, 0x95, 0x7a, 0x66, 0x7c, 0x56,
+		0x3a, 0x9a, 0x90, 0x47, 0x59, 0xf1, 0x1a, 0x2f, 0xcd, 0x7d, 0x2e, 0x02, 0x31, 0xea, 0x58, 0xc6,
+		0x60, 0x78, 0xf3, 0xe9, 0xf5, 0x52, 0x79, 0x71, 0xed, 0x6a, 0x71, 0xa5, 0x94, 0x96, 0xd0, 0x28,
+		0x00, 0x2d, 0xb8, 0xb0, 0xb2, 0x56, 0xd8, 0x4c, 0x47, 0x9c, 0xe7, 0xe5, 0x2b, 0x9b, 0xa7, 0x1f,
+		0x4d, 0x47, 0x1d, 0x86, 0xab, 0xac, 0x20, 0xe6, 0x25, 0x78, 0xe4, 0x64, 0x7a, 0x10, 0xa5, 0x21,
+		0xc5, 0x00, 0x96, 0x9f, 0x2a, 0x2d, 0x9e, 0x7e, 0x34, 0x1d, 0xf7, 0x97, 0x3c, 0x72, 0x32, 0x3d,
+		0x84, 0x46, 0x20, 0x49, 0x4b, 0x8a, 0x6b, 0x6b, 0x2b, 0xe9, 0x84, 0x83, 0xb9, 0xb1, 0x29, 0x2f,
+		0x5f, 0x59, 0x4a, 0x27, 0x1d, 0xcc, 0x25, 0x79, 0xed, 0xea, 0x7a, 0x1a, 0x1c, 0x84, 0xd5, 0xd2,
+		0xc6, 0x46, 0x61, 0xa9, 0x94, 0x1e, 0x76, 0x28, 0x8a, 0x4f, 0x6f, 0x96, 0x36, 0xd2, 0x29, 0x9f,
+		0x58, 0x8f, 0x9c, 0x4c, 0x8f, 0x38, 0xaf, 0x28, 0x5d, 0xb9, 0xba, 0x9a, 0x1e, 0x45, 0xe3, 0x30,
+		0xc2, 0x5e, 0x21, 0x84, 0x18, 0x6b, 0x29, 0x3a, 0xfd, 0x68, 0x3a, 0xed, 0x0a, 0xc2, 0x50, 0xc6,
+		0x7d, 0x05, 0xa7, 0x1f, 0x4d, 0xa3, 0xdc, 0x02, 0x0c, 0x52, 0x33, 0x44, 0x08, 0x46, 0x57, 0x0a,
+		0xc5, 0xd2, 0x4a, 0x79, 0x6d, 0x7d, 0x73, 0x79, 0xed, 0x4a, 0x61, 0x25, 0x2d, 0xb9, 0x65, 0x72,
+		0xe9, 0x89, 0xab, 0xcb, 0x72, 0x69, 0x31, 0x1d, 0xf1, 0x96, 0xad, 0x97, 0x0a, 0x9b, 0xa5, 0xc5,
+		0x74, 0x34, 0x57, 0x81, 0xc9, 0x20, 0x87, 0x1a, 0x38, 0x84, 0x3c, 0xb6, 0x10, 0xe9, 0x60, 0x0b,
+		0x14, 0xab, 0xd5, 0x16, 0x72, 0xdf, 0x8e, 0xc0, 0x44, 0xc0, 0xa4, 0x12, 0xf8, 0x92, 0xc7, 0x60,
+		0x90, 0xd9, 0x32, 0x9b, 0x66, 0x1f, 0x0c, 0x9c, 0x9d, 0xa8, 0x65, 0xb7, 0x4d, 0xb5, 0x94, 0xcf,
+		0x1b, 0x6a, 0x44, 0x3b, 0x84, 0x1a, 0x04, 0xa2, 0xcd, 0x60, 0x7f, 0xb6, 0xcd, 0xf9, 0xb3, 0xf9,
+		0xf1, 0x74, 0x2f, 0xf3, 0x23, 0x2d, 0xeb, 0x6f, 0x12, 0x18, 0x0c, 0x98, 0x04, 0xce, 0xc3, 0x78,
+		0x1b, 0x50, 0xcf, 0xce, 0xf8, 0x25, 0x09, 0x32, 0x9d, 0x94, 0x13, 0xe2, 0x12, 0x23, 0x3e, 0x97,
+		0x78, 0xbe, 0x55, 0x83, 0xf7, 0x74, 0xee, 0x84, 0xb6, 0xbe, 0xfe, 0xb8, 0x04, 0x07, 0x83, 0x43,
+		0xca, 0x40, 0x19, 0xde, 0x0e, 0xf1, 0x06, 0xb6, 0x77, 0x74, 0x11, 0x56, 0x3d, 0x10, 0x30, 0x59,
+		0x93, 0xea, 0xd6, 0xce, 0xe6, 0x5c, 0xe8, 0x5c, 0xab, 0xac, 0x33, 0x9d, 0x02, 0xdc, 0x36, 0x49,
+		0xdf, 0x1d, 0x81, 0x03, 0x81, 0xe0, 0x81, 0x82, 0x1e, 0x06, 0x50, 0x35, 0xa3, 0x69, 0xb3, 0xd0,
+		0x89, 0x79, 0xe2, 0x24, 0x2d, 0xa1, 0xce, 0x8b, 0x78, 0xd9, 0xa6, 0xed, 0xd4, 0x47, 0x69, 0x3d,
+		0xb0, 0x22, 0x4a, 0x70, 0xd6, 0x15, 0x34, 0x46, 0x05, 0x9d, 0xee, 0xd0, 0xd2, 0x36, 0xc3, 0x7c,
+		0x18, 0xd2, 0x95, 0xba, 0x8a, 0x35, 0xbb, 0x6c, 0xd9, 0x26, 0x56, 0x1a, 0xaa, 0x56, 0xa3, 0x53,
+		0x4d, 0x22, 0x3f, 0xb8, 0xad, 0xd4, 0x2d, 0x2c, 0x8f, 0xb1, 0xea, 0x0d, 0x51, 0x4b, 0x38, 0xa8,
+		0x01, 0x99, 0x1e, 0x8e, 0xb8, 0x8f, 0x83, 0x55, 0x3b, 0x1c, 0xb9, 0x5f, 0x4c, 0xc2, 0xb0, 0x27,
+		0x00, 0x47, 0xf7, 0x40, 0xea, 0x59, 0xe5, 0x86, 0x52, 0x16, 0x8b, 0x2a, 0xa6, 0x89, 0x61, 0x52,
+		0xb6, 0xce, 0x8a, 0xd0, 0xc3, 0x30, 0x49, 0x49, 0xf4, 0xa6, 0x8d, 0xcd, 0x72, 0xa5, 0xae, 0x58,
+		0x16, 0x55, 0x5a, 0x82, 0x92, 0x22, 0x52, 0xb7, 0x46, 0xaa, 0x16, 0x44, 0x0d, 0x3a, 0x05, 0x13,
+		0x94, 0xa3, 0xd1, 0xac, 0xdb, 0xaa, 0x51, 0xc7, 0x65, 0xb2, 0xcc, 0xb3, 0x32, 0xe0, 0x95, 0x6c,
+		0x9c, 0x50, 0xac, 0x72, 0x02, 0x22, 0x91, 0x85, 0x16, 0xe1, 0x30, 0x65, 0xab, 0x61, 0x0d, 0x9b,
+		0x8a, 0x8d, 0xcb, 0xf8, 0xb9, 0xa6, 0x52, 0xb7, 0xca, 0x8a, 0x56, 0x2d, 0xef, 0x28, 0xd6, 0x4e,
+		0x66, 0x92, 0x00, 0x14, 0x23, 0x19, 0x49, 0x9e, 0x22, 0x84, 0x4b, 0x9c, 0xae, 0x44, 0xc9, 0x0a,
+		0x5a, 0xf5, 0xa2, 0x62, 0xed, 0xa0, 0x3c, 0x1c, 0xa4, 0x28, 0x96, 0x6d, 0xaa, 0x5a, 0xad, 0x5c,
+		0xd9, 0xc1, 0x95, 0xeb, 0xe5, 0xa6, 0xbd, 0x7d, 0x36, 0x73, 0xb7, 0xf7, 0xfd, 0x54, 0xc2, 0x0d,
+		0x4a, 0xb3, 0x40, 0x48, 0xae, 0xda, 0xdb, 0x67, 0xd1, 0x06, 0xa4, 0x48, 0x67, 0x34, 0xd4, 0xe7,
+		0x71, 0x79, 0x5b, 0x37, 0xe9, 0x1c, 0x3a, 0x1a, 0xe0, 0x9a, 0x3c, 0x1a, 0x9c, 0x5b, 0xe3, 0x0c,
+		0xab, 0x7a, 0x15, 0xe7, 0x07, 0x37, 0xd6, 0x4b, 0xa5, 0x45, 0x79, 0x58, 0xa0, 0x5c, 0xd0, 0x4d,
+		0x62, 0x50, 0x35, 0xdd, 0x51, 0xf0, 0x30, 0x33, 0xa8, 0x9a, 0x2e, 0xd4, 0x7b, 0x0a, 0x26, 0x2a,
+		0x15, 0xd6, 0x66, 0xb5, 0x52, 0xe6, 0x8b, 0x31, 0x2b, 0x93, 0xf6, 0x29, 0xab, 0x52, 0x59, 0x62,
+		0x04, 0xdc, 0xc6, 0x2d, 0x74, 0x0e, 0x0e, 0xb8, 0xca, 0xf2, 0x32, 0x8e, 0xb7, 0xb5, 0xb2, 0x95,
+		0xf5, 0x14, 0x4c, 0x18, 0xbb, 0xed, 0x8c, 0xc8, 0xf7, 0x46, 0x63, 0xb7, 0x95, 0xed, 0x0c, 0x4c,
+		0x1a, 0x3b, 0x46, 0x3b, 0xdf, 0x31, 0x2f, 0x1f, 0x32, 0x76, 0x8c, 0x56, 0xc6, 0xfb, 0xe9, 0xca,
+		0xdc, 0xc4, 0x15, 0xc5, 0xc6, 0xd5, 0xcc, 0x5d, 0x5e, 0x72, 0x4f, 0x05, 0x9a, 0x83, 0x74, 0xa5,
+		0x52, 0xc6, 0x9a, 0xb2, 0x55, 0xc7, 0x65, 0xc5, 0xc4, 0x9a, 0x62, 0x65, 0x66, 0x28, 0x71, 0xcc,
+		0x36, 0x9b, 0x58, 0x1e, 0xad, 0x54, 0x4a, 0xb4, 0xb2, 0x40, 0xeb, 0xd0, 0x31, 0x18, 0xd7, 0xb7,
+		0x9e, 0xad, 0x30, 0x8b, 0x2c, 0x1b, 0x26, 0xde, 0x56, 0x6f, 0x65, 0xee, 0xa3, 0xea, 0x1d, 0x23,
+		0x15, 0xd4, 0x1e, 0xd7, 0x69, 0x31, 0x7a, 0x10, 0xd2, 0x15, 0x6b, 0x47, 0x31, 0x0d, 0xea, 0x92,
+		0x2d, 0x43, 0xa9, 0xe0, 0xcc, 0xfd, 0x8c, 0x94, 0x95, 0x5f, 0x11, 0xc5, 0x64, 0x44, 0x58, 0x37,
+		0xd5, 0x6d, 0x5b, 0x20, 0x1e, 0x61, 0x23, 0x82, 0x96, 0x71, 0xb4, 0xa3, 0x90, 0x26, 0x9a, 0xf0,
+		0xbd, 0xf8, 0x28, 0x25, 0x1b, 0x35, 0x76, 0x0c, 0xef, 0x7b, 0xef, 0x85, 0x11, 0x63, 0xc7, 0xfb,
+		0xd2, 0x07, 0x59, 0xe0, 0x66, 0xec, 0x78, 0xde, 0xf8, 0x28, 0x1c, 0x24, 0x44, 0x0d, 0x6c, 0x2b,
+		0x55, 0xc5, 0x56, 0x3c, 0xd4, 0x6f, 0xa1, 0xd4, 0x44, 0xed, 0xab, 0xbc, 0xd2, 0x27, 0xa7, 0xd9,
+		0xdc, 0xda, 0x75, 0x0c, 0xeb, 0x38, 0x93, 0x93, 0x94, 0x09, 0xd3, 0xba, 0x63, 0xc1, 0x79, 0x2e,
+		0x0f, 0x29, 0xaf, 0xdd, 0xa3, 0x24, 0x30, 0xcb, 0x4f, 0x4b, 0x24, 0x08, 0x5a, 0x58, 0x5b, 0x24,
+		0xe1, 0xcb, 0x33, 0xa5, 0x74, 0x84, 0x84, 0x51, 0x2b, 0xcb, 0x9b, 0xa5, 0xb2, 0x7c, 0xf5, 0xca,
+		0xe6, 0xf2, 0x6a, 0x29, 0x1d, 0xf5, 0x04, 0xf6, 0x97, 0x62, 0x89, 0x07, 0xd2, 0x47, 0x48, 0xd4,
+		0x30, 0xea, 0x5f, 0xa9, 0xa1, 0xb7, 0xc2, 0x5d, 0x22, 0xad, 0x62, 0x61, 0xbb, 0x7c, 0x53, 0x35,
+		0xe9, 0x80, 0x6c, 0x28, 0x6c, 0x72, 0x74, 0xec, 0x67, 0x92, 0x53, 0x6d, 0x60, 0xfb, 0x49, 0xd5,
+		0x24, 0xc3, 0xad, 0xa1, 0xd8, 0x68, 0x05, 0x66, 0x34, 0xbd, 0x6c, 0xd9, 0x8a, 0x56, 0x55, 0xcc,
+		0x6a, 0xd9, 0x4d, 0x68, 0x95, 0x95, 0x4a, 0x05, 0x5b, 0x96, 0xce, 0x26, 0x42, 0x07, 0xe5, 0x90,
+		0xa6, 0x6f, 0x70, 0x62, 0x77, 0x86, 0x28, 0x70, 0xd2, 0x16, 0xf3, 0x8d, 0x76, 0x32, 0xdf, 0xbb,
+		0x21, 0xd9, 0x50, 0x8c, 0x32, 0xd6, 0x6c, 0x73, 0x97, 0xc6, 0xe7, 0x09, 0x39, 0xd1, 0x50, 0x8c,
+		0x12, 0x79, 0xfe, 0x89, 0x2c, 0x93, 0x2e, 0xc5, 0x12, 0xb1, 0xf4, 0xe0, 0xa5, 0x58, 0x62, 0x30,
+		0x1d, 0xbf, 0x14, 0x4b, 0xc4, 0xd3, 0x43, 0x97, 0x62, 0x89, 0x44, 0x3a, 0x79, 0x29, 0x96, 0x48,
+		0xa6, 0x21, 0xf7, 0x5a, 0x14, 0x52, 0xde, 0x08, 0x9e, 0x2c, 0x88, 0x2a, 0x74, 0x0e, 0x93, 0xa8,
+		0x97, 0xbb, 0xb7, 0x6b, 0xbc, 0x3f, 0xb7, 0x40, 0x26, 0xb7, 0x7c, 0x9c, 0x85, 0xcb, 0x32, 0xe3,
+		0x24, 0x81, 0x05, 0x31, 0x3f, 0xcc, 0xc2, 0x93, 0x84, 0xcc, 0x9f, 0xd0, 0x12, 0xc4, 0x9f, 0xb5,
+		0x28, 0x76, 0x9c, 0x62, 0xdf, 0xd7, 0x1d, 0xfb, 0xd2, 0x06, 0x05, 0x4f, 0x5e, 0xda, 0x28, 0x5f,
+		0x59, 0x93, 0x57, 0x0b, 0x2b, 0x32, 0x67, 0x47, 0x53, 0x10, 0xab, 0x2b, 0xcf, 0xef, 0xfa, 0xa7,
+		0x41, 0x5a, 0xd4, 0x6b, 0xb7, 0x4c, 0x41, 0x8c, 0xa4, 0xec, 0xfc, 0x93, 0x0f, 0x2d, 0xba, 0x83,
+		0xc3, 0x63, 0x1e, 0x06, 0xa9, 0xbe, 0x10, 0x00, 0xd7, 0x58, 0x7a, 0x00, 0x25, 0x20, 0xb6, 0xb0,
+		0x26, 0x93, 0x21, 0x92, 0x86, 0x14, 0x2b, 0x2d, 0xaf, 0x2f, 0x97, 0x16, 0x4a, 0xe9, 0x48, 0xee,
+		0x14, 0xc4, 0x99, 0x12, 0xc8, 0xf0, 0x71, 0xd4, 0x90, 0x1e, 0xe0, 0x8f, 0x1c, 0x43, 0x12, 0xb5,
+		0x57, 0x57, 0x8b, 0x25, 0x39, 0x1d, 0x69, 0xeb, 0xfc, 0x9c, 0x05, 0x29, 0x6f, 0x64, 0xfe, 0x93,
+		0x59, 0x9e, 0x7f, 0x41, 0x82, 0x61, 0x4f, 0xa4, 0x4d, 0x42, 0x24, 0xa5, 0x5e, 0xd7, 0x6f, 0x96,
+		0x95, 0xba, 0xaa, 0x58, 0xdc, 0x34, 0x80, 0x16, 0x15, 0x48, 0x49, 0xaf, 0x5d, 0xf7, 0x13, 0x1a,
+		0x34, 0x83, 0xe9, 0x78, 0xee, 0x83, 0x12, 0xa4, 0x5b, 0x43, 0xdd, 0x16, 0x31, 0xa5, 0x3f, 0x4d,
+		0x31, 0x73, 0x1f, 0x90, 0x60, 0xd4, 0x1f, 0xdf, 0xb6, 0x88, 0x77, 0xcf, 0x9f, 0xaa, 0x78, 0xdf,
+		0x8a, 0xc0, 0x88, 0x2f, 0xaa, 0xed, 0x55, 0xba, 0xe7, 0x60, 0x5c, 0xad, 0xe2, 0x86, 0xa1, 0xdb,
+		0x24, 0x9d, 0x5e, 0xae, 0xe3, 0x1b, 0xb8, 0x9e, 0xc9, 0x51, 0xa7, 0x31, 0xdf, 0x3d, 0x6e, 0x9e,
+		0x5b, 0x76, 0xf9, 0x56, 0x08, 0x5b, 0x7e, 0x62, 0x79, 0xb1, 0xb4, 0xba, 0xbe, 0xb6, 0x59, 0xba,
+		0xb2, 0xf0, 0x74, 0xf9, 0xea, 0x95, 0xcb, 0x57, 0xd6, 0x9e, 0xbc, 0x22, 0xa7, 0xd5, 0x16, 0xb2,
+		0x3b, 0x38, 0xec, 0xd7, 0x21, 0xdd, 0x2a, 0x14, 0xba, 0x0b, 0x82, 0xc4, 0x4a, 0x0f, 0xa0, 0x09,
+		0x18, 0xbb, 0xb2, 0x56, 0xde, 0x58, 0x5e, 0x2c, 0x95, 0x4b, 0x17, 0x2e, 0x94, 0x16, 0x36, 0x37,
+		0x58, 0x26, 0xc4, 0xa1, 0xde, 0xf4, 0x0d, 0xf0, 0xdc, 0xfb, 0xa2, 0x30, 0x11, 0x20, 0x09, 0x2a,
+		0xf0, 0x35, 0x0c, 0x5b, 0x56, 0x1d, 0xef, 0x45, 0xfa, 0x39, 0x12, 0x45, 0xac, 0x2b, 0xa6, 0xcd,
+		0x97, 0x3c, 0x0f, 0x02, 0xd1, 0x92, 0x66, 0xab, 0xdb, 0x2a, 0x36, 0x79, 0x86, 0x89, 0x2d, 0x6c,
+		0xc6, 0xdc, 0x72, 0x96, 0x64, 0x7a, 0x0b, 0x20, 0x43, 0xb7, 0x54, 0x5b, 0xbd, 0x41, 0x92, 0xf4,
+		0x22, 0x1d, 0x45, 0x16, 0x3a, 0x31, 0x39, 0x2d, 0x6a, 0x96, 0x35, 0xdb, 0xa1, 0xd6, 0x70, 0x4d,
+		0x69, 0xa1, 0x26, 0xce, 0x3c, 0x2a, 0xa7, 0x45, 0x8d, 0x43, 0x7d, 0x0f, 0xa4, 0xaa, 0x7a, 0x93,
+		0x44, 0x7f, 0x8c, 0x8e, 0xcc, 0x1d, 0x92, 0x3c, 0xcc, 0xca, 0x1c, 0x12, 0x1e, 0xd7, 0xbb, 0x79,
+		0xb0, 0x94, 0x3c, 0xcc, 0xca, 0x18, 0xc9, 0x11, 0x18, 0x53, 0x6a, 0x35, 0x93, 0x80, 0x0b, 0x20,
+		0xb6, 0x52, 0x19, 0x75, 0x8a, 0x29, 0x61, 0xf6, 0x12, 0x24, 0x84, 0x1e, 0xc8, 0xe4, 0x4d, 0x34,
+		0x51, 0x36, 0xd8, 0xf2, 0x3b, 0x42, 0x52, 0x63, 0x9a, 0xa8, 0xbc, 0x07, 0x52, 0xaa, 0x55, 0x76,
+		0xd3, 0xfa, 0x91, 0xd9, 0xc8, 0xd1, 0x84, 0x3c, 0xac, 0x5a, 0x4e, 0x4a, 0x34, 0xf7, 0xf1, 0x08,
+		0x8c, 0xfa, 0xb7, 0x25, 0xd0, 0x22, 0x24, 0xea, 0x7a, 0x45, 0xa1, 0xa6, 0xc5, 0xf6, 0xc4, 0x8e,
+		0x86, 0xec, 0x64, 0xcc, 0xad, 0x70, 0x7a, 0xd9, 0xe1, 0xcc, 0xfe, 0x6b, 0x09, 0x12, 0xa2, 0x18,
+		0x1d, 0x84, 0x98, 0xa1, 0xd8, 0x3b, 0x14, 0x6e, 0xb0, 0x18, 0x49, 0x4b, 0x32, 0x7d, 0x26, 0xe5,
+		0x96, 0xa1, 0x68, 0x99, 0x88, 0x5b, 0x4e, 0x9e, 0x49, 0xbf, 0xd6, 0xb1, 0x52, 0xa5, 0xcb, 0x20,
+		0xbd, 0xd1, 0xc0, 0x9a, 0x6d, 0x89, 0x7e, 0xe5, 0xe5, 0x0b, 0xbc, 0x98, 0xec, 0x8e, 0xd9, 0xa6,
+		0xa2, 0xd6, 0x7d, 0xb4, 0x31, 0x4a, 0x9b, 0x16, 0x15, 0x0e, 0x71, 0x1e, 0xa6, 0x04, 0x6e, 0x15,
+		0xdb, 0x4a, 0x65, 0x07, 0x57, 0x5d, 0xa6, 0x38, 0x4d, 0x77, 0xdc, 0xc5, 0x09, 0x16, 0x79, 0xbd,
+		0xe0, 0xcd, 0x7d, 0x5d, 0x82, 0x71, 0xb1, 0x70, 0xab, 0x3a, 0xca, 0x5a, 0x05, 0x50, 0x34, 0x4d,
+		0xb7, 0xbd, 0xea, 0x6a, 0x37, 0xe5, 0x36, 0xbe, 0xb9, 0x82, 0xc3, 0x24, 0x7b, 0x00, 0xb2, 0x0d,
+		0x00, 0xb7, 0xa6, 0xa3, 0xda, 0x66, 0x60, 0x98, 0xef, 0x39, 0xd1, 0x8d, 0x4b, 0xb6, 0xd4, 0x07,
+		0x56, 0x44, 0x56, 0x78, 0x24, 0x21, 0xb3, 0x85, 0x6b, 0xaa, 0xc6, 0x33, 0xc9, 0xec, 0x41, 0x24,
+		0x64, 0x62, 0x4e, 0x42, 0xa6, 0xf8, 0x97, 0x60, 0xa2, 0xa2, 0x37, 0x5a, 0xc5, 0x2d, 0xa6, 0x5b,
+		0xd2, 0x0d, 0xd6, 0x45, 0xe9, 0x99, 0xe3, 0x9c, 0xa8, 0xa6, 0xd7, 0x15, 0xad, 0x36, 0xa7, 0x9b,
+		0x35, 0x77, 0xe3, 0x95, 0x44, 0x3c, 0x96, 0x67, 0xfb, 0xd5, 0xd8, 0xfa, 0x3f, 0x92, 0xf4, 0x91,
+		0x48, 0x74, 0x69, 0xbd, 0xf8, 0x89, 0x48, 0x76, 0x89, 0x31, 0xae, 0x0b, 0x65, 0xc8, 0x78, 0xbb,
+		0x8e, 0x2b, 0xa4, 0x81, 0xf0, 0xfd, 0x87, 0x60, 0xb2, 0xa6, 0xd7, 0x74, 0x8a, 0x34, 0x4f, 0xfe,
+		0xe3, 0x3b, 0xb7, 0x49, 0xa7, 0x34, 0x1b, 0xba, 0xcd, 0x9b, 0xbf, 0x02, 0x13, 0x9c, 0xb8, 0x4c,
+		0xb7, 0x8e, 0xd8, 0xc2, 0x06, 0x75, 0xcd, 0xaa, 0x65, 0x7e, 0xe3, 0x3b, 0x74, 0xfa, 0x96, 0xc7,
+		0x39, 0x2b, 0xa9, 0x63, 0x6b, 0x9f, 0xbc, 0x0c, 0x07, 0x7c, 0x78, 0x6c, 0x90, 0x62, 0x33, 0x04,
+		0xf1, 0x4b, 0x1c, 0x71, 0xc2, 0x83, 0xb8, 0xc1, 0x59, 0xf3, 0x0b, 0x30, 0xd2, 0x0f, 0xd6, 0xbf,
+		0xe2, 0x58, 0x29, 0xec, 0x05, 0x59, 0x82, 0x31, 0x0a, 0x52, 0x69, 0x5a, 0xb6, 0xde, 0xa0, 0x1e,
+		0xb0, 0x3b, 0xcc, 0xef, 0x7e, 0x87, 0x8d, 0x9a, 0x51, 0xc2, 0xb6, 0xe0, 0x70, 0xe5, 0xf3, 0x40,
+		0x77, 0xcb, 0xc8, 0x2e, 0x56, 0x08, 0xc2, 0x97, 0xb9, 0x20, 0x0e, 0x7d, 0xfe, 0x1a, 0x4c, 0x92,
+		0xff, 0xa9, 0x83, 0xf2, 0x4a, 0x12, 0x9e, 0x82, 0xcb, 0x7c, 0xfd, 0x25, 0x36, 0x30, 0x27, 0x1c,
+		0x00, 0x8f, 0x4c, 0x9e, 0x5e, 0xac, 0x61, 0xdb, 0xc6, 0xa6, 0x55, 0x56, 0xea, 0x41, 0xe2, 0x79,
+		0x72, 0x18, 0x99, 0xf7, 0xfe, 0xc0, 0xdf, 0x8b, 0x4b, 0x8c, 0xb3, 0x50, 0xaf, 0xe7, 0xaf, 0xc2,
+		0x5d, 0x01, 0x56, 0xd1, 0x03, 0xe6, 0xfb, 0x38, 0xe6, 0x64, 0x9b, 0x65, 0x10, 0xd8, 0x75, 0x10,
+		0xe5, 0x4e, 0x5f, 0xf6, 0x80, 0xf9, 0x7e, 0x8e, 0x89, 0x38, 0xaf, 0xe8, 0x52, 0x82, 0x78, 0x09,
+		0xc6, 0x6f, 0x60, 0x73, 0x4b, 0xb7, 0x78, 0xde, 0xa8, 0x07, 0xb8, 0x0f, 0x70, 0xb8, 0x31, 0xce,
+		0x48, 0x13, 0x49, 0x04, 0xeb, 0x1c, 0x24, 0xb6, 0x95, 0x0a, 0xee, 0x01, 0xe2, 0x36, 0x87, 0x18,
+		0x22, 0xf4, 0x84, 0xb5, 0x00, 0xa9, 0x9a, 0xce, 0xe7, 0xa8, 0x70, 0xf6, 0x0f, 0x72, 0xf6, 0x61,
+		0xc1, 0xc3, 0x21, 0x0c, 0xdd, 0x68, 0xd6, 0xc9, 0x04, 0x16, 0x0e, 0xf1, 0xf7, 0x04, 0x84, 0xe0,
+		0xe1, 0x10, 0x7d, 0xa8, 0xf5, 0x43, 0x02, 0xc2, 0xf2, 0xe8, 0xf3, 0x31, 0xb2, 0x9d, 0x54, 0xdf,
+		0xd5, 0xb5, 0x5e, 0x84, 0xf8, 0x30, 0x47, 0x00, 0xce, 0x42, 0x00, 0xce, 0x43, 0xb2, 0xd7, 0x8e,
+		0xf8, 0xfb, 0x3f, 0x10, 0xc3, 0x43, 0xf4, 0xc0, 0x12, 0x8c, 0x09, 0x07, 0x45, 0xb6, 0x9f, 0xc3,
+		0x21, 0xfe, 0x01, 0x87, 0x18, 0xf5, 0xb0, 0xf1, 0x66, 0xd8, 0xd8, 0xb2, 0x6b, 0xb8, 0x17, 0x90,
+		0x8f, 0x8b, 0x66, 0x70, 0x16, 0xae, 0xca, 0x2d, 0xac, 0x55, 0x76, 0x7a, 0x43, 0xf8, 0x55, 0xa1,
+		0x4a, 0xc1, 0x43, 0x20, 0x16, 0x60, 0xa4, 0xa1, 0x98, 0xd6, 0x8e, 0x52, 0xef, 0xa9, 0x3b, 0xfe,
+		0x21, 0xc7, 0x48, 0x39, 0x4c, 0x5c, 0x23, 0x4d, 0xad, 0x1f, 0x98, 0x4f, 0x08, 0x8d, 0x34, 0x35,
+		0x1f, 0xd0, 0x3a, 0x4c, 0x5a, 0x36, 0x4d, 0xb2, 0xf5, 0x83, 0xf6, 0x6b, 0x62, 0xe8, 0x31, 0xde,
+		0x55, 0x2f, 0xe2, 0x79, 0x48, 0x5a, 0xea, 0xf3, 0x3d, 0xc1, 0x7c, 0x52, 0xf4, 0x34, 0x65, 0x20,
+		0xcc, 0x4f, 0xc3, 0x54, 0xe0, 0x34, 0xd1, 0x03, 0xd8, 0xaf, 0x73, 0xb0, 0x83, 0x01, 0x53, 0x05,
+		0x77, 0x09, 0xfd, 0x42, 0xfe, 0x23, 0xe1, 0x12, 0x70, 0x0b, 0xd6, 0x3a, 0x59, 0x35, 0x58, 0xca,
+		0x76, 0x7f, 0x5a, 0xfb, 0xc7, 0x42, 0x6b, 0x8c, 0xd7, 0xa7, 0xb5, 0x4d, 0x38, 0xc8, 0x11, 0xfb,
+		0xeb, 0xd7, 0x4f, 0x09, 0xc7, 0xca, 0xb8, 0xaf, 0xfa, 0x7b, 0xf7, 0x1d, 0x90, 0x75, 0xd4, 0x29,
+		0xc2, 0x53, 0xab, 0x4c, 0x32, 0x53, 0xe1, 0xc8, 0xbf, 0xc1, 0x91, 0x85, 0xc7, 0x77, 0xe2, 0x5b,
+		0x6b, 0x55, 0x31, 0x08, 0xf8, 0x53, 0x90, 0x11, 0xe0, 0x4d, 0xcd, 0xc4, 0x15, 0xbd, 0xa6, 0xa9,
+		0xcf, 0xe3, 0x6a, 0x0f, 0xd0, 0x9f, 0x6e, 0xe9, 0xaa, 0xab, 0x1e, 0x76, 0x82, 0xbc, 0x0c, 0x69,
+		0x27, 0x56, 0x29, 0xab, 0x0d, 0x43, 0x37, 0xed, 0x10, 0xc4, 0xcf, 0x88, 0x9e, 0x72, 0xf8, 0x96,
+		0x29, 0x5b, 0xbe, 0x04, 0x6c, 0xe7, 0xb9, 0x57, 0x93, 0x7c, 0x85, 0x03, 0x8d, 0xb8, 0x5c, 0xdc,
+		0x71, 0x54, 0xf4, 0x86, 0xa1, 0x98, 0xbd, 0xf8, 0xbf, 0xcf, 0x0a, 0xc7, 0xc1, 0x59, 0xb8, 0xe3,
+		0x20, 0x11, 0x1d, 0x99, 0xed, 0x7b, 0x40, 0xf8, 0x9c, 0x70, 0x1c, 0x82, 0x87, 0x43, 0x88, 0x80,
+		0xa1, 0x07, 0x88, 0x7f, 0x22, 0x20, 0x04, 0x0f, 0x81, 0x78, 0xc2, 0x9d, 0x68, 0x4d, 0x5c, 0x53,
+		0x2d, 0xdb, 0x64, 0x41, 0x71, 0x77, 0xa8, 0x7f, 0xfa, 0x03, 0x7f, 0x10, 0x26, 0x7b, 0x58, 0x89,
+		0x27, 0xe2, 0x69, 0x57, 0xba, 0x66, 0x0a, 0x17, 0xec, 0x37, 0x85, 0x27, 0xf2, 0xb0, 0x11, 0xd9,
+		0x3c, 0x11, 0x22, 0x51, 0x7b, 0x85, 0xac, 0x14, 0x7a, 0x80, 0xfb, 0x7c, 0x8b, 0x70, 0x1b, 0x82,
+		0x97, 0x60, 0x7a, 0xe2, 0x9f, 0xa6, 0x76, 0x1d, 0xef, 0xf6, 0x64, 0x9d, 0xbf, 0xd5, 0x12, 0xff,
+		0x5c, 0x65, 0x9c, 0xcc, 0x87, 0x8c, 0xb5, 0xc4, 0x53, 0x28, 0xec, 0x9c, 0x51, 0xe6, 0xe7, 0x5e,
+		0xe7, 0xed, 0xf5, 0x87, 0x53, 0xf9, 0x15, 0x48, 0xf3, 0x12, 0x37, 0x80, 0x0d, 0x05, 0x7b, 0xe9,
+		0x75, 0xc7, 0xce, 0x7d, 0x31, 0x4f, 0xfe, 0x02, 0x8c, 0xf8, 0x02, 0x9e, 0x70, 0xa8, 0xbf, 0xcc,
+		0xa1, 0x52, 0xde, 0x78, 0x27, 0x7f, 0x0a, 0x62, 0x24, 0x78, 0x09, 0x67, 0xff, 0x2b, 0x9c, 0x9d,
+		0x92, 0xe7, 0xdf, 0x06, 0x09, 0x11, 0xb4, 0x84, 0xb3, 0xfe, 0x02, 0x67, 0x75, 0x58, 0x08, 0xbb,
+		0x08, 0x58, 0xc2, 0xd9, 0xff, 0xaa, 0x60, 0x17, 0x2c, 0x84, 0xbd, 0x77, 0x15, 0x7e, 0xe1, 0xaf,
+		0xc5, 0x18, 0xbb, 0x60, 0xc9, 0x93, 0x9d, 0x6f, 0x16, 0xa9, 0x84, 0x73, 0xbf, 0x9b, 0xbf, 0x5c,
+		0x70, 0xe4, 0xcf, 0xc0, 0x60, 0x8f, 0x0a, 0xff, 0x1b, 0x9c, 0x95, 0xd1, 0xe7, 0x17, 0x60, 0xd8,
+		0x13, 0x9d, 0x84, 0xb3, 0xff, 0x4d, 0xce, 0xee, 0xe5, 0x22, 0xa2, 0xf3, 0xe8, 0x24, 0x1c, 0xe0,
+		0x6f, 0x09, 0xd1, 0x39, 0x07, 0x51, 0x9b, 0x08, 0x4c, 0xc2, 0xb9, 0xdf, 0x23, 0xb4, 0x2e, 0x58,
+		0xf2, 0x8f, 0x41, 0xd2, 0x99, 0x6c, 0xc2, 0xf9, 0x7f, 0x91, 0xf3, 0xbb, 0x3c, 0x44, 0x03, 0x4d,
+		0xad, 0x0f, 0x88, 0xbf, 0x2d, 0x34, 0xe0, 0xe1, 0x22, 0xc3, 0xa8, 0x35, 0x80, 0x09, 0x47, 0xfa,
+		0x25, 0x31, 0x8c, 0x5a, 0xe2, 0x17, 0xd2, 0x9b, 0xd4, 0xe7, 0x87, 0x43, 0xfc, 0x1d, 0xd1, 0x9b,
+		0x94, 0x9e, 0x88, 0xd1, 0x1a, 0x11, 0x84, 0x63, 0xfc, 0xb2, 0x10, 0xa3, 0x25, 0x20, 0xc8, 0xaf,
+		0x03, 0x6a, 0x8f, 0x06, 0xc2, 0xf1, 0x7e, 0x85, 0xe3, 0x8d, 0xb7, 0x05, 0x03, 0xf9, 0x27, 0xe1,
+		0x60, 0x70, 0x24, 0x10, 0x8e, 0xfa, 0xde, 0xd7, 0x5b, 0xd6, 0x6e, 0xde, 0x40, 0x20, 0xbf, 0x09,
+		0x93, 0x41, 0x51, 0x40, 0x38, 0xec, 0xfb, 0x5e, 0xf7, 0x3b, 0x6e, 0x6f, 0x10, 0x90, 0x2f, 0x00,
+		0xb8, 0x13, 0x70, 0x38, 0xd6, 0x07, 0x38, 0x96, 0x87, 0x89, 0x0c, 0x0d, 0x3e, 0xff, 0x86, 0xf3,
+		0xdf, 0x16, 0x43, 0x83, 0x73, 0x90, 0xa1, 0x21, 0xa6, 0xde, 0x70, 0xee, 0x0f, 0x8a, 0xa1, 0x21,
+		0x58, 0x88, 0x65, 0x7b, 0x66, 0xb7, 0x70, 0x84, 0x0f, 0x0b, 0xcb, 0xf6, 0x70, 0xe5, 0xaf, 0xc0,
+		0x78, 0xdb, 0x84, 0x18, 0x0e, 0xf5, 0x11, 0x0e, 0x95, 0x6e, 0x9d, 0x0f, 0xbd, 0x93, 0x17, 0x9f,
+		0x0c, 0xc3, 0xd1, 0x3e, 0xda, 0x32, 0x79, 0xf1, 0xb9, 0x30, 0x7f, 0x1e, 0x12, 0x5a, 0xb3, 0x5e,
+		0x27, 0x83, 0x07, 0x75, 0x3f, 0x1b, 0x98, 0xf9, 0xcf, 0x3f, 0xe2, 0xda, 0x11, 0x0c, 0xf9, 0x53,
+		0x30, 0x88, 0x1b, 0x5b, 0xb8, 0x1a, 0xc6, 0xf9, 0xfd, 0x1f, 0x09, 0x87, 0x49, 0xa8, 0xf3, 0x8f,
+		0x01, 0xb0, 0xd4, 0x08, 0xdd, 0x0c, 0x0c, 0xe1, 0xfd, 0x2f, 0x3f, 0xe2, 0x87, 0x71, 0x5c, 0x16,
+		0x17, 0x80, 0x1d, 0xed, 0xe9, 0x0e, 0xf0, 0x03, 0x3f, 0x00, 0xed, 0x91, 0x73, 0x30, 0x44, 0x8e,
+		0x48, 0xda, 0x4a, 0x2d, 0x8c, 0xfb, 0xbf, 0x72, 0x6e, 0x41, 0x4f, 0x14, 0xd6, 0xd0, 0x4d, 0x6c,
+		0x2b, 0x35, 0x2b, 0x8c, 0xf7, 0xbf, 0x71, 0x5e, 0x87, 0x81, 0x30, 0x57, 0x14, 0xcb, 0xee, 0xa5,
+		0xdd, 0x7f, 0x22, 0x98, 0x05, 0x03, 0x11, 0x9a, 0xfc, 0x7f, 0x1d, 0xef, 0x86, 0xf1, 0xfe, 0x50,
+		0x08, 0xcd, 0xe9, 0xf3, 0x6f, 0x83, 0x24, 0xf9, 0x97, 0x9d, 0xb0, 0x0b, 0x61, 0xfe, 0xef, 0x9c,
+		0xd9, 0xe5, 0x20, 0x6f, 0xb6, 0xec, 0xaa, 0xad, 0x86, 0x2b, 0xfb, 0x7f, 0xf0, 0x9e, 0x16, 0xf4,
+		0xf9, 0x02, 0x0c, 0x5b, 0x76, 0xb5, 0xda, 0xe4, 0xf1, 0x69, 0x08, 0xfb, 0xff, 0xfc, 0x91, 0x93,
+		0xb2, 0x70, 0x78, 0x48, 0x6f, 0xdf, 0xbc, 0x6e, 0x1b, 0x3a, 0xdd, 0xf0, 0x08, 0x43, 0x78, 0x9d,
+		0x23, 0x78, 0x58, 0xf2, 0x0b, 0x90, 0x22, 0x6d, 0x31, 0xb1, 0x81, 0xe9, 0xee, 0x54, 0x08, 0xc4,
+		0xff, 0xe2, 0x0a, 0xf0, 0x31, 0x15, 0x7f, 0xf6, 0xcb, 0xaf, 0x4d, 0x4b, 0x5f, 0x7b, 0x6d, 0x5a,
+		0xfa, 0xd6, 0x6b, 0xd3, 0xd2, 0x7b, 0xbe, 0x3d, 0x3d, 0xf0, 0xb5, 0x6f, 0x4f, 0x0f, 0xfc, 0xc1,
+		0xb7, 0xa7, 0x07, 0x82, 0xb3, 0xc4, 0xb0, 0xa4, 0x2f, 0xe9, 0x2c, 0x3f, 0xfc, 0x4c, 0xae, 0xa6,
+		0xda, 0x3b, 0xcd, 0xad, 0xb9, 0x8a, 0xde, 0xa0, 0x69, 0x5c, 0x37, 0x5b, 0xeb, 0x2c, 0x72, 0xe0,
+		0xfb, 0x11, 0x98, 0xaa, 0xe8, 0x56, 0x43, 0xb7, 0xca, 0x2c, 0xdf, 0xcb, 0x1e, 0x18, 0x20, 0x4a,
+		0x79, 0xab, 0x7a, 0x48, 0xfa, 0x6e, 0xc2, 0xa4, 0xda, 0x30, 0xea, 0x98, 0x26, 0xe7, 0xcb, 0x54,
+		0x0b, 0xbd, 0x05, 0x83, 0x5f, 0xf9, 0x77, 0x83, 0x2c, 0x09, 0xe9, 0xb2, 0x2f, 0x0b, 0xee, 0xfc,
+		0x0a, 0x8c, 0x93, 0x93, 0x16, 0x86, 0x0f, 0x32, 0x44, 0x99, 0x02, 0x30, 0xcd, 0x39, 0x5d, 0xb4,
+		0x33, 0x10, 0xb7, 0x2a, 0x4a, 0x5d, 0x09, 0xed, 0xd2, 0xaf, 0x72, 0x08, 0x4e, 0x5e, 0x3c, 0xdb,
+		0xa9, 0x27, 0x9e, 0x99, 0xf6, 0x28, 0x9a, 0x69, 0x8c, 0xff, 0x39, 0xce, 0x90, 0xe3, 0xf4, 0xcf,
+		0x23, 0xf0, 0xfb, 0x51, 0x98, 0xe6, 0xf5, 0x5b, 0x8a, 0x85, 0xe7, 0x6f, 0x9c, 0xd8, 0xc2, 0xb6,
+		0x72, 0x62, 0xbe, 0xa2, 0xab, 0x1a, 0xd7, 0xf8, 0x04, 0xd7, 0x3f, 0xa9, 0x9f, 0xe3, 0xf5, 0xd9,
+		0xc0, 0x74, 0x7c, 0xb6, 0x73, 0xbf, 0xe5, 0xae, 0x42, 0x6c, 0x41, 0x57, 0x35, 0xb2, 0xe5, 0x50,
+		0xc5, 0x9a, 0xde, 0xe0, 0x07, 0xf1, 0xd8, 0x03, 0x3a, 0x01, 0x71, 0xa5, 0xa1, 0x37, 0x35, 0x9b,
+		0x6d, 0x52, 0x14, 0xa7, 0xbe, 0xfc, 0xea, 0xcc, 0xc0, 0x1f, 0xbe, 0x3a, 0x13, 0x5d, 0xd6, 0xec,
+		0xdf, 0x7b, 0xe5, 0x38, 0x70, 0xa8, 0x65, 0xcd, 0x96, 0x39, 0x61, 0x3e, 0xf6, 0xbd, 0x0f, 0xcd,
+		0x48, 0xb9, 0xa7, 0x60, 0x68, 0x11, 0x57, 0xf6, 0x82, 0xbc, 0x88, 0x2b, 0x1e, 0xe4, 0x45, 0x5c,
+		0x69, 0x41, 0x3e, 0x03, 0x89, 0x65, 0xcd, 0x66, 0xc7, 0x28, 0x1f, 0x82, 0xa8, 0xaa, 0xb1, 0x93,
+		0x39, 0x5d, 0x65, 0x23, 0x54, 0x84, 0x71, 0x11, 0x57, 0x1c, 0xc6, 0x2a, 0xae, 0x64, 0xa4, 0xb0,
+		0x57, 0x13, 0xaa, 0xe2, 0xe2, 0x1f, 0xfc, 0xc7, 0xe9, 0x81, 0x17, 0x5f, 0x9b, 0x1e, 0xe8, 0xd8,
+		0xab, 0xb9, 0x8e, 0xbd, 0x6a, 0x55, 0xaf, 0xb3, 0xed, 0x15, 0xa7, 0x67, 0xff, 0x38, 0x0e, 0x39,
+		0x4e, 0x63, 0xd9, 0xca, 0x75, 0x55, 0xab, 0x39, 0x9d, 0xab, 0x34, 0xed, 0x9d, 0xe7, 0x79, 0xef,
+		0x1e, 0xe4, 0x52, 0x70, 0x9a, 0x3d, 0x77, 0x70, 0x36, 0xc4, 0x8c, 0x72, 0xdf, 0x8d, 0x02, 0xda,
+		0xb0, 0x95, 0xeb, 0xb8, 0xd0, 0xb4, 0x77, 0x74, 0x53, 0x7d, 0x9e, 0xb9, 0x41, 0x0c, 0xd0, 0x50,
+		0x6e, 0x95, 0x6d, 0xfd, 0x3a, 0xd6, 0x2c, 0xaa, 0xa8, 0xe1, 0x93, 0x53, 0x73, 0x01, 0x26, 0x37,
+		0x47, 0x3a, 0xb9, 0xf8, 0xd0, 0x27, 0xbe, 0x39, 0x73, 0x24, 0x5c, 0x0b, 0x94, 0x98, 0xc4, 0xe5,
+		0xb7, 0x36, 0x29, 0x30, 0xba, 0x06, 0xec, 0x7c, 0x46, 0xb9, 0xae, 0x5a, 0x36, 0x3f, 0xf4, 0x7d,
+		0x6a, 0x2e, 0xb8, 0xed, 0x73, 0xed, 0x62, 0xce, 0x5d, 0x53, 0xea, 0x6a, 0x55, 0xb1, 0x75, 0xd3,
+		0xba, 0x38, 0x20, 0x27, 0x29, 0xd4, 0x8a, 0x6a, 0xd9, 0x68, 0x13, 0x92, 0x55, 0xac, 0xed, 0x32,
+		0xd8, 0xe8, 0x1b, 0x83, 0x4d, 0x10, 0x24, 0x8a, 0xfa, 0x14, 0x20, 0xc5, 0x4b, 0x27, 0x6e, 0x39,
+		0xb1, 0xc3, 0x9a, 0x1d, 0xe0, 0x7d, 0xc8, 0xf4, 0x52, 0xc6, 0xb8, 0xd2, 0x5a, 0x94, 0x7d, 0x1c,
+		0xc0, 0x7d, 0x27, 0x3a, 0x09, 0x43, 0x4a, 0xb5, 0x6a, 0x62, 0xcb, 0xa2, 0x7b, 0x87, 0xc9, 0x62,
+		0xe6, 0xf7, 0x5e, 0x39, 0x3e, 0xc9, 0xf1, 0x0b, 0xac, 0x86, 0x2d, 0xc7, 0x65, 0x41, 0x98, 0x1f,
+		0xff, 0xea, 0x2b, 0xc7, 0x47, 0x7c, 0xef, 0x2a, 0xa6, 0x00, 0x6e, 0x38, 0xa0, 0xc7, 0x3e, 0x28,
+		0xc1, 0x78, 0x9b, 0x2c, 0x28, 0x07, 0xd3, 0x85, 0xab, 0x9b, 0x17, 0xd7, 0xe4, 0xe5, 0x67, 0x0a,
+		0xe4, 0x6c, 0x7f, 0x99, 0xdd, 0x2c, 0xb8, 0xb2, 0xb1, 0x5e, 0x5a, 0x58, 0xbe, 0xb0, 0x5c, 0x5a,
+		0x4c, 0x0f, 0xa0, 0x19, 0xb8, 0x3b, 0x80, 0x66, 0xb1, 0xb4, 0x52, 0x5a, 0x2a, 0x6c, 0x92, 0x7b,
+		0x14, 0xf7, 0xc0, 0xe1, 0x40, 0x10, 0x87, 0x24, 0xd2, 0x81, 0x44, 0x2e, 0x39, 0x24, 0xd1, 0xe2,
+		0x85, 0x8e, 0xe3, 0xeb, 0x2d, 0x5d, 0x2d, 0xeb, 0x96, 0x33, 0x90, 0xfc, 0x23, 0xed, 0xe7, 0x22,
+		0x30, 0xc5, 0xdc, 0xb6, 0x3b, 0x0f, 0x29, 0xda, 0x6e, 0x87, 0xcb, 0xa5, 0xc1, 0x23, 0x2b, 0x77,
+		0x11, 0xa2, 0x05, 0x6d, 0x17, 0x4d, 0xb1, 0x20, 0xbd, 0xdc, 0x34, 0xeb, 0xdc, 0x8f, 0x0d, 0x91,
+		0xe7, 0xab, 0x66, 0x9d, 0xf8, 0x37, 0x71, 0x9f, 0x80, 0x9c, 0x09, 0x60, 0x0f, 0xf9, 0xf4, 0xaf,
+		0x7c, 0x68, 0x66, 0xe0, 0x53, 0x1f, 0x9a, 0x19, 0xf8, 0xe1, 0x87, 0x67, 0x06, 0x5e, 0xfc, 0xa3,
+		0xd9, 0x81, 0xe2, 0xf5, 0xd6, 0xe6, 0x7d, 0x21, 0x74, 0x8a, 0x4e, 0x14, 0xb4, 0x5d, 0xea, 0xb0,
+		0xd6, 0xa5, 0x67, 0x06, 0x69, 0xe3, 0xc4, 0xae, 0xec, 0x74, 0xeb, 0xae, 0xec, 0x93, 0xb8, 0x5e,
+		0xbf, 0xac, 0xe9, 0x37, 0xb5, 0x4d, 0x9f, 0x0e, 0x7e, 0x29, 0x02, 0xd3, 0x6d, 0x73, 0x31, 0x0f,
+		0x5b, 0x3a, 0xdd, 0xb2, 0xcd, 0x43, 0x62, 0x91, 0x93, 0x90, 0x6b, 0xaf, 0x16, 0xae, 0xe8, 0x5a,
+		0x95, 0xf9, 0x80, 0xa8, 0x2c, 0x1e, 0x49, 0xb3, 0x35, 0x45, 0xd3, 0x2d, 0x7e, 0xb4, 0x9f, 0x3d,
+		0x14, 0xdf, 0x2f, 0xf5, 0x17, 0x84, 0x8c, 0x88, 0x37, 0x89, 0x66, 0x9e, 0x08, 0xdd, 0xa7, 0xbe,
+		0x4e, 0x5a, 0xe9, 0x34, 0xc2, 0xb7, 0x57, 0xdd, 0xab, 0x56, 0x7e, 0x39, 0x02, 0x33, 0xad, 0x5a,
+		0x21, 0xb1, 0xa0, 0x65, 0x2b, 0x0d, 0xa3, 0x93, 0x5a, 0xce, 0x43, 0x72, 0x53, 0xd0, 0xf4, 0xad,
+		0x97, 0xdb, 0x7d, 0xea, 0x65, 0xd4, 0x79, 0x95, 0x50, 0xcc, 0xc9, 0x1e, 0x15, 0xe3, 0xb4, 0x63,
+		0x4f, 0x9a, 0xf9, 0x44, 0x0c, 0x0e, 0xd3, 0xbb, 0x5f, 0x66, 0x43, 0xd5, 0xec, 0xf9, 0x8a, 0xb9,
+		0x6b, 0xd8, 0x34, 0x1a, 0xd4, 0xb7, 0xb9, 0x5e, 0xc6, 0xdd, 0xea, 0x39, 0x56, 0xdd, 0x61, 0xe4,
+		0x6c, 0xc3, 0xe0, 0x3a, 0xe1, 0x23, 0x1a, 0xb1, 0x75, 0x5b, 0xa9, 0x73, 0x4d, 0xb1, 0x07, 0x52,
+		0xca, 0xee, 0x8b, 0x45, 0x58, 0xa9, 0x2a, 0xae, 0x8a, 0xd5, 0xb1, 0xb2, 0xcd, 0x8e, 0xdd, 0x47,
+		0xe9, 0x80, 0x4a, 0x90, 0x02, 0x7a, 0xc2, 0x7e, 0x12, 0x06, 0x95, 0x26, 0x3b, 0x1f, 0x12, 0x25,
+		0x23, 0x8d, 0x3e, 0xe4, 0x2e, 0xc3, 0x10, 0xdf, 0xa5, 0x26, 0x27, 0x24, 0xae, 0xe3, 0x5d, 0xfa,
+		0x9e, 0x94, 0x4c, 0xfe, 0x45, 0x73, 0x30, 0x48, 0x85, 0xe7, 0x53, 0x4b, 0x66, 0xae, 0x4d, 0xfa,
+		0x39, 0x2a, 0xa4, 0xcc, 0xc8, 0x72, 0x97, 0x20, 0xb1, 0xa8, 0x37, 0x54, 0x4d, 0xf7, 0xa3, 0x25,
+		0x19, 0x1a, 0x95, 0xd9, 0x68, 0xf2, 0x98, 0x45, 0x66, 0x0f, 0xe4, 0x30, 0x2a, 0xbb, 0x86, 0xc1,
+		0xcf, 0xb8, 0xf0, 0xa7, 0xdc, 0x02, 0x0c, 0x51, 0xec, 0x35, 0x83, 0xdc, 0xf7, 0x70, 0x4e, 0xbc,
+		0x26, 0xf9, 0xa5, 0x3c, 0x0e, 0x1f, 0x71, 0x85, 0x45, 0x10, 0xab, 0x2a, 0xb6, 0xc2, 0xdb, 0x4d,
+		0xff, 0xcf, 0xbd, 0x1d, 0x12, 0x1c, 0x84, 0x4c, 0x0b, 0x51, 0xdd, 0xb0, 0xf8, 0x29, 0x95, 0x6c,
+		0xa7, 0xa6, 0xac, 0x19, 0xc5, 0x18, 0x89, 0x68, 0x64, 0x42, 0x5c, 0x94, 0x3b, 0x3a, 0xd5, 0xb3,
+		0x1e, 0xa7, 0xea, 0xe9, 0x72, 0xcf, 0xbf, 0xac, 0x4b, 0xdb, 0xcc, 0xc1, 0x31, 0x96, 0x0f, 0x47,
+		0x60, 0xda, 0x53, 0x7b, 0x03, 0x9b, 0x96, 0xaa, 0x6b, 0x7c, 0xa6, 0x67, 0xd6, 0x82, 0x3c, 0x42,
+		0xf2, 0xfa, 0x0e, 0xe6, 0xf2, 0x36, 0x88, 0x16, 0x0c, 0x83, 0xdc, 0x46, 0xa4, 0xcf, 0x15, 0x9d,
+		0xd9, 0x4b, 0x4c, 0x76, 0x9e, 0x49, 0x9d, 0xa5, 0x6f, 0xdb, 0x37, 0x15, 0xd3, 0xb9, 0xa9, 0x28,
+		0x9e, 0x73, 0xe7, 0x20, 0xb9, 0xa0, 0x6b, 0x16, 0xd6, 0xac, 0x26, 0x1d, 0x83, 0x5b, 0x75, 0xbd,
+		0x72, 0x9d, 0x23, 0xb0, 0x07, 0xa2, 0x70, 0xc5, 0x30, 0x28, 0x67, 0x4c, 0x26, 0xff, 0xb2, 0x88,
+		0xb2, 0xb8, 0xd1, 0x51, 0x45, 0xe7, 0xfa, 0x57, 0x11, 0x6f, 0xa4, 0xa3, 0xa3, 0x1f, 0x4b, 0x70,
+		0xa8, 0x7d, 0x40, 0x5d, 0xc7, 0xbb, 0x56, 0xbf, 0xe3, 0xe9, 0x29, 0x48, 0xae, 0xd3, 0xcf, 0x05,
+		0x5c, 0xc6, 0xbb, 0x28, 0x0b, 0x43, 0xb8, 0x7a, 0xf2, 0xd4, 0xa9, 0x13, 0xe7, 0x98, 0xb5, 0x5f,
+		0x1c, 0x90, 0x45, 0x01, 0x9a, 0x86, 0xa4, 0x85, 0x2b, 0xc6, 0xc9, 0x53, 0xa7, 0xaf, 0x9f, 0x60,
+		0xe6, 0x45, 0x62, 0x23, 0xa7, 0x28, 0x9f, 0x20, 0xad, 0xfe, 0xde, 0x87, 0x67, 0xa4, 0xe2, 0x20,
+		0x44, 0xad, 0x66, 0xe3, 0x8e, 0xda, 0xc8, 0xfb, 0x06, 0x61, 0xd6, 0xcb, 0x49, 0x3d, 0x95, 0x13,
+		0x95, 0x70, 0x1d, 0xa4, 0x3d, 0x3a, 0xa0, 0x14, 0x1d, 0xc2, 0xdc, 0xae, 0x9a, 0xcc, 0x7d, 0x5a,
+		0x82, 0x94, 0x13, 0x44, 0x91, 0x2f, 0x43, 0x9c, 0xf7, 0xc6, 0x3f, 0x7c, 0xd8, 0xdc, 0x3d, 0xd7,
+		0xfa, 0x2e, 0x37, 0xd8, 0x93, 0x3d, 0xe4, 0xe8, 0x0c, 0x35, 0x44, 0x43, 0xb7, 0xf8, 0xed, 0xb5,
+		0x10, 0x56, 0x87, 0x98, 0x9c, 0x3d, 0xa4, 0x1e, 0xae, 0x7c, 0x43, 0xb7, 0xc9, 0x61, 0x0c, 0x43,
+		0xbf, 0xc9, 0xef, 0x04, 0x47, 0xe5, 0x34, 0xad, 0xb9, 0x46, 0x2b, 0xd6, 0x49, 0x39, 0x11, 0x3a,
+		0xe9, 0xa0, 0x90, 0x69, 0xc5, 0x0d, 0xfc, 0x88, 0x13, 0x10, 0x8f, 0xe4, 0xca, 0x9c, 0xd1, 0xdc,
+		0x2a, 0x0b, 0x8f, 0x41, 0x2e, 0x1d, 0x06, 0x8c, 0x7f, 0x61, 0x1f, 0xdc, 0x03, 0xc4, 0x8d, 0xe6,
+		0x16, 0xb1, 0x96, 0x7b, 0x20, 0x15, 0x20, 0xcc, 0xf0, 0x0d, 0x57, 0x0e, 0xfa, 0x95, 0x0a, 0xde,
+		0x82, 0xb2, 0x61, 0xaa, 0xba, 0xa9, 0xda, 0xbb, 0x34, 0xb2, 0x8d, 0xca, 0x69, 0x51, 0xb1, 0xce,
+		0xcb, 0x73, 0xd7, 0x61, 0x6c, 0x83, 0x2e, 0xbf, 0x5d, 0xc9, 0x4f, 0xb9, 0xf2, 0x49, 0xe1, 0xf2,
+		0x75, 0x94, 0x2c, 0xd2, 0x26, 0x59, 0xf1, 0x89, 0x8e, 0xd6, 0x79, 0xa6, 0x7f, 0xeb, 0xf4, 0x47,
+		0x88, 0x7f, 0x32, 0x05, 0x87, 0x5a, 0x2b, 0x7d, 0xee, 0xab, 0x57, 0xc3, 0x0c, 0x8b, 0x26, 0xb2,
+		0xdd, 0x27, 0xd5, 0x6c, 0x88, 0x1b, 0xcd, 0x86, 0x0e, 0xa1, 0xdc, 0x39, 0x18, 0x21, 0x67, 0x46,
+		0x37, 0xb0, 0x7d, 0x11, 0x2b, 0x55, 0x6c, 0xfa, 0x67, 0xdd, 0x11, 0x31, 0xeb, 0x22, 0x88, 0xd1,
+		0xa9, 0x95, 0xcd, 0x3a, 0xf4, 0xff, 0xdc, 0x0e, 0xc4, 0x08, 0xab, 0x3b, 0x23, 0x73, 0x0e, 0xfa,
+		0x40, 0x4a, 0xb7, 0x76, 0x6d, 0x6c, 0x89, 0xf0, 0x96, 0x3e, 0xa0, 0x47, 0xc5, 0xbc, 0x1a, 0xed,
+		0x3e, 0xaf, 0x72, 0x43, 0xe4, 0xb3, 0x6b, 0x1d, 0x86, 0x8a, 0xc4, 0x15, 0x2f, 0x2f, 0x3a, 0x82,
+		0x48, 0xae, 0x20, 0x68, 0x15, 0xc6, 0x0c, 0xc5, 0xb4, 0xe9, 0xcd, 0x9b, 0x1d, 0xda, 0x0a, 0x6e,
+		0xeb, 0x33, 0xed, 0x23, 0xcf, 0xd7, 0x58, 0xfe, 0x96, 0x11, 0xc3, 0x5b, 0x98, 0xfb, 0x6e, 0x0c,
+		0xe2, 0x5c, 0x19, 0x6f, 0x83, 0x21, 0xae, 0x56, 0x6e, 0x9d, 0x87, 0xe7, 0xda, 0x27, 0xa6, 0x39,
+		0x67, 0x02, 0xe1, 0x78, 0x82, 0x07, 0x3d, 0x00, 0x89, 0xca, 0x8e, 0xa2, 0x6a, 0x65, 0xb5, 0xca,
+		0xd3, 0x15, 0xc3, 0xaf, 0xbd, 0x3a, 0x33, 0xb4, 0x40, 0xca, 0x96, 0x17, 0xe5, 0x21, 0x5a, 0xb9,
+		0x5c, 0x25, 0x91, 0xc0, 0x0e, 0x56, 0x6b, 0x3b, 0x36, 0x1f, 0x61, 0xfc, 0x89, 0x7c, 0xa2, 0x86,
+		0x18, 0x04, 0xbf, 0x97, 0x99, 0x6d, 0x4b, 0x26, 0x39, 0xc1, 0x5e, 0x31, 0x41, 0x5e, 0xfc, 0x9e,
+		0x6f, 0xce, 0x48, 0x32, 0xe5, 0x40, 0x0b, 0x30, 0x52, 0x57, 0x2c, 0xbb, 0x4c, 0x67, 0x30, 0xf2,
+		0xfa, 0x41, 0xbe, 0x12, 0x6f, 0x53, 0x08, 0x57, 0x2c, 0x17, 0x7d, 0x98, 0x70, 0xb1, 0xa2, 0x2a,
+		0xb9, 0x36, 0x46, 0x41, 0xc8, 0x51, 0x59, 0xd5, 0x66, 0xb1, 0x55, 0x9c, 0xea, 0x7d, 0x94, 0x94,
+		0x2f, 0xd0, 0x62, 0x1a, 0x61, 0xdd, 0x0d, 0x49, 0x7a, 0x13, 0x8c, 0x92, 0xb0, 0x33, 0xce, 0x09,
+		0x52, 0x40, 0x2b, 0x8f, 0xc0, 0x98, 0xeb, 0x1f, 0x19, 0x49, 0x82, 0xa1, 0xb8, 0xc5, 0x94, 0xf0,
+		0x61, 0x98, 0xd4, 0xf0, 0x2d, 0xbb, 0xec, 0x16, 0x33, 0xea, 0x24, 0xa5, 0x46, 0xa4, 0xee, 0x9a,
+		0x9f, 0xe3, 0x7e, 0x18, 0xad, 0x08, 0xe5, 0x33, 0x5a, 0xa0, 0xb4, 0x23, 0x4e, 0x29, 0x25, 0x9b,
+		0x82, 0x84, 0x62, 0x18, 0x8c, 0x60, 0x98, 0xfb, 0x47, 0xc3, 0xa0, 0x55, 0xc7, 0x60, 0x9c, 0xb6,
+		0xd1, 0xc4, 0x56, 0xb3, 0x6e, 0x73, 0x90, 0x14, 0xa5, 0x19, 0x23, 0x15, 0x32, 0x2b, 0xa7, 0xb4,
+		0xf7, 0xc2, 0x08, 0xbe, 0xa1, 0x56, 0xb1, 0x56, 0xc1, 0x8c, 0x6e, 0x84, 0xd2, 0xa5, 0x44, 0x21,
+		0x25, 0x7a, 0x10, 0x1c, 0xbf, 0x57, 0x16, 0x3e, 0x79, 0x94, 0xe1, 0x89, 0x72, 0xbe, 0x12, 0xcf,
+		0x65, 0x20, 0xb6, 0xa8, 0xd8, 0x0a, 0x09, 0x30, 0xec, 0x5b, 0x6c, 0xa2, 0x49, 0xc9, 0xe4, 0xdf,
+		0xdc, 0xf7, 0x22, 0x10, 0xbb, 0xa6, 0xdb, 0x18, 0x3d, 0xe2, 0x09, 0x00, 0x47, 0x83, 0xec, 0x79,
+		0x43, 0xad, 0x69, 0xb8, 0xba, 0x6a, 0xd5, 0x3c, 0x9f, 0x6d, 0x70, 0xcd, 0x29, 0xe2, 0x33, 0xa7,
+		0x49, 0x18, 0x34, 0xf5, 0xa6, 0x56, 0x15, 0xc7, 0x83, 0xe9, 0x03, 0x2a, 0x41, 0xc2, 0xb1, 0x92,
+		0x58, 0x98, 0x95, 0x8c, 0x11, 0x2b, 0x21, 0x36, 0xcc, 0x0b, 0xe4, 0xa1, 0x2d, 0x6e, 0x2c, 0x45,
+		0x48, 0x3a, 0xce, 0x2b, 0x33, 0xd8, 0x87, 0xc1, 0xba, 0x6c, 0x64, 0x32, 0x71, 0xfa, 0xde, 0x51,
+		0x1e, 0xb3, 0xb8, 0xb4, 0x53, 0xc1, 0xb5, 0xe7, 0x33, 0x2b, 0xfe, 0x09, 0x89, 0x21, 0xda, 0x2e,
+		0xd7, 0xac, 0xd8, 0x67, 0x24, 0x0e, 0x91, 0xd3, 0x5e, 0x35, 0x4d, 0xb1, 0x9b, 0x26, 0xe6, 0x96,
+		0xe7, 0x16, 0x90, 0xcb, 0x40, 0x71, 0x66, 0xc9, 0x1e, 0xbd, 0x49, 0xc1, 0x7a, 0x8b, 0x74, 0xd2,
+		0x5b, 0x74, 0xef, 0x7a, 0x2b, 0x00, 0x38, 0xc2, 0x58, 0xfc, 0x66, 0x7f, 0x40, 0xc4, 0xc0, 0x44,
+		0xdc, 0x50, 0x6b, 0x7c, 0xa0, 0x7a, 0x98, 0x72, 0xff, 0x41, 0x82, 0xa4, 0x53, 0x8f, 0x0a, 0x30,
+		0x22, 0xe4, 0x2a, 0x6f, 0xd7, 0x95, 0x1a, 0xb7, 0x9d, 0xc3, 0x1d, 0x85, 0xbb, 0x50, 0x57, 0x6a,
+		0xf2, 0x30, 0x97, 0x87, 0x3c, 0x04, 0xf7, 0x43, 0xa4, 0x43, 0x3f, 0xf8, 0x3a, 0x3e, 0xba, 0xb7,
+		0x8e, 0xf7, 0x75, 0x51, 0xac, 0xb5, 0x8b, 0x3e, 0x13, 0xa1, 0x8b, 0x19, 0x43, 0xb7, 0x94, 0xfa,
+		0x4f, 0x62, 0x44, 0xdc, 0x0d, 0x49, 0x43, 0xaf, 0x97, 0x59, 0x0d, 0x3b, 0x36, 0x9f, 0x30, 0xf4,
+		0xba, 0xdc, 0xd6, 0xed, 0x83, 0xfb, 0x34, 0x5c, 0xe2, 0xfb, 0xa0, 0xb5, 0xa1, 0x56, 0xad, 0x99,
+		0x90, 0x62, 0xaa, 0xe0, 0x73, 0xd9, 0xc3, 0x44, 0x07, 0xe4, 0xbf, 0x8c, 0xd4, 0x3e, 0xf7, 0x32,
+		0xb1, 0x19, 0xa5, 0x1c, 0xdf, 0x71, 0x38, 0x98, 0xeb, 0xcf, 0x44, 0x3a, 0x71, 0x30, 0xb3, 0x93,
+		0x39, 0x5d, 0xee, 0xef, 0x4a, 0x00, 0x2b, 0x44, 0xb3, 0xb4, 0xbd, 0x64, 0x16, 0xb2, 0xa8, 0x08,
+		0x65, 0xdf, 0x9b, 0xa7, 0x3b, 0x75, 0x1a, 0x7f, 0x7f, 0xca, 0xf2, 0xca, 0xbd, 0x00, 0x23, 0xae,
+		0x31, 0x5a, 0x58, 0x08, 0x33, 0xdd, 0x25, 0xaa, 0xde, 0xc0, 0xb6, 0x9c, 0xba, 0xe1, 0x79, 0xca,
+		0xfd, 0x4b, 0x09, 0x92, 0x54, 0x26, 0x72, 0x2f, 0xd9, 0xd7, 0x87, 0xd2, 0xde, 0xfb, 0xf0, 0x30,
+		0x00, 0x83, 0x21, 0x7b, 0xdf, 0xdc, 0xb2, 0x92, 0xb4, 0x84, 0xec, 0x68, 0xa3, 0xd3, 0x8e, 0xc2,
+		0xa3, 0xdd, 0x15, 0x2e, 0xa2, 0x6e, 0xae, 0xf6, 0xbb, 0x60, 0x88, 0x7e, 0x09, 0xeb, 0x96, 0xc5,
+		0x03, 0x69, 0xf2, 0xf9, 0x8b, 0xcd, 0x5b, 0x56, 0xee, 0x59, 0x18, 0xda, 0xbc, 0xc5, 0x72, 0x23,
+		0x77, 0x43, 0xd2, 0xd4, 0x75, 0x3e, 0x27, 0xb3, 0x58, 0x28, 0x41, 0x0a, 0xe8, 0x14, 0x24, 0xf2,
+		0x01, 0x11, 0x37, 0x1f, 0xe0, 0x26, 0x34, 0xa2, 0x3d, 0x25, 0x34, 0x8e, 0xfd, 0xbe, 0x04, 0xc3,
+		0x1e, 0xff, 0x80, 0x4e, 0xc0, 0x81, 0xe2, 0xca, 0xda, 0xc2, 0xe5, 0xf2, 0xf2, 0x62, 0xf9, 0xc2,
+		0x4a, 0x61, 0xc9, 0xbd, 0x18, 0x96, 0x3d, 0xf8, 0xf2, 0xed, 0x59, 0xe4, 0xa1, 0xbd, 0xaa, 0xd1,
+		0x8c, 0x12, 0x9a, 0x87, 0x49, 0x3f, 0x4b, 0xa1, 0xb8, 0x41, 0x6e, 0x89, 0x49, 0xd9, 0x03, 0x2f,
+		0xdf, 0x9e, 0x1d, 0xf7, 0x70, 0x14, 0xb6, 0x2c, 0xac, 0xd9, 0xed, 0x0c, 0x0b, 0x6b, 0xab, 0xab,
+		0xcb, 0x9b, 0xe9, 0x48, 0x1b, 0x03, 0x77, 0xd8, 0x0f, 0xc2, 0xb8, 0x9f, 0xe1, 0xca, 0xf2, 0x4a,
+		0x3a, 0x9a, 0x45, 0x2f, 0xdf, 0x9e, 0x1d, 0xf5, 0x50, 0x5f, 0x51, 0xeb, 0xd9, 0xc4, 0xbb, 0x3e,
+		0x3a, 0x3d, 0xf0, 0xab, 0x1f, 0x9b, 0x96, 0x48, 0xcb, 0x46, 0x7c, 0x3e, 0x02, 0xbd, 0x05, 0xee,
+		0xda, 0x58, 0x5e, 0xba, 0x52, 0x5a, 0x2c, 0xaf, 0x6e, 0x2c, 0x89, 0x1c, 0xb4, 0x68, 0xdd, 0xd8,
+		0xcb, 0xb7, 0x67, 0x87, 0x79, 0x93, 0x3a, 0x51, 0xaf, 0xcb, 0xa5, 0x6b, 0x6b, 0x24, 0xa3, 0xcd,
+		0xa8, 0xd7, 0x4d, 0x7c, 0x43, 0xb7, 0xd9, 0xa7, 0xf2, 0x1e, 0x86, 0xa9, 0x00, 0x6a, 0xa7, 0x61,
+		0xe3, 0x2f, 0xdf, 0x9e, 0x1d, 0x59, 0x37, 0x31, 0x1b, 0x3f, 0x94, 0x63, 0x0e, 0x32, 0xed, 0x1c,
+		0x6b, 0xeb, 0x6b, 0x1b, 0x85, 0x95, 0xf4, 0x6c, 0x36, 0xfd, 0xf2, 0xed, 0xd9, 0x94, 0x70, 0x86,
+		0x74, 0x0b, 0xc0, 0x69, 0xd9, 0x9d, 0x5c, 0xf1, 0xfc, 0xce, 0x3c, 0xdc, 0xd7, 0x61, 0xf7, 0x89,
+		0x3f, 0xef, 0x6d, 0xff, 0xa9, 0x63, 0x9e, 0x3d, 0x1b, 0x92, 0x7e, 0x0e, 0x5f, 0x3a, 0xed, 0x7d,
+		0x6f, 0x2b, 0xdb, 0x75, 0x71, 0x97, 0x7b, 0xb7, 0x04, 0xa3, 0x17, 0x55, 0xcb, 0xd6, 0x4d, 0xb5,
+		0xa2, 0xd4, 0xe9, 0x75, 0xb0, 0xd3, 0xbd, 0xfa, 0xd6, 0x96, 0xa1, 0xfe, 0x18, 0xc4, 0x6f, 0x28,
+		0x75, 0xe6, 0xd4, 0xa2, 0xf4, 0x7b, 0x36, 0x1d, 0x36, 0x83, 0x1c, 0xd7, 0x26, 0x00, 0x18, 0x5b,
+		0xee, 0x93, 0x11, 0x18, 0xa3, 0x83, 0xc1, 0x62, 0x5f, 0x3a, 0x23, 0x6b, 0xac, 0x75, 0x88, 0x99,
+		0x8a, 0xcd, 0x93, 0x86, 0xc5, 0xb7, 0xf2, 0x5d, 0xca, 0x07, 0x7a, 0xd8, 0x65, 0x6b, 0xdf, 0xc8,
+		0xa4, 0x48, 0xe8, 0x49, 0x48, 0x90, 0x4d, 0x3d, 0x8a, 0x1a, 0xd9, 0x07, 0xd4, 0xa1, 0x86, 0x72,
+		0x8b, 0xc8, 0x8a, 0xaa, 0x30, 0x46, 0x80, 0x2b, 0x3b, 0x8a, 0x56, 0xc3, 0x0c, 0x3f, 0xba, 0x0f,
+		0xf8, 0x23, 0x0d, 0xe5, 0xd6, 0x02, 0xc5, 0x24, 0x6f, 0xc9, 0x27, 0xc8, 0x9e, 0x0a, 0xdd, 0x04,
+		0xfe, 0x2d, 0x09, 0xc0, 0x55, 0x17, 0xfa, 0x19, 0x48, 0x57, 0x9c, 0x27, 0xfa, 0x7a, 0xb1, 0x65,
+		0x79, 0xa4, 0x53, 0x47, 0xb4, 0x28, 0x9b, 0x4d, 0xcc, 0x5f, 0x7b, 0x75, 0x46, 0x92, 0xc7, 0x2a,
+		0x2d, 0xfd, 0x50, 0x82, 0xe1, 0xa6, 0x51, 0x55, 0x6c, 0x5c, 0xa6, 0x8b, 0xb8, 0x48, 0x1f, 0x93,
+		0x3c, 0x30, 0x46, 0x52, 0xe5, 0x91, 0xfe, 0x93, 0x12, 0x0c, 0x2f, 0x7a, 0xce, 0x63, 0x66, 0x60,
+		0xa8, 0xa1, 0x6b, 0xea, 0x75, 0x6e, 0x76, 0x49, 0x59, 0x3c, 0x92, 0x8c, 0x27, 0xbb, 0x08, 0x6b,
+		0xef, 0x8a, 0x8c, 0xa7, 0x78, 0x26, 0x5c, 0x37, 0xf1, 0x96, 0xa5, 0x0a, 0x5d, 0xcb, 0xe2, 0x91,
+		0x2c, 0x5d, 0x2c, 0x5c, 0x69, 0x92, 0x54, 0x4d, 0xb9, 0xa2, 0x6b, 0xb6, 0x52, 0xb1, 0xf9, 0x95,
+		0xca, 0x31, 0x51, 0xbe, 0xc0, 0x8a, 0x09, 0x48, 0x15, 0xdb, 0x8a, 0x5a, 0xb7, 0x32, 0xec, 0x08,
+		0x83, 0x78, 0xf4, 0x88, 0xfb, 0x95, 0xb8, 0x37, 0x45, 0xb5, 0x00, 0x69, 0xdd, 0xc0, 0xa6, 0x2f,
+		0xa4, 0x64, 0x16, 0xda, 0x79, 0x93, 0x72, 0x4c, 0x70, 0xf0, 0x62, 0xf4, 0x34, 0xa4, 0x9d, 0x95,
+		0x5d, 0xd9, 0x68, 0x6e, 0xb9, 0x69, 0xad, 0xc9, 0x36, 0xbd, 0x16, 0xb4, 0xdd, 0x62, 0xe6, 0xab,
+		0x2e, 0xb4, 0x9b, 0x4b, 0x22, 0x89, 0xa4, 0x31, 0x07, 0x67, 0x9d, 0xc2, 0x90, 0x10, 0xf1, 0x59,
+		0x45, 0xad, 0x8b, 0xfb, 0xfd, 0x32, 0x7f, 0x42, 0x79, 0x88, 0x5b, 0xb6, 0x62, 0x37, 0x2d, 0xbe,
+		0x5f, 0x9b, 0xeb, 0x64, 0x19, 0x45, 0x5d, 0xab, 0x6e, 0x50, 0x4a, 0x99, 0x73, 0xa0, 0x4d, 0x88,
+		0xf3, 0x8d, 0xf0, 0xc1, 0xbe, 0xad, 0x3a, 0xe0, 0xa4, 0x04, 0xc3, 0x42, 0x35, 0x48, 0x57, 0x71,
+		0x1d, 0xd7, 0x58, 0x40, 0xb4, 0xa3, 0x90, 0x75, 0x43, 0x7c, 0x1f, 0x46, 0xcd, 0x98, 0x83, 0xba,
+		0x41, 0x41, 0xd1, 0x65, 0xdf, 0xf1, 0x5f, 0xfe, 0xd1, 0xca, 0x7b, 0x3b, 0xb5, 0xdf, 0x63, 0x99,
+		0x22, 0x99, 0xe0, 0xe1, 0x26, 0xc6, 0xd5, 0xd4, 0xb6, 0x74, 0x8d, 0xde, 0xc2, 0xe5, 0xc1, 0x78,
+		0x82, 0x86, 0x37, 0x63, 0x4e, 0xf9, 0x45, 0x5a, 0x8c, 0x2e, 0xc3, 0xa8, 0x4b, 0x4a, 0xc7, 0x4e,
+		0xb2, 0x8f, 0xb1, 0x33, 0xe2, 0xf0, 0x92, 0x5a, 0x74, 0x11, 0xc0, 0x1d, 0x98, 0x34, 0x3d, 0x30,
+		0x7c, 0x32, 0x17, 0x3e, 0xba, 0xc5, 0x32, 0xcb, 0xe5, 0x45, 0x75, 0x98, 0x68, 0xa8, 0x5a, 0xd9,
+		0xc2, 0xf5, 0xed, 0x32, 0x57, 0x15, 0x81, 0x1c, 0xde, 0x87, 0xae, 0x1d, 0x6f, 0xa8, 0xda, 0x06,
+		0xae, 0x6f, 0x2f, 0x3a, 0xb0, 0xf9, 0xd4, 0xbb, 0x3e, 0x34, 0x33, 0xc0, 0xc7, 0xd2, 0x40, 0x6e,
+		0x9d, 0xa6, 0xa8, 0xf9, 0x30, 0xc0, 0x16, 0x3a, 0x0d, 0x49, 0x45, 0x3c, 0x84, 0xee, 0xf5, 0xbb,
+		0xa4, 0x6c, 0x74, 0xbe, 0xf8, 0x47, 0xb3, 0x52, 0xee, 0x63, 0x12, 0xc4, 0x17, 0xaf, 0xad, 0x2b,
+		0xaa, 0x89, 0x4a, 0x30, 0xee, 0x1a, 0x54, 0xaf, 0x63, 0xd3, 0xb5, 0x41, 0x31, 0x38, 0x4b, 0x9d,
+		0x56, 0x8d, 0x5d, 0x61, 0x5a, 0xd7, 0x93, 0x2d, 0x0d, 0x2f, 0xc1, 0x10, 0x93, 0x92, 0xdc, 0xe2,
+		0x1e, 0x34, 0xc8, 0x3f, 0x3c, 0x23, 0x3f, 0xdd, 0xd1, 0x10, 0x29, 0xbd, 0x93, 0x41, 0x24, 0x2c,
+		0xb9, 0x1f, 0x4b, 0x00, 0x8b, 0xd7, 0xae, 0x6d, 0x9a, 0xaa, 0x51, 0xc7, 0xf6, 0x7e, 0xb5, 0x78,
+		0x05, 0x0e, 0xb8, 0x2d, 0xb6, 0xcc, 0x4a, 0xcf, 0xad, 0x9e, 0x70, 0x17, 0x27, 0x66, 0x25, 0x10,
+		0xad, 0x6a, 0xd9, 0x0e, 0x5a, 0xb4, 0x67, 0xb4, 0x45, 0xcb, 0x0e, 0x56, 0xe3, 0x06, 0x0c, 0xbb,
+		0xcd, 0x27, 0x5f, 0x2e, 0x4b, 0xd8, 0xfc, 0x7f, 0xae, 0xcd, 0x5c, 0x67, 0x6d, 0x0a, 0x36, 0xae,
+		0x51, 0x87, 0x33, 0xf7, 0xff, 0x88, 0x52, 0x1d, 0x8b, 0x7d, 0x73, 0x99, 0x11, 0xf1, 0xbd, 0xdc,
+		0x37, 0xee, 0x47, 0x44, 0xc1, 0xb1, 0x5a, 0xb4, 0xfa, 0x52, 0x84, 0x7c, 0xe2, 0x82, 0x7b, 0x9b,
+		0x37, 0xad, 0x26, 0xd6, 0x61, 0x08, 0x6b, 0xb6, 0xa9, 0x52, 0x55, 0x90, 0xbe, 0x7e, 0xb8, 0x53,
+		0x5f, 0x07, 0xb4, 0x85, 0x7e, 0x0e, 0x4a, 0xe4, 0xb5, 0x39, 0x4c, 0x8b, 0x16, 0xfe, 0x7d, 0x04,
+		0x32, 0x9d, 0x38, 0x49, 0x96, 0xae, 0x62, 0x62, 0x5a, 0x50, 0xf6, 0x25, 0xd7, 0x46, 0x45, 0x31,
+		0x77, 0xfa, 0xab, 0x40, 0x02, 0x28, 0x62, 0x58, 0x84, 0xb4, 0xef, 0x88, 0x69, 0xd4, 0x65, 0x26,
+		0xd5, 0x08, 0xc3, 0x98, 0xaa, 0xa9, 0xb6, 0xaa, 0xd4, 0xcb, 0x5b, 0x4a, 0x5d, 0xd1, 0x2a, 0x7b,
+		0x89, 0x2c, 0xdb, 0x1d, 0xf5, 0x28, 0x07, 0x2d, 0x32, 0x4c, 0x74, 0x0d, 0x86, 0x04, 0x7c, 0x6c,
+		0x1f, 0xe0, 0x05, 0x98, 0x27, 0x8a, 0xfa, 0x46, 0x04, 0xc6, 0x65, 0x5c, 0xfd, 0xe9, 0x52, 0xeb,
+		0x3b, 0x00, 0xd8, 0x80, 0x23, 0x7e, 0x30, 0x13, 0xdb, 0x87, 0x01, 0x9c, 0x64, 0x78, 0x8b, 0x96,
+		0xed, 0xd1, 0xed, 0x57, 0x23, 0x90, 0xf2, 0xea, 0xf6, 0xa7, 0x60, 0x5e, 0x40, 0xcb, 0xae, 0x37,
+		0x88, 0xf1, 0x0f, 0xd9, 0x76, 0xf0, 0x06, 0x6d, 0x56, 0xd7, 0xdd, 0x0d, 0xbc, 0x3f, 0x06, 0xf1,
+		0x75, 0xc5, 0x54, 0x1a, 0x16, 0xba, 0xd4, 0x16, 0xc0, 0x89, 0x2c, 0x5b, 0xdb, 0xe7, 0xca, 0xf9,
+		0xa2, 0x9e, 0x99, 0xdc, 0xaf, 0x04, 0xc4, 0x6f, 0xf7, 0xc3, 0x28, 0x59, 0x22, 0x7a, 0x36, 0xe4,
+		0x23, 0x74, 0x9b, 0x91, 0xac, 0xf1, 0x3c, 0x47, 0x1f, 0x67, 0x60, 0x98, 0x90, 0xb9, 0x8e, 0x8e,
+		0xd0, 0x90, 0xa3, 0xa8, 0x25, 0x56, 0x82, 0x8e, 0x03, 0xda, 0x71, 0x16, 0xed, 0x65, 0x57, 0x05,
+		0x84, 0x6e, 0xdc, 0xad, 0x11, 0xe4, 0x24, 0xb7, 0xa7, 0x6b, 0xd5, 0x32, 0x3b, 0x82, 0xcc, 0xd6,
+		0x38, 0x49, 0x52, 0xb2, 0x48, 0x0a, 0xd0, 0x4d, 0x98, 0xaa, 0xd5, 0xf5, 0x2d, 0xa5, 0x5e, 0xae,
+		0xab, 0xcf, 0x35, 0xd5, 0x6a, 0x99, 0x2b, 0xaf, 0x5c, 0x51, 0x8c, 0x7d, 0x09, 0xc6, 0x0f, 0x32,
+		0xf8, 0x15, 0x8a, 0xbe, 0xc1, 0xc0, 0x17, 0x14, 0x03, 0xbd, 0x00, 0x87, 0x5c, 0x63, 0x08, 0x78,
+		0xf7, 0xd0, 0x3e, 0xbc, 0x7b, 0xca, 0x79, 0x43, 0xdb, 0xeb, 0xfd, 0x5a, 0x54, 0xcc, 0xca, 0x8e,
+		0x7a, 0x83, 0x6d, 0xaa, 0x24, 0xbc, 0x5a, 0x2c, 0xb0, 0x0a, 0xcf, 0x50, 0xfb, 0xa8, 0x04, 0xc8,
+		0x9d, 0x1b, 0x64, 0x6c, 0x19, 0xba, 0x66, 0xd1, 0xe8, 0xdc, 0x13, 0x4a, 0x4b, 0xdd, 0xa3, 0x73,
+		0x97, 0x5f, 0x44, 0xe7, 0x9e, 0xa1, 0x7b, 0xce, 0xf5, 0xc4, 0x91, 0xb0, 0x53, 0xc7, 0xdc, 0x8e,
+		0x5b, 0x9d, 0xed, 0x40, 0xee, 0x1b, 0x12, 0x4c, 0xb5, 0x99, 0xbd, 0x23, 0xec, 0x5f, 0x00, 0x64,
+		0x7a, 0x2a, 0xf9, 0xa7, 0x13, 0x99, 0xd0, 0x7d, 0x8f, 0xa2, 0x71, 0xb3, 0xb5, 0xe2, 0x8e, 0x4d,
+		0x26, 0xec, 0x00, 0xfc, 0xbf, 0x90, 0x60, 0xd2, 0x2b, 0x8c, 0xd3, 0xac, 0x2b, 0x90, 0xf2, 0xca,
+		0xc2, 0x1b, 0x74, 0x5f, 0x2f, 0x0d, 0xe2, 0x6d, 0xf1, 0xf1, 0xa3, 0x27, 0x5c, 0x0f, 0xc3, 0xb2,
+		0x5a, 0x27, 0x7a, 0xd6, 0x8d, 0x90, 0xa9, 0xd5, 0xd3, 0xc4, 0x44, 0xb8, 0x15, 0x5b, 0xd7, 0xf5,
+		0x3a, 0x7a, 0x01, 0xc6, 0x35, 0xdd, 0x2e, 0x93, 0xe1, 0x88, 0xab, 0xde, 0xb3, 0xe6, 0xc9, 0xe2,
+		0x13, 0xfd, 0xa9, 0xec, 0xfb, 0xaf, 0xce, 0xb4, 0x43, 0xb5, 0xe8, 0x71, 0x4c, 0xd3, 0xed, 0x22,
+		0xad, 0xe7, 0x87, 0xcf, 0x4d, 0x18, 0xf1, 0xbf, 0x9a, 0xb9, 0xf5, 0xd5, 0xbe, 0x5f, 0x3d, 0xd2,
+		0xed, 0xb5, 0xa9, 0x2d, 0xcf, 0x3b, 0xd9, 0xe1, 0xab, 0x1f, 0x92, 0x7e, 0xfc, 0xbc, 0x04, 0x13,
+		0xb4, 0x50, 0x7d, 0x1e, 0xd3, 0x85, 0xba, 0x8c, 0x2b, 0xba, 0x59, 0x45, 0xa3, 0x10, 0xe1, 0xdb,
+		0x19, 0x31, 0x39, 0xa2, 0x92, 0xcf, 0xd3, 0x0e, 0xea, 0x37, 0x35, 0x7e, 0x16, 0xa2, 0xdb, 0x34,
+		0xc1, 0xc8, 0xa8, 0xa3, 0xd5, 0xab, 0xcd, 0x3a, 0x26, 0x1f, 0x1d, 0xa5, 0x37, 0x2c, 0x58, 0x7a,
+		0x68, 0x84, 0x95, 0x16, 0x58, 0x21, 0x59, 0x79, 0x3a, 0xee, 0x21, 0x13, 0x0b, 0x81, 0x76, 0x49,
+		0xb9, 0x11, 0x5a, 0x30, 0xea, 0x38, 0x6d, 0x76, 0x80, 0x28, 0x30, 0xc8, 0x95, 0xfa, 0x0e, 0x72,
+		0x27, 0x61, 0xd0, 0x7b, 0x12, 0x88, 0x3d, 0xb0, 0x97, 0x1e, 0xfb, 0x9c, 0x04, 0xe0, 0x66, 0x67,
+		0x48, 0x02, 0xbf, 0xb8, 0x76, 0x65, 0xb1, 0xbc, 0xb1, 0x59, 0xd8, 0xbc, 0xba, 0xe1, 0x3f, 0xb3,
+		0x2e, 0xd2, 0xfd, 0x96, 0x81, 0x2b, 0xe4, 0x2b, 0x72, 0x55, 0xf4, 0x00, 0x4c, 0xfa, 0xa9, 0xc9,
+		0x13, 0xf9, 0x70, 0x6c, 0x36, 0xf5, 0xf2, 0xed, 0xd9, 0x04, 0x0b, 0x7c, 0x31, 0x39, 0x2c, 0x71,
+		0xa0, 0x9d, 0x8e, 0x7c, 0x04, 0x33, 0x92, 0x1d, 0x79, 0xf9, 0xf6, 0x6c, 0xd2, 0x89, 0x90, 0x51,
+		0x0e, 0x90, 0x97, 0x92, 0xe3, 0x45, 0xb3, 0xf0, 0xf2, 0xed, 0xd9, 0x38, 0x33, 0xb4, 0x6c, 0x8c,
+		0x24, 0xf5, 0xf7, 0xfd, 0x64, 0xfb, 0xeb, 0x89, 0x8e, 0x59, 0xfc, 0x1a, 0xd6, 0xb0, 0xa5, 0x5a,
+		0x7b, 0xca, 0xe2, 0xf7, 0xb4, 0x33, 0xd0, 0xed, 0x32, 0xd1, 0x47, 0xe2, 0x90, 0x5a, 0x62, 0x02,
+		0x90, 0x3e, 0xc2, 0xe8, 0xad, 0xe4, 0x3b, 0xad, 0x24, 0x94, 0x70, 0x76, 0x0c, 0x3b, 0x78, 0x10,
+		0x16, 0x70, 0x38, 0xc7, 0xd6, 0xe8, 0x13, 0x7a, 0x8a, 0x9f, 0x5b, 0x61, 0xc7, 0xe9, 0x5c, 0xb3,
+		0x48, 0x15, 0xe7, 0xfa, 0x1b, 0xa2, 0xec, 0x9c, 0xcb, 0x26, 0x81, 0x61, 0xc6, 0x5a, 0x85, 0x03,
+		0x14, 0xd9, 0xb5, 0x58, 0x8a, 0x2e, 0x16, 0x56, 0xc7, 0x3a, 0x89, 0xb9, 0xa2, 0x58, 0xb6, 0xdf,
+		0xee, 0xb9, 0xc8, 0x13, 0xf5, 0xb6, 0x1a, 0x0b, 0x2d, 0xf9, 0xce, 0x1f, 0xc6, 0xfa, 0xdb, 0x19,
+		0xf0, 0xb0, 0xa2, 0x4b, 0x30, 0xec, 0x3a, 0x58, 0x8b, 0xff, 0x1a, 0x4e, 0xef, 0xd3, 0xab, 0x97,
+		0x19, 0x6d, 0xc3, 0x01, 0x37, 0xa6, 0xf3, 0xa2, 0xb2, 0x1f, 0x0d, 0x7a, 0xa8, 0x8f, 0x35, 0x25,
+		0x87, 0x9f, 0x6c, 0xb6, 0x57, 0x91, 0xd5, 0xea, 0x88, 0x77, 0x36, 0xb1, 0x32, 0xe2, 0x2b, 0x97,
+		0xbd, 0x4f, 0x47, 0x7e, 0x00, 0xf6, 0x43, 0x25, 0x86, 0x6e, 0xda, 0xb8, 0xca, 0x23, 0x15, 0xe7,
+		0x19, 0xd5, 0xe0, 0xa0, 0xcd, 0x7d, 0x29, 0x4b, 0xa5, 0x96, 0x4d, 0xea, 0x4d, 0xad, 0x4c, 0xb2,
+		0x7b, 0xb3, 0x02, 0x3c, 0xb0, 0x68, 0x96, 0xdd, 0x5e, 0x65, 0xa1, 0xc7, 0xe1, 0x30, 0xb7, 0xc9,
+		0x80, 0xb7, 0x91, 0x7d, 0x68, 0xa0, 0x8e, 0x7b, 0x8a, 0x19, 0x5c, 0x1b, 0xc0, 0x72, 0x35, 0xb7,
+		0x03, 0xa8, 0xdd, 0x8c, 0xfc, 0x57, 0x7e, 0xa4, 0x9e, 0xae, 0xfc, 0x74, 0xf0, 0x95, 0x89, 0x77,
+		0xf1, 0x28, 0x68, 0xdf, 0xdd, 0xce, 0x37, 0x23, 0x70, 0xcc, 0xbb, 0xe3, 0xf6, 0x5c, 0x13, 0x9b,
+		0xbb, 0x8e, 0x97, 0x30, 0x94, 0x9a, 0xaa, 0x79, 0x2f, 0x96, 0x4c, 0x79, 0xe3, 0x36, 0x4a, 0x2b,
+		0xb4, 0x9e, 0x7b, 0x97, 0x04, 0xc3, 0xeb, 0x4a, 0x0d, 0xcb, 0xf8, 0xb9, 0x26, 0xb6, 0xec, 0x80,
+		0x83, 0xfb, 0xe4, 0x50, 0xfd, 0xf6, 0xb6, 0x38, 0x26, 0x10, 0x93, 0xf9, 0x13, 0x69, 0x73, 0x5d,
+		0x6d, 0xa8, 0x6c, 0x52, 0x8b, 0xc9, 0xec, 0x81, 0xac, 0x1a, 0xe8, 0xac, 0xc6, 0x5c, 0x45, 0x26,
+		0x26, 0xbe, 0x89, 0xd3, 0xd4, 0xd8, 0xa8, 0x27, 0xfb, 0x1c, 0x26, 0x26, 0xc7, 0xf9, 0xd8, 0x57,
+		0x40, 0x13, 0xb2, 0x78, 0xcc, 0x3d, 0x06, 0x29, 0x26, 0x09, 0x8f, 0xa2, 0xa6, 0x20, 0x41, 0x0f,
+		0xaf, 0xb9, 0xf2, 0x0c, 0x91, 0xe7, 0xcb, 0xec, 0xf8, 0x3f, 0xc3, 0x67, 0x22, 0xb1, 0x87, 0x62,
+		0xb1, 0xa3, 0x96, 0x8f, 0x86, 0x7b, 0x27, 0xa6, 0x43, 0x47, 0xc3, 0x5f, 0x1a, 0x84, 0x03, 0x6c,
+		0x69, 0x35, 0xaf, 0x18, 0xea, 0xfc, 0x8e, 0x6d, 0x8b, 0xeb, 0x28, 0xc0, 0x8a, 0xe7, 0x14, 0x43,
+		0xcd, 0xed, 0x42, 0xec, 0xa2, 0x6d, 0x1b, 0xe8, 0x18, 0x0c, 0x9a, 0xcd, 0x3a, 0x16, 0xa9, 0x3f,
+		0x67, 0xeb, 0x44, 0x31, 0xd4, 0x39, 0x42, 0x20, 0x37, 0xeb, 0x58, 0x66, 0x24, 0xa8, 0x04, 0x33,
+		0xdb, 0xcd, 0x7a, 0x7d, 0x97, 0xfc, 0xf4, 0x95, 0x5e, 0x25, 0x06, 0xcb, 0x7f, 0x2a, 0x04, 0xdf,
+		0x32, 0x14, 0xf1, 0x79, 0x51, 0xa2, 0x98, 0x43, 0x94, 0x6c, 0x91, 0x52, 0x89, 0x9f, 0x09, 0x29,
+		0x09, 0x9a, 0xdc, 0x1f, 0x46, 0x20, 0x21, 0xa0, 0xc9, 0x80, 0xb4, 0x70, 0x1d, 0x57, 0x48, 0x04,
+		0x21, 0xf1, 0xf3, 0xf8, 0xfc, 0x19, 0x21, 0x88, 0xd6, 0x78, 0xe7, 0x25, 0x2f, 0x0e, 0xc8, 0xe4,
+		0x81, 0x94, 0x39, 0xb7, 0x24, 0x48, 0x19, 0xb9, 0x3c, 0x31, 0x09, 0x31, 0x43, 0x17, 0xb9, 0x81,
+		0x8b, 0x03, 0x32, 0x7d, 0x42, 0x19, 0x88, 0x93, 0x91, 0x6f, 0xb3, 0xde, 0x22, 0xe5, 0xfc, 0x19,
+		0x1d, 0x24, 0xc9, 0x63, 0xbb, 0xc2, 0x0e, 0x30, 0x92, 0x0a, 0xf6, 0x88, 0xce, 0x40, 0x9c, 0xdd,
+		0x9e, 0x6f, 0xfd, 0x15, 0x21, 0xa2, 0x0c, 0xf6, 0x99, 0x42, 0x22, 0xf7, 0xba, 0x62, 0xdb, 0xd8,
+		0xd4, 0x08, 0x20, 0x23, 0x27, 0x87, 0x2c, 0xb6, 0xf4, 0xea, 0x2e, 0xff, 0x65, 0x23, 0xfa, 0x3f,
+		0xff, 0x29, 0x15, 0x6a, 0x0f, 0x65, 0x5a, 0xc9, 0x7e, 0xd0, 0x2d, 0x25, 0x0a, 0x8b, 0x84, 0xa8,
+		0x04, 0x13, 0x4a, 0xb5, 0xaa, 0xb2, 0x1f, 0x19, 0x2a, 0x6f, 0xa9, 0xd4, 0x03, 0x5a, 0x99, 0xe1,
+		0x2e, 0x7d, 0x81, 0x5c, 0x86, 0x22, 0xa7, 0x2f, 0x26, 0xc9, 0x0f, 0x0b, 0x52, 0xa1, 0x72, 0xe7,
+		0x61, 0xbc, 0x4d, 0x52, 0x22, 0xdf, 0x75, 0x55, 0xab, 0x8a, 0xab, 0x23, 0xe4, 0x7f, 0x52, 0x46,
+		0x3f, 0x2c, 0xca, 0xb6, 0x04, 0xe9, 0xff, 0xc5, 0x9f, 0xef, 0x7c, 0xc3, 0x68, 0xd4, 0x73, 0xc3,
+		0x48, 0x31, 0xd4, 0x62, 0x92, 0xe2, 0xf3, 0x7b, 0x45, 0x85, 0xf6, 0x7b, 0x45, 0x35, 0xac, 0x89,
+		0xd8, 0x80, 0x54, 0x29, 0x86, 0x6a, 0x51, 0x73, 0x74, 0x3f, 0x74, 0x6a, 0x9d, 0xf7, 0xfc, 0x4f,
+		0xaf, 0x19, 0xc5, 0x96, 0x0a, 0xeb, 0xcb, 0x8e, 0x1d, 0x7f, 0x31, 0x02, 0x87, 0x3c, 0x76, 0xec,
+		0x21, 0x6e, 0x37, 0xe7, 0x6c, 0xb0, 0xc5, 0xf7, 0x70, 0x87, 0xfc, 0x32, 0xc4, 0x08, 0x3d, 0x0a,
+		0xf9, 0xa1, 0x93, 0xcc, 0xa7, 0xbe, 0xfa, 0xcf, 0x72, 0xb3, 0x52, 0xc7, 0x5e, 0xa1, 0x20, 0xc5,
+		0x5f, 0xe8, 0x5d, 0x7f, 0x69, 0xf7, 0x1b, 0xaf, 0xd6, 0xfe, 0xa9, 0xb1, 0x55, 0x87, 0xaf, 0x5f,
+		0xee, 0x78, 0x51, 0x98, 0x39, 0xd3, 0xee, 0x21, 0x5e, 0x1f, 0x9e, 0xba, 0xd3, 0x6d, 0x8b, 0x6e,
+		0x3d, 0xf8, 0xc6, 0x83, 0xc5, 0x5b, 0x70, 0xf0, 0x09, 0x22, 0x96, 0x9b, 0xfc, 0x11, 0xb3, 0xc1,
+		0x41, 0x67, 0xab, 0x56, 0xe2, 0xd7, 0xea, 0xe9, 0x13, 0xba, 0x00, 0xe0, 0x8a, 0xce, 0xb3, 0x03,
+		0x0f, 0xcc, 0x75, 0x9c, 0x65, 0xe6, 0x3c, 0x33, 0x8c, 0xec, 0xe1, 0xcc, 0xfd, 0x9a, 0x04, 0x77,
+		0xb5, 0xbd, 0x9a, 0xbb, 0xff, 0xa5, 0x80, 0x3b, 0x23, 0x7b, 0x8a, 0xd9, 0x96, 0x02, 0x84, 0x3d,
+		0x12, 0x2a, 0x2c, 0x93, 0xc2, 0x27, 0xed, 0x53, 0x70, 0xc0, 0x2f, 0xac, 0x50, 0xd3, 0x63, 0x30,
+		0xea, 0x5f, 0x71, 0x85, 0x46, 0x0e, 0x23, 0xbe, 0xe5, 0x56, 0xae, 0xdc, 0xda, 0x03, 0x8e, 0x16,
+		0x4a, 0xde, 0xc5, 0xa1, 0xc4, 0x7f, 0xa2, 0xa9, 0x47, 0x25, 0xb8, 0x9c, 0x44, 0xd1, 0xb3, 0xfe,
+		0x37, 0x78, 0x22, 0xc4, 0xfd, 0x6a, 0xc6, 0xbe, 0x99, 0xc5, 0xf7, 0x24, 0xb8, 0xa7, 0x8b, 0xb4,
+		0x5c, 0x35, 0xcf, 0xc3, 0xa4, 0x27, 0x75, 0x24, 0x66, 0x04, 0x61, 0x2a, 0xc7, 0xc2, 0x83, 0x72,
+		0x27, 0x37, 0x72, 0x37, 0x51, 0xd7, 0x27, 0xbe, 0x39, 0x33, 0xd1, 0x5e, 0x67, 0xc9, 0x13, 0xed,
+		0x09, 0x9e, 0x7d, 0xb4, 0xa9, 0x57, 0x24, 0x78, 0xd0, 0xdf, 0xd4, 0x80, 0xf0, 0xfe, 0xcd, 0xd7,
+		0x43, 0xdf, 0x90, 0xe0, 0x58, 0x2f, 0x62, 0xf3, 0xae, 0xda, 0x82, 0x09, 0x77, 0xa9, 0xd3, 0xda,
+		0x53, 0x7b, 0x58, 0xe8, 0x20, 0x07, 0xed, 0x0e, 0x74, 0xc9, 0xc7, 0x24, 0x3e, 0x1a, 0xbd, 0xd6,
+		0xe0, 0xe8, 0xdf, 0xbf, 0x9b, 0x11, 0xae, 0x7f, 0xdf, 0x56, 0x46, 0x40, 0x07, 0x46, 0xfa, 0xea,
+		0x40, 0x77, 0x4d, 0x91, 0xbb, 0x01, 0x77, 0xb5, 0x49, 0xc9, 0xd5, 0xfd, 0x0e, 0x98, 0x08, 0x18,
+		0x19, 0xdc, 0x7d, 0xf4, 0x31, 0x30, 0x64, 0xd4, 0x6e, 0xfb, 0xb9, 0x5f, 0x97, 0x60, 0x86, 0xbe,
+		0x38, 0xa0, 0x7b, 0xde, 0x8c, 0x7a, 0x6a, 0xc0, 0x6c, 0x67, 0x71, 0xb9, 0xc2, 0x96, 0x21, 0xce,
+		0x2c, 0x8a, 0xeb, 0x68, 0x0f, 0x26, 0xc9, 0x01, 0x72, 0x9f, 0x15, 0x9e, 0x76, 0x51, 0x34, 0x28,
+		0x78, 0x1c, 0xbf, 0x31, 0xfd, 0xec, 0xd3, 0x38, 0xf6, 0xa8, 0xe9, 0xeb, 0xc2, 0xe7, 0x06, 0xcb,
+		0xcd, 0x15, 0x55, 0xd9, 0x37, 0x9f, 0xcb, 0xb3, 0x35, 0x77, 0xd4, 0xb9, 0xfe, 0xb6, 0x70, 0xae,
+		0x4e, 0x9b, 0x42, 0x9c, 0xeb, 0x9b, 0xad, 0x53, 0x1c, 0x37, 0x1b, 0xd2, 0x80, 0x3f, 0x8b, 0x6e,
+		0xf6, 0xb7, 0x23, 0x30, 0x45, 0xdb, 0xe6, 0xcd, 0x37, 0xed, 0x67, 0x67, 0x20, 0xb2, 0x4f, 0xdc,
+		0xa7, 0x17, 0x49, 0x5b, 0x66, 0xe5, 0x5a, 0xcb, 0x8c, 0x89, 0xaa, 0x96, 0xdd, 0x8a, 0x13, 0xb6,
+		0x51, 0x9c, 0xae, 0x7a, 0xf2, 0x4a, 0x01, 0xc6, 0x11, 0xdb, 0x07, 0xe3, 0xf8, 0x9a, 0x04, 0xd9,
+		0x20, 0x05, 0x72, 0x63, 0x50, 0xe1, 0xa0, 0x6f, 0x6f, 0xad, 0xd5, 0x1e, 0xde, 0xd2, 0x4b, 0xfe,
+		0xaf, 0x65, 0xb8, 0x1e, 0x30, 0xf1, 0x9d, 0x8e, 0x86, 0x66, 0xfc, 0xf6, 0xde, 0xbe, 0x26, 0x79,
+		0x13, 0x0e, 0xd3, 0x57, 0xda, 0x7c, 0xfe, 0x9f, 0x89, 0xf5, 0xcc, 0x27, 0x25, 0x98, 0xee, 0x20,
+		0xf6, 0x9b, 0x71, 0x22, 0xdf, 0xe9, 0x68, 0x1b, 0xfb, 0xbd, 0x5a, 0x7a, 0x94, 0x0f, 0x2c, 0xff,
+		0x9d, 0x04, 0xcf, 0xa2, 0x38, 0xe8, 0x52, 0x63, 0xee, 0x69, 0xb8, 0x3b, 0x90, 0x8b, 0xcb, 0x96,
+		0x87, 0x18, 0xd9, 0xce, 0xcf, 0x48, 0x7e, 0x83, 0x6b, 0x15, 0xab, 0x85, 0x9b, 0xf2, 0xe4, 0x10,
+		0xa4, 0x29, 0x34, 0xd9, 0xab, 0xe5, 0x62, 0xe4, 0x2e, 0xc3, 0xb8, 0xa7, 0x8c, 0xbf, 0xe4, 0x34,
+		0x49, 0xe2, 0xe9, 0x75, 0xe7, 0xe6, 0x7f, 0xa7, 0x4d, 0x1e, 0x5d, 0xaf, 0xf3, 0x66, 0x53, 0xfa,
+		0xdc, 0x24, 0x20, 0x06, 0x46, 0xf7, 0x7b, 0xc4, 0x2b, 0x36, 0x60, 0xc2, 0x57, 0xca, 0x5f, 0xf2,
+		0x86, 0xf6, 0x92, 0x72, 0x27, 0x78, 0x37, 0x06, 0xe4, 0xe4, 0x85, 0x86, 0x5b, 0x36, 0x5e, 0x73,
+		0x2f, 0x89, 0xf1, 0x15, 0xc8, 0xe3, 0xc6, 0x70, 0x2c, 0xf7, 0x1f, 0x16, 0xc3, 0x75, 0xde, 0x68,
+		0xe0, 0x00, 0xee, 0x87, 0xf2, 0x22, 0x9e, 0x0f, 0xe5, 0x91, 0x35, 0xf4, 0x91, 0x4e, 0x52, 0x58,
+		0xc5, 0xdd, 0x35, 0xb2, 0xe7, 0x2b, 0x5a, 0xe0, 0x6c, 0x15, 0x4b, 0xbd, 0x6d, 0x15, 0xef, 0xbf,
+		0x4f, 0xfa, 0x82, 0x04, 0x47, 0xc3, 0xa5, 0xe5, 0xba, 0xbb, 0x4c, 0x92, 0xf2, 0xb4, 0x26, 0x2c,
+		0x58, 0xe8, 0xac, 0x3c, 0x81, 0xb0, 0x7f, 0xfe, 0xe9, 0x1d, 0x90, 0xa1, 0x2d, 0x70, 0xcf, 0xcc,
+		0xe0, 0xea, 0xbe, 0xa5, 0x5c, 0xfe, 0xba, 0x08, 0x3f, 0xfc, 0xe8, 0x5c, 0x21, 0xbb, 0x90, 0x65,
+		0x7b, 0x9d, 0x9e, 0x03, 0x41, 0xad, 0x07, 0x23, 0xde, 0xd8, 0x59, 0x92, 0xbb, 0x28, 0xbe, 0xf7,
+		0xe5, 0xfc, 0x2c, 0x84, 0x0d, 0x77, 0xb5, 0x9f, 0x47, 0x62, 0xe7, 0x6e, 0xf7, 0xe3, 0xa6, 0xd0,
+		0x81, 0xd6, 0xa3, 0x48, 0x14, 0x3a, 0x77, 0x1a, 0x0e, 0xf9, 0xd7, 0xf3, 0x74, 0x33, 0xac, 0x60,
+		0x87, 0x39, 0xbd, 0x06, 0x1c, 0xee, 0xc0, 0xc7, 0x35, 0xb9, 0x12, 0x30, 0xed, 0x3d, 0x10, 0xea,
+		0x93, 0xbd, 0x3b, 0xba, 0x1e, 0x7e, 0x12, 0xd1, 0x67, 0x5a, 0x56, 0xbd, 0x05, 0xfb, 0x4d, 0x33,
+		0x59, 0x75, 0xfa, 0xec, 0x84, 0x67, 0x58, 0x3e, 0x07, 0x53, 0x01, 0xf2, 0x73, 0x5d, 0xb9, 0x27,
+		0xac, 0xa5, 0xfd, 0x3b, 0x61, 0x7d, 0xf2, 0x3f, 0x1d, 0x86, 0x41, 0xfa, 0x4e, 0xf4, 0x5e, 0xc9,
+		0xf7, 0x71, 0xc3, 0xb9, 0x4e, 0xdd, 0x10, 0x9c, 0x0d, 0xce, 0xce, 0xf7, 0x4c, 0xcf, 0x53, 0x05,
+		0xc7, 0x7e, 0xfe, 0xdf, 0x7c, 0xe7, 0x97, 0x22, 0xf7, 0xa1, 0xdc, 0x7c, 0x87, 0x14, 0xb5, 0x27,
+		0xaa, 0xf9, 0xb8, 0xef, 0xf3, 0x3b, 0xc7, 0x7b, 0x7b, 0x95, 0x90, 0x6c, 0xae, 0x57, 0x72, 0x2e,
+		0xd8, 0x79, 0x2a, 0xd8, 0x29, 0xf4, 0x48, 0xb8, 0x60, 0xf3, 0xef, 0xf4, 0x9b, 0xc4, 0x0b, 0xe8,
+		0xdf, 0x4a, 0x30, 0x19, 0x94, 0x98, 0x44, 0x67, 0x7b, 0x93, 0xa2, 0x7d, 0xe9, 0x99, 0x3d, 0xb7,
+		0x07, 0x4e, 0xde, 0x94, 0x25, 0xda, 0x94, 0x02, 0x7a, 0x6c, 0x0f, 0x4d, 0x99, 0xf7, 0x6e, 0xea,
+		0xff, 0x5f, 0x09, 0x0e, 0x77, 0xcd, 0xe6, 0xa1, 0x42, 0x6f, 0x52, 0x76, 0x59, 0x63, 0x67, 0x8b,
+		0x6f, 0x04, 0x82, 0xb7, 0xf8, 0x09, 0xda, 0xe2, 0xcb, 0x68, 0x79, 0x2f, 0x2d, 0x0e, 0x3c, 0x71,
+		0x81, 0x7e, 0xd7, 0x7f, 0xef, 0xa2, 0xbb, 0x39, 0xb5, 0xa5, 0xbb, 0xb2, 0xf3, 0x3d, 0xd3, 0xf3,
+		0x26, 0x3c, 0x45, 0x9b, 0x20, 0xa3, 0xf5, 0x37, 0xd8, 0x69, 0xf3, 0xef, 0xf4, 0x3b, 0xbc, 0x17,
+		0xd0, 0xff, 0x96, 0x82, 0x2f, 0x50, 0x9c, 0xe9, 0x2a, 0x62, 0xe7, 0x54, 0x5e, 0xf6, 0x6c, 0xff,
+		0x8c, 0xbc, 0x91, 0x0d, 0xda, 0xc8, 0x1a, 0xc2, 0xfb, 0xdd, 0xc8, 0xc0, 0x4e, 0x44, 0x5f, 0x91,
+		0x60, 0x32, 0x28, 0x77, 0x15, 0x32, 0x2c, 0xbb, 0xa4, 0xe9, 0x42, 0x86, 0x65, 0xb7, 0x44, 0x59,
+		0xee, 0xad, 0xb4, 0xf1, 0xa7, 0xd1, 0xa3, 0x9d, 0x1a, 0xdf, 0xb5, 0x17, 0xc9, 0x58, 0xec, 0x9a,
+		0xf2, 0x09, 0x19, 0x8b, 0xbd, 0xe4, 0xbb, 0x42, 0xc6, 0x62, 0x4f, 0x19, 0xa7, 0xf0, 0xb1, 0xe8,
+		0xb4, 0xac, 0xc7, 0x6e, 0xb4, 0xd0, 0x17, 0x25, 0x18, 0xf1, 0x65, 0x34, 0xd0, 0x89, 0xae, 0x82,
+		0x06, 0xa5, 0x8f, 0xb2, 0x27, 0xfb, 0x61, 0xe1, 0x6d, 0x59, 0xa6, 0x6d, 0x59, 0x40, 0x85, 0xbd,
+		0xb4, 0xc5, 0x7f, 0x40, 0xea, 0x6b, 0x12, 0x4c, 0x04, 0xe4, 0x02, 0x42, 0x46, 0x61, 0xe7, 0xa4,
+		0x47, 0xf6, 0x6c, 0xff, 0x8c, 0xbc, 0x55, 0x17, 0x68, 0xab, 0x1e, 0x47, 0x6f, 0xdf, 0x4b, 0xab,
+		0x3c, 0xf3, 0xf3, 0xab, 0xee, 0x71, 0x73, 0xcf, 0x7b, 0xd0, 0xe9, 0x3e, 0x05, 0x13, 0x0d, 0x3a,
+		0xd3, 0x37, 0x1f, 0x6f, 0xcf, 0x93, 0xb4, 0x3d, 0x4f, 0xa0, 0xb5, 0x37, 0xd6, 0x9e, 0xf6, 0x69,
+		0xfd, 0x33, 0xed, 0x1f, 0x21, 0xe8, 0x6e, 0x45, 0x81, 0xd9, 0x81, 0xec, 0x23, 0x7d, 0xf1, 0xf0,
+		0x46, 0x9d, 0xa5, 0x8d, 0x3a, 0x89, 0x1e, 0xee, 0xd4, 0x28, 0xcf, 0x15, 0x01, 0x55, 0xdb, 0xd6,
+		0xe7, 0xdf, 0xc9, 0x02, 0xcb, 0x17, 0xd0, 0xcf, 0x49, 0xfc, 0x04, 0xf7, 0xd1, 0xae, 0xef, 0xf5,
+		0x24, 0x0e, 0xb2, 0x0f, 0xf6, 0x40, 0xc9, 0xe5, 0xba, 0x8f, 0xca, 0x35, 0x8d, 0x0e, 0x75, 0x92,
+		0xcb, 0x20, 0xaf, 0x7e, 0xb7, 0xe4, 0xdc, 0x53, 0x39, 0xd6, 0x1d, 0xdb, 0x9b, 0x5d, 0xc8, 0x3e,
+		0xd4, 0x13, 0x2d, 0x97, 0xe4, 0x01, 0x2a, 0xc9, 0x2c, 0x9a, 0xee, 0x28, 0x09, 0x13, 0xe0, 0x4b,
+		0x1d, 0xce, 0x72, 0x77, 0xb7, 0xb7, 0xce, 0xb9, 0x88, 0xec, 0xd9, 0xfe, 0x19, 0x7b, 0x0d, 0x32,
+		0x83, 0xcf, 0x49, 0xce, 0xbf, 0x53, 0xad, 0xbe, 0x80, 0xbe, 0x2b, 0xc1, 0xdd, 0x5d, 0x56, 0xee,
+		0xe8, 0xb1, 0x7e, 0xc5, 0x6a, 0xc9, 0x50, 0x64, 0x1f, 0xdf, 0x3b, 0x00, 0x6f, 0xdf, 0x22, 0x6d,
+		0xdf, 0xdb, 0xd1, 0x5b, 0xfb, 0x6c, 0x1f, 0xcd, 0x78, 0xcc, 0xbf, 0x93, 0xfe, 0x79, 0x01, 0x7d,
+		0x44, 0x82, 0x94, 0x77, 0x15, 0x8c, 0x1e, 0xee, 0x2a, 0x58, 0x40, 0x2e, 0x20, 0x7b, 0xa2, 0x0f,
+		0x0e, 0x2e, 0xfb, 0x71, 0x2a, 0xfb, 0x11, 0x74, 0x7f, 0x27, 0xd9, 0x7d, 0xeb, 0x7e, 0x32, 0x27,
+		0xa5, 0x5b, 0x57, 0xb8, 0xe8, 0xd1, 0xde, 0x62, 0x59, 0xff, 0x42, 0x3a, 0x7b, 0xaa, 0x4f, 0x2e,
+		0x2e, 0xf0, 0xe3, 0x54, 0xe0, 0x3c, 0x3a, 0xdb, 0x49, 0x60, 0x7e, 0x73, 0x68, 0xbe, 0xe5, 0x20,
+		0xb5, 0xeb, 0x29, 0x5e, 0x95, 0x20, 0xe5, 0x5d, 0x75, 0x86, 0x28, 0x3a, 0x60, 0x81, 0x9d, 0x3d,
+		0xd1, 0x07, 0x07, 0x97, 0x5b, 0xa5, 0x72, 0x57, 0x90, 0x12, 0x26, 0x77, 0x37, 0xb7, 0xed, 0x8b,
+		0x95, 0x5a, 0x23, 0x45, 0xd1, 0xc0, 0x7d, 0x3f, 0x64, 0xfb, 0xcf, 0x0f, 0xc3, 0x4c, 0x27, 0xcb,
+		0xbe, 0x15, 0x72, 0xe6, 0xab, 0xcb, 0x67, 0x78, 0x42, 0x3f, 0xb3, 0xb3, 0xdf, 0x3f, 0x2c, 0xd1,
+		0xdb, 0x01, 0xb1, 0xdc, 0x67, 0x63, 0x80, 0x56, 0xad, 0xda, 0x82, 0x89, 0xd9, 0xef, 0xe3, 0xf3,
+		0x09, 0xbe, 0xe5, 0x93, 0x15, 0xd2, 0x1b, 0xfa, 0x64, 0xc5, 0xaa, 0xef, 0xd3, 0x11, 0x91, 0xfe,
+		0x3e, 0x0c, 0xd3, 0xf3, 0xf7, 0x23, 0xa2, 0x77, 0xe4, 0xfb, 0x11, 0xc1, 0x57, 0x59, 0x63, 0xfb,
+		0x73, 0x07, 0x7d, 0xb0, 0xef, 0xeb, 0x39, 0x17, 0x20, 0xce, 0x3f, 0xd7, 0x12, 0xdf, 0xd3, 0xe7,
+		0x5a, 0x38, 0x37, 0x3a, 0x25, 0x7e, 0x34, 0x61, 0xa8, 0xb7, 0x3b, 0x7e, 0x8c, 0xda, 0x93, 0xd1,
+		0x3a, 0x04, 0xd9, 0x76, 0xb3, 0x71, 0xc6, 0xff, 0x8f, 0x23, 0x90, 0x5e, 0xb5, 0x6a, 0xa5, 0xaa,
+		0x6a, 0xdf, 0x21, 0x9b, 0xda, 0xa7, 0x3b, 0xfd, 0x0a, 0x8c, 0xb5, 0x7c, 0xb9, 0x88, 0xdb, 0xd1,
+		0xd9, 0x3d, 0x27, 0xe0, 0x46, 0xfd, 0xdf, 0x2f, 0x42, 0x3b, 0xc1, 0xe6, 0x1a, 0xeb, 0xeb, 0x35,
+		0x3d, 0x7d, 0xea, 0xc4, 0xed, 0x9d, 0x2c, 0x64, 0x5a, 0xd5, 0xef, 0xf4, 0xcd, 0xab, 0x12, 0x0c,
+		0xaf, 0x5a, 0x62, 0x5d, 0x87, 0xdf, 0x64, 0x1f, 0x59, 0x38, 0xe3, 0xfc, 0x2e, 0x51, 0xb4, 0x37,
+		0xcb, 0xe4, 0xe4, 0x9e, 0xc6, 0x1f, 0x80, 0x09, 0x4f, 0xfb, 0x9c, 0x76, 0x7f, 0x3e, 0x42, 0x3d,
+		0x5d, 0x11, 0xd7, 0x54, 0xcd, 0x59, 0x0a, 0xe2, 0x9f, 0x86, 0xab, 0xea, 0xae, 0x4e, 0x63, 0x7b,
+		0xd5, 0xe9, 0x75, 0xc8, 0xb6, 0xeb, 0xce, 0xc9, 0x60, 0x07, 0x7c, 0x1c, 0x41, 0xda, 0xfb, 0xc7,
+		0x11, 0x72, 0xdf, 0x92, 0x60, 0x64, 0xd5, 0xaa, 0x5d, 0xd5, 0xaa, 0x7f, 0x6e, 0x6d, 0x74, 0x1b,
+		0x0e, 0xf8, 0x5a, 0x78, 0xa7, 0x54, 0xf9, 0xe9, 0x08, 0x8c, 0x93, 0xcf, 0x33, 0x7a, 0x03, 0x7b,
+		0xeb, 0xcf, 0x89, 0x3a, 0xc9, 0x28, 0x11, 0x8b, 0x0d, 0xbe, 0x59, 0x56, 0x66, 0xdb, 0xaf, 0x61,
+		0xd3, 0xf3, 0x84, 0xc3, 0x46, 0x15, 0x42, 0x17, 0x38, 0x9e, 0xce, 0xd9, 0x84, 0xa9, 0x36, 0x9d,
+		0x39, 0x1d, 0xe4, 0x4a, 0x2b, 0xf5, 0x25, 0x2d, 0x39, 0x60, 0x4a, 0x9c, 0x32, 0x19, 0x3e, 0xb8,
+		0x41, 0xc1, 0xad, 0x0b, 0xba, 0xb9, 0xbf, 0x3d, 0x72, 0xc6, 0xf7, 0xab, 0x6e, 0x7b, 0xb2, 0xcc,
+		0x77, 0xc0, 0x6c, 0x27, 0x29, 0xdf, 0xb0, 0x0e, 0x4e, 0xbe, 0x27, 0x0e, 0xd1, 0x55, 0xab, 0x86,
+		0x9e, 0x83, 0xb1, 0xd6, 0x88, 0xb3, 0x63, 0x0e, 0xa1, 0x3d, 0xcc, 0xc8, 0x9e, 0xec, 0x9d, 0xd6,
+		0x91, 0xf9, 0x3a, 0x8c, 0xf8, 0xc3, 0x91, 0xa3, 0x5d, 0x40, 0x7c, 0x94, 0xd9, 0x87, 0x7b, 0xa5,
+		0x74, 0x5e, 0xf6, 0x33, 0xe4, 0xb7, 0xee, 0xb8, 0xef, 0xba, 0xb7, 0x0b, 0xb7, 0x20, 0xca, 0x3e,
+		0xd4, 0x03, 0x91, 0x83, 0xfe, 0x1c, 0x8c, 0xb5, 0xce, 0x62, 0xdd, 0xb4, 0xd7, 0x42, 0x9b, 0x3d,
+		0xd9, 0x3b, 0xad, 0xe7, 0x8c, 0x21, 0x78, 0xdc, 0xf1, 0xfd, 0x5d, 0x10, 0x5c, 0xb2, 0xec, 0xf1,
+		0x9e, 0xc8, 0x9c, 0x77, 0x68, 0x30, 0xda, 0xe2, 0xa7, 0x1e, 0xec, 0x02, 0xe0, 0x27, 0xcd, 0x9e,
+		0xe8, 0x99, 0xd4, 0x79, 0xdf, 0x4b, 0x12, 0x1c, 0x08, 0x1e, 0x8d, 0xdd, 0x3a, 0x3c, 0x90, 0x23,
+		0x7b, 0xb6, 0x5f, 0x0e, 0xe7, 0x88, 0xdd, 0x3e, 0xaf, 0x5f, 0xff, 0xff, 0x00, 0xe1, 0x54, 0xd4,
+		0x66, 0xda, 0xa7, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2060,6 +2266,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.HistoricalArchive != that1.HistoricalArchive {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2116,6 +2331,66 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *ValidatorPower) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorPower)
+	if !ok {
+		that2, ok := that.(ValidatorPower)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2910,6 +3185,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoricalArchive {
+		i--
+		if m.HistoricalArchive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3119,6 +3424,90 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3451,6 +3840,13 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.HistoricalArchive {
+		n += 2
+	}
 	return n
 }
 
@@ -3510,6 +3906,46 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5983,13 +6419,101 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalArchive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoricalArchive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if (iNdEx + skippy) > l {
@@ -6472,6 +6996,272 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// tokenizeShareModuleAccountPrefix is the prefix of the name of the module
+// account of a tokenize share record, followed by the record id.
+const tokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance. The module
+// account of the record is derived from its id.
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", tokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the bank denom of the tokenized shares of the
+// record, in the {validator}/{id} format.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid tokenize share record %d owner: %w", r.Id, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid tokenize share record %d validator: %w", r.Id, err)
+	}
+	if r.ModuleAccount != fmt.Sprintf("%s%d", tokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid tokenize share record %d module account: %s", r.Id, r.ModuleAccount)
+	}
+
+	return nil
+}
+
+// ParseShareTokenDenom returns the tokenize share record id of a share token
+// denom. The caller must still check that the record's denom matches.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return 0, fmt.Errorf("%s is not a tokenized share denom", denom)
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a tokenized share denom: %w", denom, err)
+	}
+
+	return id, nil
+}
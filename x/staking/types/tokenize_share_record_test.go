@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeShareRecordValidate(t *testing.T) {
+	record := types.NewTokenizeShareRecord(1, sdk.AccAddress(valAddr1), valAddr2)
+	require.NoError(t, record.Validate())
+
+	invalid := record
+	invalid.Id = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = record
+	invalid.Owner = ""
+	require.Error(t, invalid.Validate())
+
+	invalid = record
+	invalid.Validator = sdk.AccAddress(valAddr2).String()
+	require.Error(t, invalid.Validate())
+
+	invalid = record
+	invalid.ModuleAccount = types.NewTokenizeShareRecord(2, sdk.AccAddress(valAddr1), valAddr2).ModuleAccount
+	require.Error(t, invalid.Validate())
+}
+
+func TestShareTokenDenom(t *testing.T) {
+	record := types.NewTokenizeShareRecord(7, sdk.AccAddress(valAddr1), valAddr2)
+	denom := record.GetShareTokenDenom()
+	require.NoError(t, sdk.ValidateDenom(denom))
+
+	id, err := types.ParseShareTokenDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, record.Id, id)
+
+	_, err = types.ParseShareTokenDenom(sdk.DefaultBondDenom)
+	require.Error(t, err)
+	_, err = types.ParseShareTokenDenom("ibc/ABCDEF")
+	require.Error(t, err)
+	require.NotEqual(t, record.GetModuleAddress(), types.NewTokenizeShareRecord(8, sdk.AccAddress(valAddr1), valAddr2).GetModuleAddress())
+}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// GetTxCmd returns a root CLI command handler for all x/tokenfactory transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Tokenfactory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateDenomCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+	)
+
+	return txCmd
+}
+
+// NewCreateDenomCmd returns a CLI command handler for creating a MsgCreateDenom transaction.
+func NewCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create a new factory denom, with the sender as its admin",
+		Long: fmt.Sprintf(`Create the %s/{sender}/{subdenom} denom, with the sender as its admin.
+The denom creation fee is charged to the sender.
+
+Example:
+$ %s tx %s create-denom mytoken --from mykey
+`, types.ModuleDenomPrefix, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintCmd returns a CLI command handler for creating a MsgMint transaction.
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount] [mint_to_address]",
+		Short: "Mint tokens of a factory denom, to the sender unless another address is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), amount)
+			if len(args) > 1 {
+				mintTo, err := sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgMintTo(clientCtx.GetFromAddress(), amount, mintTo)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount] [burn_from_address]",
+		Short: "Burn tokens of a factory denom, from the sender unless another address is given",
+		Long: `Burn tokens of a factory denom from the sender, or from the given address. Burning
+from another address requires the enable_burn_from param of the module to be set.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), amount)
+			if len(args) > 1 {
+				burnFrom, err := sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgBurnFrom(clientCtx.GetFromAddress(), amount, burnFrom)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeAdminCmd returns a CLI command handler for creating a MsgChangeAdmin transaction.
+func NewChangeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new_admin_address]",
+		Short: "Hand over the admin rights of a factory denom to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[0], newAdmin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomMetadataCmd returns a CLI command handler for creating a MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata_file]",
+		Short: "Set the bank metadata of a factory denom from a JSON file",
+		Long: fmt.Sprintf(`Set the bank metadata of a factory denom from a JSON file. The base of the
+metadata must be the factory denom.
+
+Example:
+$ %s tx %s set-denom-metadata metadata.json --from mykey
+
+Where metadata.json contains:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/cosmos1.../utoken", "exponent": 0},
+    {"denom": "token", "exponent": 6}
+  ],
+  "base": "factory/cosmos1.../utoken",
+  "display": "token",
+  "name": "Token",
+  "symbol": "TKN"
+}
+`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,224 @@
+package testutil
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg          network.Config
+	network      *network.Network
+	genesisDenom string
+}
+
+func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
+	return &IntegrationTestSuite{cfg: cfg}
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	s.genesisDenom = s.createDenom("genesis")
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) txArgs(args ...string) []string {
+	val := s.network.Validators[0]
+	return append(args,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+}
+
+// createDenom creates a factory denom with the first validator as its admin.
+func (s *IntegrationTestSuite) createDenom(subdenom string) string {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCreateDenomCmd(), s.txArgs(subdenom))
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	denom, err := types.GetTokenDenom(val.Address.String(), subdenom)
+	s.Require().NoError(err)
+	return denom
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryParams(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().Equal(`{"denom_creation_fee":[{"denom":"stake","amount":"10000000"}]}`, strings.TrimSpace(out.String()))
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryDenomAuthorityMetadata() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  string
+	}{
+		{
+			"existing denom",
+			[]string{s.genesisDenom, fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			fmt.Sprintf(`{"authority_metadata":{"admin":"%s"}}`, val.Address),
+		},
+		{
+			"unknown denom",
+			[]string{"stake", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDenomAuthorityMetadata(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryDenomsFromCreator() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDenomsFromCreator(), []string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res types.QueryDenomsFromCreatorResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Contains(res.Denoms, s.genesisDenom)
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDenomsFromCreator(), []string{"invalid", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestNewMintBurnCmd() {
+	val := s.network.Validators[0]
+	denom := s.createDenom("mintburn")
+
+	testCases := []struct {
+		name         string
+		cmd          func() *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{"mint", cli.NewMintCmd, s.txArgs(fmt.Sprintf("100%s", denom)), false, 0},
+		{"mint to address", cli.NewMintCmd, s.txArgs(fmt.Sprintf("100%s", denom), val.Address.String()), false, 0},
+		{"mint invalid amount", cli.NewMintCmd, s.txArgs("foo"), true, 0},
+		{"mint non factory denom", cli.NewMintCmd, s.txArgs("100stake"), true, 0},
+		{"burn", cli.NewBurnCmd, s.txArgs(fmt.Sprintf("50%s", denom)), false, 0},
+		{"burn more than balance", cli.NewBurnCmd, s.txArgs(fmt.Sprintf("1000%s", denom)), false, 5},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			txResp := &sdk.TxResponse{}
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetDenomMetadataCmd() {
+	val := s.network.Validators[0]
+	denom := s.createDenom("umetadata")
+
+	metadata := fmt.Sprintf(`{
+  "description": "Metadata token",
+  "denom_units": [
+    {"denom": "%s", "exponent": 0},
+    {"denom": "metadata", "exponent": 6}
+  ],
+  "base": "%s",
+  "display": "metadata",
+  "name": "Metadata",
+  "symbol": "MTD"
+}`, denom, denom)
+	metadataFile := testutil.WriteToNewTempFile(s.T(), metadata)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSetDenomMetadataCmd(), s.txArgs(metadataFile.Name()))
+	s.Require().NoError(err)
+	txResp := &sdk.TxResponse{}
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSetDenomMetadataCmd(), s.txArgs(filepath.Join(s.T().TempDir(), "missing.json")))
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestNewChangeAdminCmd() {
+	val := s.network.Validators[0]
+	denom := s.createDenom("changeadmin")
+	newAdmin := sdk.AccAddress("newadmin____________")
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewChangeAdminCmd(), s.txArgs(denom, newAdmin.String()))
+	s.Require().NoError(err)
+	txResp := &sdk.TxResponse{}
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDenomAuthorityMetadata(), []string{denom, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res types.QueryDenomAuthorityMetadataResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Equal(newAdmin.String(), res.AuthorityMetadata.Admin)
+
+	// the previous admin can't mint anymore
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewMintCmd(), s.txArgs(fmt.Sprintf("100%s", denom)))
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+	s.Require().Equal(types.ErrUnauthorized.ABCICode(), txResp.Code, out.String())
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom with the creator
+// as its admin. The denom creation fee is charged to the creator and sent to
+// the community pool.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found ||
+		k.bankKeeper.HasSupply(ctx, denom) || k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    denom,
+		Display: denom,
+		Name:    denom,
+		Symbol:  denom,
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}},
+	})
+
+	if err := k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return "", err
+	}
+	k.addDenomFromCreator(ctx, creator, denom)
+
+	return denom, nil
+}
+
+// Mint mints an amount of a factory denom to an account. Only the admin of the
+// denom is allowed to mint.
+func (k Keeper) Mint(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if err := k.assertAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(mintTo) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins)
+}
+
+// Burn burns an amount of a factory denom from an account. Only the admin of
+// the denom is allowed to burn. The admin can only burn from other accounts if
+// the EnableBurnFrom param is set, and module accounts can't be burned from.
+func (k Keeper) Burn(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin, burnFrom sdk.AccAddress) error {
+	if err := k.assertAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	if !burnFrom.Equals(admin) && !k.GetParams(ctx).EnableBurnFrom {
+		return sdkerrors.Wrapf(types.ErrBurnFromDisabled, "%s can't burn from %s", admin, burnFrom)
+	}
+
+	if k.bankKeeper.BlockedAddr(burnFrom) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "burning from %s is not allowed", burnFrom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burnFrom, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChangeAdmin hands over the admin rights of a factory denom. Only the current
+// admin of the denom is allowed to do so.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error {
+	if err := k.assertAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin.String()})
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom. Only the admin of
+// the denom is allowed to do so.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, admin sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.assertAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// assertAdmin returns an error if the account isn't the admin of the factory
+// denom.
+func (k Keeper) assertAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+
+	if metadata.Admin != admin.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
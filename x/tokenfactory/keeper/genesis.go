@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	// ensure the module account is created
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, genDenom := range genState.FactoryDenoms {
+		creatorStr, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+		creator, err := sdk.AccAddressFromBech32(creatorStr)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.setAuthorityMetadata(ctx, genDenom.Denom, genDenom.AuthorityMetadata); err != nil {
+			panic(fmt.Errorf("invalid authority metadata for %s: %w", genDenom.Denom, err))
+		}
+		k.addDenomFromCreator(ctx, creator, genDenom.Denom)
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genDenoms := []types.GenesisDenom{}
+	k.IterateFactoryDenoms(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		genDenoms = append(genDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), genDenoms)
+}
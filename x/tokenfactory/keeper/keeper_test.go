@@ -0,0 +1,275 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(100_000_000))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) createDenom(creator sdk.AccAddress, subdenom string) string {
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creator, subdenom))
+	suite.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	app, ctx := suite.app, suite.ctx
+	creator := suite.addrs[0]
+	fee := app.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	balanceBefore := app.BankKeeper.GetAllBalances(ctx, creator)
+	poolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	denom := suite.createDenom(creator, "bitcoin")
+	suite.Require().Equal("factory/"+creator.String()+"/bitcoin", denom)
+
+	// the creation fee is sent to the community pool
+	suite.Require().Equal(balanceBefore.Sub(fee), app.BankKeeper.GetAllBalances(ctx, creator))
+	suite.Require().Equal(poolBefore.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	metadata, found := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(creator.String(), metadata.Admin)
+
+	bankMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(denom, bankMetadata.Base)
+
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creator))
+
+	// the same denom can't be created twice
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(creator, "bitcoin"))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrDenomExists.Error())
+
+	// the same subdenom can be used by another creator
+	other := suite.createDenom(suite.addrs[1], "bitcoin")
+	suite.Require().NotEqual(denom, other)
+
+	// creation fails if the creator can't pay the fee
+	app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)), false))
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(creator, "litecoin"))
+	suite.Require().Error(err)
+
+	// no fee is charged when the fee is empty
+	app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins(), false))
+	balanceBefore = app.BankKeeper.GetAllBalances(ctx, creator)
+	suite.createDenom(creator, "litecoin")
+	suite.Require().Equal(balanceBefore, app.BankKeeper.GetAllBalances(ctx, creator))
+	suite.Require().Len(app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creator), 2)
+}
+
+func (suite *KeeperTestSuite) TestMintBurn() {
+	app, ctx := suite.app, suite.ctx
+	admin, other := suite.addrs[0], suite.addrs[1]
+	denom := suite.createDenom(admin, "bitcoin")
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := suite.msgServer.Mint(goCtx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), app.BankKeeper.GetBalance(ctx, admin, denom).Amount.Int64())
+
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 50), other))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), app.BankKeeper.GetBalance(ctx, other, denom).Amount.Int64())
+	suite.Require().Equal(int64(150), app.BankKeeper.GetSupply(ctx, denom).Amount.Int64())
+
+	// only the admin can mint
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(other, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrUnauthorized.Error())
+
+	// unknown factory denoms can't be minted
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(admin, sdk.NewInt64Coin("factory/"+admin.String()+"/unknown", 100)))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrDenomNotFound.Error())
+
+	// module accounts can't receive minted tokens
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), feeCollector))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), app.BankKeeper.GetBalance(ctx, admin, denom).Amount.Int64())
+
+	// burning from another account is disabled by default
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 20), other))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrBurnFromDisabled.Error())
+	suite.Require().Equal(int64(50), app.BankKeeper.GetBalance(ctx, other, denom).Amount.Int64())
+
+	// the admin can burn its own tokens through burn_from_address
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 10), admin))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), app.BankKeeper.GetBalance(ctx, admin, denom).Amount.Int64())
+
+	params := app.TokenFactoryKeeper.GetParams(ctx)
+	params.EnableBurnFrom = true
+	app.TokenFactoryKeeper.SetParams(ctx, params)
+
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 20), other))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(30), app.BankKeeper.GetBalance(ctx, other, denom).Amount.Int64())
+	suite.Require().Equal(int64(80), app.BankKeeper.GetSupply(ctx, denom).Amount.Int64())
+
+	// only the admin can burn
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(other, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrUnauthorized.Error())
+
+	// burning more than the balance fails
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestChangeAdmin() {
+	app, ctx := suite.app, suite.ctx
+	admin, newAdmin := suite.addrs[0], suite.addrs[1]
+	denom := suite.createDenom(admin, "bitcoin")
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// only the admin can change the admin
+	_, err := suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(newAdmin, denom, newAdmin))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrUnauthorized.Error())
+
+	_, err = suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(admin, denom, newAdmin))
+	suite.Require().NoError(err)
+
+	metadata, found := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(newAdmin.String(), metadata.Admin)
+
+	// the previous admin lost its rights
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(newAdmin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+
+	// the denom is still indexed under its creator
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, admin))
+}
+
+func (suite *KeeperTestSuite) TestSetDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+	admin, other := suite.addrs[0], suite.addrs[1]
+	denom := suite.createDenom(admin, "ubitcoin")
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	metadata := banktypes.Metadata{
+		Description: "Bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "bitcoin", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "bitcoin",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+
+	_, err := suite.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(other, metadata))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrUnauthorized.Error())
+
+	_, err = suite.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().NoError(err)
+
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, stored)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	creator := suite.addrs[0]
+	denom := suite.createDenom(creator, "bitcoin")
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.TokenFactoryKeeper.GetParams(ctx), params.Params)
+
+	metadata, err := queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(creator.String(), metadata.AuthorityMetadata.Admin)
+
+	_, err = queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{})
+	suite.Require().Error(err)
+
+	denoms, err := queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom}, denoms.Denoms)
+
+	denoms, err = queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(denoms.Denoms)
+
+	_, err = queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx := suite.app, suite.ctx
+	denom1 := suite.createDenom(suite.addrs[0], "bitcoin")
+	denom2 := suite.createDenom(suite.addrs[1], "litecoin")
+	_, err := suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(suite.addrs[1], denom2, suite.addrs[2]))
+	suite.Require().NoError(err)
+
+	exported := app.TokenFactoryKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Len(exported.FactoryDenoms, 2)
+
+	app2 := simapp.Setup(suite.T(), false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	app2.TokenFactoryKeeper.InitGenesis(ctx2, exported)
+
+	suite.Require().Equal(exported, app2.TokenFactoryKeeper.ExportGenesis(ctx2))
+	suite.Require().Equal([]string{denom1}, app2.TokenFactoryKeeper.GetDenomsFromCreator(ctx2, suite.addrs[0]))
+	suite.Require().Equal([]string{denom2}, app2.TokenFactoryKeeper.GetDenomsFromCreator(ctx2, suite.addrs[1]))
+
+	metadata, found := app2.TokenFactoryKeeper.GetAuthorityMetadata(ctx2, denom2)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.addrs[2].String(), metadata.Admin)
+}
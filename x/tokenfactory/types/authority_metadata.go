@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the authority metadata.
+func (metadata DenomAuthorityMetadata) Validate() error {
+	if metadata.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(metadata.Admin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of every factory denom.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory
+	// denom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom constructs a factory denom from its creator and subdenom. The
+// resulting denom is factory/{creator}/{subdenom}.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory denom into its creator and subdenom. An
+// error is returned if the denom isn't a valid factory denom.
+func DeconstructDenom(denom string) (creator string, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s doesn't have the %s/{creator}/{subdenom} format", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	subdenom = parts[2]
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expected string
+		expErr   bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", false},
+		{"subdenom with slashes", creator, "bit/coin", "factory/" + creator + "/bit/coin", false},
+		{"empty subdenom", creator, "", "factory/" + creator + "/", false},
+		{"subdenom too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), "", true},
+		{"creator with slash", "cosmos/1", "bitcoin", "", true},
+		{"invalid characters", creator, "bit coin", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expCreator  string
+		expSubdenom string
+		expErr      bool
+	}{
+		{"valid", "factory/" + creator + "/bitcoin", creator, "bitcoin", false},
+		{"subdenom with slashes", "factory/" + creator + "/bit/coin", creator, "bit/coin", false},
+		{"not a factory denom", "stake", "", "", true},
+		{"wrong prefix", "ibc/" + creator + "/bitcoin", "", "", true},
+		{"missing subdenom", "factory/" + creator, "", "", true},
+		{"invalid creator", "factory/cosmos1invalid/bitcoin", "", "", true},
+		{"subdenom too long", "factory/" + creator + "/" + strings.Repeat("a", types.MaxSubdenomLength+1), "", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			creator, subdenom, err := types.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCreator, creator)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
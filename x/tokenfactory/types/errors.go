@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists      = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrUnauthorized     = sdkerrors.Register(ModuleName, 3, "unauthorized account")
+	ErrInvalidDenom     = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrDenomNotFound    = sdkerrors.Register(ModuleName, 5, "denom not found")
+	ErrBurnFromDisabled = sdkerrors.Register(ModuleName, 6, "burning from other accounts is disabled")
+)
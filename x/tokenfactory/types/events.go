@@ -0,0 +1,19 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyMintTo        = "mint_to_address"
+	AttributeKeyBurnFrom      = "burn_from_address"
+	AttributeKeyNewAdmin      = "new_admin"
+	AttributeKeyDenomMetadata = "denom_metadata"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, factoryDenoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: factoryDenoms,
+	}
+}
+
+// DefaultGenesisState returns a default tokenfactory module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// Validate performs basic validation of tokenfactory genesis data returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range gs.FactoryDenoms {
+		if seenDenoms[denom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", denom.Denom)
+		}
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+
+		seenDenoms[denom.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	denom := "factory/" + addr1.String() + "/bitcoin"
+
+	testCases := []struct {
+		name   string
+		gs     *types.GenesisState
+		expErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{
+			"valid",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr2.String()}},
+				{Denom: denom + "2", AuthorityMetadata: types.DenomAuthorityMetadata{}},
+			}),
+			false,
+		},
+		{
+			"invalid params",
+			types.NewGenesisState(types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, false), nil),
+			true,
+		},
+		{
+			"duplicate denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr1.String()}},
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr1.String()}},
+			}),
+			true,
+		},
+		{
+			"non factory denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: "stake", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr1.String()}},
+			}),
+			true,
+		},
+		{
+			"invalid admin",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "invalid"}},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.gs.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	// DenomAuthorityMetadataPrefix is the prefix for the authority metadata of
+	// factory denoms.
+	DenomAuthorityMetadataPrefix = []byte{0x01}
+
+	// CreatorPrefix is the prefix for the index of factory denoms by creator.
+	CreatorPrefix = []byte{0x02}
+)
+
+// GetDenomAuthorityMetadataKey returns the key of the authority metadata of a
+// factory denom.
+func GetDenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataPrefix, []byte(denom)...)
+}
+
+// GetCreatorPrefix returns the prefix of the factory denoms created by an
+// account.
+func GetCreatorPrefix(creator []byte) []byte {
+	return append(CreatorPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// GetCreatorDenomKey returns the key of the index entry of a factory denom
+// under its creator.
+func GetCreatorDenomKey(creator []byte, denom string) []byte {
+	return append(GetCreatorPrefix(creator), []byte(denom)...)
+}
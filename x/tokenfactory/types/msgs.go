@@ -0,0 +1,258 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// tokenfactory message types
+const (
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "tf_mint"
+	TypeMsgBurn             = "tf_burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+)
+
+// NewMsgCreateDenom creates a msg to create a new factory denom.
+//nolint:interfacer
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender.String(),
+		Subdenom: subdenom,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgCreateDenom.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic performs a stateless validation of MsgCreateDenom.
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if _, err := GetTokenDenom(msg.Sender, msg.Subdenom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgMint creates a msg to mint factory tokens to the sender.
+//nolint:interfacer
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin) *MsgMint {
+	return &MsgMint{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// NewMsgMintTo creates a msg to mint factory tokens to another account.
+//nolint:interfacer
+func NewMsgMintTo(sender sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) *MsgMint {
+	return &MsgMint{
+		Sender:        sender.String(),
+		Amount:        amount,
+		MintToAddress: mintTo.String(),
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgMint.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic performs a stateless validation of MsgMint.
+func (msg MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.MintToAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// NewMsgBurn creates a msg to burn factory tokens from the sender.
+//nolint:interfacer
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// NewMsgBurnFrom creates a msg to burn factory tokens from another account.
+//nolint:interfacer
+func NewMsgBurnFrom(sender sdk.AccAddress, amount sdk.Coin, burnFrom sdk.AccAddress) *MsgBurn {
+	return &MsgBurn{
+		Sender:          sender.String(),
+		Amount:          amount,
+		BurnFromAddress: burnFrom.String(),
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgBurn.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic performs a stateless validation of MsgBurn.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.BurnFromAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.BurnFromAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid burn from address: %s", err)
+		}
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// NewMsgChangeAdmin creates a msg to change the admin of a factory denom.
+//nolint:interfacer
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender.String(),
+		Denom:    denom,
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgChangeAdmin.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic performs a stateless validation of MsgChangeAdmin.
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// NewMsgSetDenomMetadata creates a msg to set the bank metadata of a factory
+// denom.
+//nolint:interfacer
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender.String(),
+		Metadata: metadata,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetDenomMetadata.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic performs a stateless validation of MsgSetDenomMetadata.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, _, err := DeconstructDenom(msg.Metadata.Base)
+	return err
+}
+
+// validateFactoryCoin checks that a coin is a positive amount of a factory
+// denom.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	_, _, err := DeconstructDenom(coin.Denom)
+	return err
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________")
+	addr2 = sdk.AccAddress("addr2_______________")
+)
+
+func TestMsgCreateDenom(t *testing.T) {
+	require.NoError(t, types.NewMsgCreateDenom(addr1, "bitcoin").ValidateBasic())
+	require.Error(t, types.NewMsgCreateDenom(addr1, "bit coin").ValidateBasic())
+	require.Error(t, (&types.MsgCreateDenom{Sender: "invalid", Subdenom: "bitcoin"}).ValidateBasic())
+
+	msg := types.NewMsgCreateDenom(addr1, "bitcoin")
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+	require.Equal(t, types.TypeMsgCreateDenom, msg.Type())
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgMintBurn(t *testing.T) {
+	denom := "factory/" + addr1.String() + "/bitcoin"
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"valid mint", types.NewMsgMint(addr1, sdk.NewInt64Coin(denom, 10)), false},
+		{"valid mint to", types.NewMsgMintTo(addr1, sdk.NewInt64Coin(denom, 10), addr2), false},
+		{"mint zero", types.NewMsgMint(addr1, sdk.NewInt64Coin(denom, 0)), true},
+		{"mint non factory denom", types.NewMsgMint(addr1, sdk.NewInt64Coin("stake", 10)), true},
+		{"mint to invalid address", &types.MsgMint{Sender: addr1.String(), Amount: sdk.NewInt64Coin(denom, 10), MintToAddress: "invalid"}, true},
+		{"valid burn", types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 10)), false},
+		{"valid burn from", types.NewMsgBurnFrom(addr1, sdk.NewInt64Coin(denom, 10), addr2), false},
+		{"burn zero", types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 0)), true},
+		{"burn non factory denom", types.NewMsgBurn(addr1, sdk.NewInt64Coin("stake", 10)), true},
+		{"burn from invalid address", &types.MsgBurn{Sender: addr1.String(), Amount: sdk.NewInt64Coin(denom, 10), BurnFromAddress: "invalid"}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgChangeAdmin(t *testing.T) {
+	denom := "factory/" + addr1.String() + "/bitcoin"
+
+	require.NoError(t, types.NewMsgChangeAdmin(addr1, denom, addr2).ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin(addr1, "stake", addr2).ValidateBasic())
+	require.Error(t, (&types.MsgChangeAdmin{Sender: addr1.String(), Denom: denom, NewAdmin: "invalid"}).ValidateBasic())
+}
+
+func TestMsgSetDenomMetadata(t *testing.T) {
+	denom := "factory/" + addr1.String() + "/ubitcoin"
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "bitcoin", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "bitcoin",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+	require.NoError(t, types.NewMsgSetDenomMetadata(addr1, metadata).ValidateBasic())
+
+	invalid := metadata
+	invalid.Display = ""
+	require.Error(t, types.NewMsgSetDenomMetadata(addr1, invalid).ValidateBasic())
+
+	nonFactory := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ustake", Exponent: 0}},
+		Base:       "ustake",
+		Display:    "ustake",
+		Name:       "Stake",
+		Symbol:     "STK",
+	}
+	require.Error(t, types.NewMsgSetDenomMetadata(addr1, nonFactory).ValidateBasic())
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyDenomCreationFee = []byte("DenomCreationFee")
+	KeyEnableBurnFrom   = []byte("EnableBurnFrom")
+)
+
+// ParamKeyTable for the tokenfactory module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the tokenfactory module
+func NewParams(denomCreationFee sdk.Coins, enableBurnFrom bool) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+		EnableBurnFrom:   enableBurnFrom,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the tokenfactory module
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
+		EnableBurnFrom:   false,
+	}
+}
+
+// Validate all tokenfactory module parameters
+func (p Params) Validate() error {
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+
+	return validateEnableBurnFrom(p.EnableBurnFrom)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyEnableBurnFrom, &p.EnableBurnFrom, validateEnableBurnFrom),
+	}
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateEnableBurnFrom(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}